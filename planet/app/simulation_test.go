@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"planet/app"
+	blogmoduletypes "planet/x/blog/types"
 )
 
 type storeKeysPrefixes struct {
@@ -356,6 +357,7 @@ func TestAppImportExport(t *testing.T) {
 		{bApp.GetKey(evidencetypes.StoreKey), newApp.GetKey(evidencetypes.StoreKey), [][]byte{}},
 		{bApp.GetKey(capabilitytypes.StoreKey), newApp.GetKey(capabilitytypes.StoreKey), [][]byte{}},
 		{bApp.GetKey(authzkeeper.StoreKey), newApp.GetKey(authzkeeper.StoreKey), [][]byte{authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix}},
+		{bApp.GetKey(blogmoduletypes.StoreKey), newApp.GetKey(blogmoduletypes.StoreKey), [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Upgrade is the upgrade plan of a release
+type Upgrade struct {
+	// Name is the name of the upgrade plan, the tag of the release
+	Name string
+	// StoreUpgrades are the stores the release adds, renames or deletes
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// UpgradeName is the name of the upgrade plan of the current release
+const UpgradeName = "v0.2.0"
+
+// Upgrades are the upgrade plans of the app releases. Each of them runs the
+// module migrations up to the consensus versions of its release.
+var Upgrades = []Upgrade{
+	{
+		// v0.2.0 migrates the x/blog store from consensus version 1 to 12 and
+		// keeps all the blog records in the existing blog store
+		Name: UpgradeName,
+	},
+}
+
+// setupUpgradeHandlers registers the handlers of the upgrade plans the app supports
+func (app *App) setupUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.Name,
+			func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			},
+		)
+	}
+}
+
+// setupUpgradeStoreLoader sets the store loader that applies the store upgrades
+// of the upgrade plan the node was halted for, if any
+func (app *App) setupUpgradeStoreLoader() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgrade.Name == upgradeInfo.Name {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+			return
+		}
+	}
+}
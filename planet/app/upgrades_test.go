@@ -0,0 +1,147 @@
+package app_test
+
+import (
+	"os"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+
+	"planet/app"
+	keepertest "planet/testutil/keeper"
+	blogmodule "planet/x/blog"
+	blogmodulekeeper "planet/x/blog/keeper"
+	blogmoduletypes "planet/x/blog/types"
+)
+
+// setBlogV1State writes a v1 blog genesis the way a v1 chain stored it: raw
+// records without indexes, no params in the module store and the v1 creator
+// and chain formats
+func setBlogV1State(ctx sdk.Context, bApp *app.App, genState blogmoduletypes.GenesisState) {
+	cdc := bApp.AppCodec()
+	store := ctx.KVStore(bApp.GetKey(blogmoduletypes.StoreKey))
+
+	postStore := prefix.NewStore(store, blogmoduletypes.KeyPrefix(blogmoduletypes.PostKey))
+	for _, post := range genState.PostList {
+		postStore.Set(sdk.Uint64ToBigEndian(post.Id), cdc.MustMarshal(&post))
+	}
+	store.Set(blogmoduletypes.KeyPrefix(blogmoduletypes.PostCountKey), sdk.Uint64ToBigEndian(genState.PostCount))
+
+	sentPostStore := prefix.NewStore(store, blogmoduletypes.KeyPrefix(blogmoduletypes.SentPostKey))
+	for _, sentPost := range genState.SentPostList {
+		sentPostStore.Set(sdk.Uint64ToBigEndian(sentPost.Id), cdc.MustMarshal(&sentPost))
+	}
+	store.Set(blogmoduletypes.KeyPrefix(blogmoduletypes.SentPostCountKey), sdk.Uint64ToBigEndian(genState.SentPostCount))
+
+	timeoutPostStore := prefix.NewStore(store, blogmoduletypes.KeyPrefix(blogmoduletypes.TimeoutPostKey))
+	for _, timeoutPost := range genState.TimeoutPostList {
+		timeoutPostStore.Set(sdk.Uint64ToBigEndian(timeoutPost.Id), cdc.MustMarshal(&timeoutPost))
+	}
+	store.Set(blogmoduletypes.KeyPrefix(blogmoduletypes.TimeoutPostCountKey), sdk.Uint64ToBigEndian(genState.TimeoutPostCount))
+
+	store.Set(blogmoduletypes.PortKey, []byte(genState.PortId))
+}
+
+func TestUpgradeBlogFromV1(t *testing.T) {
+	bApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		0,
+		app.MakeEncodingConfig(),
+		simtestutil.EmptyAppOptions{},
+	)
+	ctx := bApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+	bz, err := os.ReadFile("testdata/blog_v1_genesis.json")
+	require.NoError(t, err)
+	var genState blogmoduletypes.GenesisState
+	bApp.AppCodec().MustUnmarshalJSON(bz, &genState)
+	setBlogV1State(ctx, bApp, genState)
+
+	// the local channel-3 is connected to the counterparty channel-0
+	bApp.CapabilityKeeper.InitMemStore(ctx)
+	bApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, blogmoduletypes.PortID, "channel-3", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(blogmoduletypes.PortID, "channel-0"), []string{"connection-0"}, blogmoduletypes.Version1,
+	))
+	chanCap, err := bApp.ScopedIBCKeeper.NewCapability(ctx, host.ChannelCapabilityPath(blogmoduletypes.PortID, "channel-3"))
+	require.NoError(t, err)
+	require.NoError(t, bApp.ScopedBlogKeeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(blogmoduletypes.PortID, "channel-3")))
+
+	fromVM := bApp.ModuleManager().GetVersionMap()
+	fromVM[blogmoduletypes.ModuleName] = 1
+	bApp.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+
+	bApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeName, Height: ctx.BlockHeight()})
+
+	vm := bApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, uint64(12), vm[blogmoduletypes.ModuleName])
+	require.Equal(t, bApp.ModuleManager().GetVersionMap()[blogmoduletypes.ModuleName], vm[blogmoduletypes.ModuleName])
+
+	k := bApp.BlogKeeper
+	received, err := k.Posts.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos18427pnwf35jskwz5pzmrxquaaz4rdfpe0t4hm9", received.Creator)
+	require.Equal(t, "blog", received.OriginPort)
+	require.Equal(t, "channel-0", received.OriginChannel)
+	// v12 resolved the local channel the post was received on
+	require.Equal(t, "channel-3", received.ChannelID)
+	require.Equal(t, "Hello from Earth", received.Title)
+
+	local, err := k.Posts.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1qcrl9zy7merupfkhqksp0eqs0u40mdszf04lqf", local.Creator)
+	require.Empty(t, local.OriginChannel)
+
+	sentPost, err := k.GetSentPostByRemote(ctx, "channel-3", "4")
+	require.NoError(t, err)
+	require.Equal(t, "Hello Earth", sentPost.Title)
+	require.Equal(t, "channel-3", sentPost.ChannelID)
+	// the test channel has no client to resolve the chain ID from
+	require.Empty(t, sentPost.ChainID)
+
+	// v4 moved the params to the module store, v7 and v8 added their fields
+	require.Equal(t, blogmoduletypes.DefaultParams(), k.GetParams(ctx))
+
+	// v5 indexed the posts by creator, v6 moved them to collections
+	byCreator, err := k.PostByCreator(ctx, &blogmoduletypes.QueryPostByCreatorRequest{Creator: local.Creator})
+	require.NoError(t, err)
+	require.Equal(t, []blogmoduletypes.Post{local}, byCreator.Post)
+
+	require.Len(t, keepertest.AllTimeoutPosts(t, ctx, k.TimeoutPosts), 1)
+	require.NoError(t, blogmodule.ExportGenesis(ctx, k).Validate())
+	msg, broken := blogmodulekeeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestUpgradeHandlers(t *testing.T) {
+	bApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		0,
+		app.MakeEncodingConfig(),
+		simtestutil.EmptyAppOptions{},
+	)
+	names := make(map[string]bool)
+	for _, upgrade := range app.Upgrades {
+		require.False(t, names[upgrade.Name], "duplicated upgrade %s", upgrade.Name)
+		names[upgrade.Name] = true
+		require.True(t, bApp.UpgradeKeeper.HasHandler(upgrade.Name), upgrade.Name)
+	}
+	require.True(t, names[app.UpgradeName])
+}
@@ -0,0 +1,80 @@
+package ibctest
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"planet/app"
+	"planet/x/blog/types"
+)
+
+const (
+	// MarsChainID is the chain ID of the first test chain, as in mars.yml
+	MarsChainID = "mars"
+	// EarthChainID is the chain ID of the second test chain, as in earth.yml
+	EarthChainID = "earth"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+}
+
+// SetupTestingApp creates a planet app backed by an in-memory database for ibctesting chains.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := app.MakeEncodingConfig()
+	a := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		5,
+		encoding,
+		simtestutil.EmptyAppOptions{},
+	)
+	return a, app.NewDefaultGenesisState(encoding.Marshaler)
+}
+
+// NewCoordinator creates a coordinator with the mars and earth planet chains.
+func NewCoordinator(t *testing.T) *ibctesting.Coordinator {
+	t.Helper()
+	coord := ibctesting.NewCoordinator(t, 0)
+	coord.Chains = map[string]*ibctesting.TestChain{
+		MarsChainID:  ibctesting.NewTestChain(t, coord, MarsChainID),
+		EarthChainID: ibctesting.NewTestChain(t, coord, EarthChainID),
+	}
+	return coord
+}
+
+// NewBlogPath returns a path between the blog ports of two chains for the current channel version.
+func NewBlogPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	return NewBlogPathWithVersion(chainA, chainB, types.Version)
+}
+
+// NewBlogPathWithVersion returns a path between the blog ports of two chains whose
+// endpoints propose the channel version.
+func NewBlogPathWithVersion(chainA, chainB *ibctesting.TestChain, version string) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	return path
+}
+
+// App returns the planet app of a test chain.
+func App(chain *ibctesting.TestChain) *app.App {
+	a, ok := chain.App.(*app.App)
+	if !ok {
+		chain.T.Fatalf("chain %s does not run the planet app", chain.ChainID)
+	}
+	return a
+}
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
 	"planet/x/blog/keeper"
 	"planet/x/blog/types"
 
+	"cosmossdk.io/collections"
 	tmdb "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -14,14 +16,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
-	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	// TestChannelID is the channel the keeper returned by BlogKeeper owns the capability of
+	TestChannelID = "channel-0"
+	// TestCounterpartyChannelID is the channel ID of the test channel on the counterparty chain
+	TestCounterpartyChannelID = "channel-1"
+)
+
 // blogChannelKeeper is a stub of cosmosibckeeper.ChannelKeeper.
 type blogChannelKeeper struct{}
 
@@ -33,6 +46,22 @@ func (blogChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID
 	return 0, false
 }
 
+func (blogChannelKeeper) GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel {
+	if !strings.HasPrefix(types.PortID, portPrefix) {
+		return nil
+	}
+	return []channeltypes.IdentifiedChannel{{
+		State:        channeltypes.OPEN,
+		Counterparty: channeltypes.NewCounterparty(types.PortID, TestCounterpartyChannelID),
+		PortId:       types.PortID,
+		ChannelId:    TestChannelID,
+	}}
+}
+
+func (blogChannelKeeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
+	return "07-tendermint-0", &ibctm.ClientState{ChainId: "counterparty"}, nil
+}
+
 func (blogChannelKeeper) SendPacket(
 	ctx sdk.Context,
 	channelCap *capabilitytypes.Capability,
@@ -57,6 +86,13 @@ func (blogPortKeeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.
 }
 
 func BlogKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := BlogKeeperWithStoreKey(t)
+	return k, ctx
+}
+
+// BlogKeeperWithStoreKey returns the keeper of BlogKeeper with its store key, to write
+// the store directly
+func BlogKeeperWithStoreKey(t testing.TB) (*keeper.Keeper, sdk.Context, storetypes.StoreKey) {
 	logger := log.NewNopLogger()
 
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
@@ -72,26 +108,105 @@ func BlogKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	appCodec := codec.NewProtoCodec(registry)
 	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, storeKey, memStoreKey)
 
-	paramsSubspace := typesparams.NewSubspace(appCodec,
-		types.Amino,
-		storeKey,
-		memStoreKey,
-		"BlogParams",
-	)
+	ibcScopedKeeper := capabilityKeeper.ScopeToModule("IBCScopedKeeper")
+
 	k := keeper.NewKeeper(
 		appCodec,
 		storeKey,
 		memStoreKey,
-		paramsSubspace,
 		blogChannelKeeper{},
 		blogPortKeeper{},
 		capabilityKeeper.ScopeToModule("BlogScopedKeeper"),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
 
-	// Initialize params
-	k.SetParams(ctx, types.DefaultParams())
+	// Initialize params and port
+	k.SetPort(ctx, types.PortID)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
 
-	return k, ctx
+	// Give the module the capability of a test channel so that packets can be sent on it
+	chanCap, err := ibcScopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(types.PortID, TestChannelID))
+	require.NoError(t, err)
+	require.NoError(t, k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(types.PortID, TestChannelID)))
+
+	return k, ctx, storeKey
+}
+
+// AllPosts returns all the posts of the blog store
+func AllPosts(t testing.TB, ctx sdk.Context, posts *collections.IndexedMap[uint64, types.Post, keeper.PostIndexes]) []types.Post {
+	var values []types.Post
+	err := keeper.Walk(ctx, posts, func(_ uint64, value types.Post) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
+}
+
+// AllSentPosts returns all the sentPosts of the blog store
+func AllSentPosts(t testing.TB, ctx sdk.Context, sentPosts *collections.IndexedMap[uint64, types.SentPost, keeper.SentPostIndexes]) []types.SentPost {
+	var values []types.SentPost
+	err := keeper.Walk(ctx, sentPosts, func(_ uint64, value types.SentPost) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
+}
+
+// AllStagedUploads returns all the stagedUploads of the blog store
+func AllStagedUploads(t testing.TB, ctx sdk.Context, stagedUploads *collections.IndexedMap[uint64, types.StagedUpload, keeper.StagedUploadIndexes]) []types.StagedUpload {
+	var values []types.StagedUpload
+	err := keeper.Walk(ctx, stagedUploads, func(_ uint64, value types.StagedUpload) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
+}
+
+// AllTimeoutPosts returns all the timeoutPosts of the blog store
+func AllTimeoutPosts(t testing.TB, ctx sdk.Context, timeoutPosts collections.Map[uint64, types.TimeoutPost]) []types.TimeoutPost {
+	var values []types.TimeoutPost
+	err := keeper.Walk(ctx, timeoutPosts, func(_ uint64, value types.TimeoutPost) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
+}
+
+// AllPostChanges returns all the postChanges queued in the blog store
+func AllPostChanges(t testing.TB, ctx sdk.Context, postChanges collections.Map[uint64, types.PostChange]) []types.PostChange {
+	var values []types.PostChange
+	err := keeper.Walk(ctx, postChanges, func(_ uint64, value types.PostChange) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
+}
+
+// AllOutboundPosts returns all the outboundPosts of the blog store
+func AllOutboundPosts(t testing.TB, ctx sdk.Context, outboundPosts collections.Map[collections.Pair[string, uint64], types.OutboundPost]) []types.OutboundPost {
+	var values []types.OutboundPost
+	err := keeper.Walk(ctx, outboundPosts, func(_ collections.Pair[string, uint64], value types.OutboundPost) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
+}
+
+// AllPostRevisions returns all the postRevisions of the blog store
+func AllPostRevisions(t testing.TB, ctx sdk.Context, revisions collections.Map[collections.Pair[uint64, uint64], types.PostRevision]) []types.PostRevision {
+	var values []types.PostRevision
+	err := keeper.Walk(ctx, revisions, func(_ collections.Pair[uint64, uint64], value types.PostRevision) bool {
+		values = append(values, value)
+		return false
+	})
+	require.NoError(t, err)
+	return values
 }
@@ -27,10 +27,25 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListPost())
 	cmd.AddCommand(CmdShowPost())
+	cmd.AddCommand(CmdListPostByCreator())
+	cmd.AddCommand(CmdListPostByChannel())
+	cmd.AddCommand(CmdListPostByChain())
 	cmd.AddCommand(CmdListSentPost())
 	cmd.AddCommand(CmdShowSentPost())
+	cmd.AddCommand(CmdShowSentPostByRemote())
+	cmd.AddCommand(CmdListSentPostByChain())
 	cmd.AddCommand(CmdListTimeoutPost())
 	cmd.AddCommand(CmdShowTimeoutPost())
+	cmd.AddCommand(CmdListOutboundPost())
+	cmd.AddCommand(CmdShowOutboundPost())
+	cmd.AddCommand(CmdShowBroadcast())
+	cmd.AddCommand(CmdShowRemotePost())
+	cmd.AddCommand(CmdShowReplicationSubscription())
+	cmd.AddCommand(CmdShowReplica())
+	cmd.AddCommand(CmdShowReplicatedPost())
+	cmd.AddCommand(CmdListPostRevision())
+	cmd.AddCommand(CmdShowPostRevision())
+	cmd.AddCommand(CmdDiffPostRevision())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
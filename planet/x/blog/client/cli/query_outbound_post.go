@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const (
+	flagStatus   = "status"
+	flagUploadID = "upload-id"
+)
+
+func CmdListOutboundPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-outbound-post",
+		Short: "list all outbound post, optionally filtered by status or upload",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argStatus, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := parseOutboundPostStatus(argStatus)
+			if err != nil {
+				return err
+			}
+
+			argUploadID, err := cmd.Flags().GetString(flagUploadID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllOutboundPostRequest{
+				Pagination: pageReq,
+				Status:     status,
+				UploadID:   argUploadID,
+			}
+
+			res, err := queryClient.OutboundPostAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "Filter by status: pending, delivered, partial, failed or timed-out")
+	cmd.Flags().String(flagUploadID, "", "Filter the chunks of a chunked post by upload ID")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowOutboundPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-outbound-post [channel-id] [sequence]",
+		Short: "shows an outbound post",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetOutboundPostRequest{
+				ChannelID: args[0],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.OutboundPost(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseOutboundPostStatus parses a status given as "timed-out" or "OUTBOUND_POST_STATUS_TIMED_OUT"
+func parseOutboundPostStatus(s string) (types.OutboundPostStatus, error) {
+	if s == "" {
+		return types.OutboundPostStatusUnspecified, nil
+	}
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if !strings.HasPrefix(name, "OUTBOUND_POST_STATUS_") {
+		name = "OUTBOUND_POST_STATUS_" + name
+	}
+	status, ok := types.OutboundPostStatus_value[name]
+	if !ok {
+		return types.OutboundPostStatusUnspecified, fmt.Errorf("invalid outbound post status: %s", s)
+	}
+	return types.OutboundPostStatus(status), nil
+}
@@ -0,0 +1,109 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+func networkWithOutboundPostObjects(t *testing.T, n int) (*network.Network, []types.OutboundPost) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{Params: types.DefaultParams(), PortId: types.PortID}
+	for i := 0; i < n; i++ {
+		outboundPost := types.OutboundPost{
+			Id:        uint64(i),
+			ChannelID: "channel-0",
+			Sequence:  uint64(i + 1),
+			Status:    types.OutboundPostStatus(i%4 + 1),
+		}
+		nullify.Fill(&outboundPost)
+		state.OutboundPostList = append(state.OutboundPostList, outboundPost)
+	}
+	state.OutboundPostCount = uint64(n)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.OutboundPostList
+}
+
+func TestOutboundPost(t *testing.T) {
+	net, objs := networkWithOutboundPostObjects(t, 8)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("Show", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc     string
+			sequence string
+			err      error
+			obj      types.OutboundPost
+		}{
+			{
+				desc:     "found",
+				sequence: fmt.Sprintf("%d", objs[0].Sequence),
+				obj:      objs[0],
+			},
+			{
+				desc:     "not found",
+				sequence: "100000",
+				err:      status.Error(codes.NotFound, "not found"),
+			},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				args := append([]string{"channel-0", tc.sequence}, common...)
+				out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowOutboundPost(), args)
+				if tc.err != nil {
+					stat, ok := status.FromError(tc.err)
+					require.True(t, ok)
+					require.ErrorIs(t, stat.Err(), tc.err)
+					return
+				}
+				require.NoError(t, err)
+				var resp types.QueryGetOutboundPostResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.OutboundPost),
+				)
+			})
+		}
+	})
+
+	t.Run("ListByStatus", func(t *testing.T) {
+		args := append([]string{"--status=timed-out"}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListOutboundPost(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllOutboundPostResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		var expected []types.OutboundPost
+		for _, obj := range objs {
+			if obj.Status == types.OutboundPostTimedOut {
+				expected = append(expected, obj)
+			}
+		}
+		require.ElementsMatch(t,
+			nullify.Fill(expected),
+			nullify.Fill(resp.OutboundPost),
+		)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		args := append([]string{"--status=lost"}, common...)
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListOutboundPost(), args)
+		require.Error(t, err)
+	})
+}
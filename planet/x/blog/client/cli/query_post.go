@@ -80,3 +80,117 @@ func CmdShowPost() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListPostByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-post-by-creator [creator]",
+		Short: "list the posts written by a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostByCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PostByCreator(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPostByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-post-by-channel [channel-id]",
+		Short: "list the posts received on a local channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostByChannelRequest{
+				ChannelID:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PostByChannel(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPostByChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-post-by-chain [chain-id]",
+		Short: "list the posts received from an origin chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostByChainRequest{
+				ChainID:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PostByChain(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
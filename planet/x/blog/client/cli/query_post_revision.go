@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func CmdListPostRevision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-post-revision [post-id]",
+		Short: "list the previous revisions of a post",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPostRevisionsRequest{
+				PostID:     postID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PostRevisions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPostRevision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-post-revision [post-id] [revision]",
+		Short: "shows a previous revision of a post",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			revision, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPostRevisionRequest{
+				PostID:   postID,
+				Revision: revision,
+			}
+
+			res, err := queryClient.PostRevision(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDiffPostRevision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff-post-revision [post-id] [from-revision] [to-revision]",
+		Short: "prints a unified diff between two revisions of a post",
+		Long:  "Prints a unified diff between two revisions of a post. The current revision of the post is used when to-revision is omitted.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			fromRevision, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			post, err := queryClient.Post(cmd.Context(), &types.QueryGetPostRequest{Id: postID})
+			if err != nil {
+				return err
+			}
+			toRevision := post.Post.Revision
+			if len(args) > 2 {
+				toRevision, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			from, err := queryPostVersion(cmd.Context(), queryClient, post.Post, fromRevision)
+			if err != nil {
+				return err
+			}
+			to, err := queryPostVersion(cmd.Context(), queryClient, post.Post, toRevision)
+			if err != nil {
+				return err
+			}
+
+			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(postDocument(from)),
+				B:        difflib.SplitLines(postDocument(to)),
+				FromFile: fmt.Sprintf("post/%d@%d", postID, fromRevision),
+				ToFile:   fmt.Sprintf("post/%d@%d", postID, toRevision),
+				Context:  3,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(diff)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryPostVersion returns the post as it was at the revision. The current revision
+// is the post itself, previous ones are queried from the revision history.
+func queryPostVersion(ctx context.Context, queryClient types.QueryClient, post types.Post, revision uint64) (types.PostRevision, error) {
+	if revision == post.Revision {
+		return types.PostRevision{
+			PostID:   post.Id,
+			Revision: post.Revision,
+			Title:    post.Title,
+			Content:  post.Content,
+		}, nil
+	}
+
+	res, err := queryClient.PostRevision(ctx, &types.QueryGetPostRevisionRequest{
+		PostID:   post.Id,
+		Revision: revision,
+	})
+	if status.Code(err) == codes.NotFound {
+		return types.PostRevision{}, fmt.Errorf("post %d has no revision %d", post.Id, revision)
+	}
+	if err != nil {
+		return types.PostRevision{}, err
+	}
+	return res.PostRevision, nil
+}
+
+// postDocument renders a post version as the text diffed between revisions: the
+// title on the first line, then a blank line and the content
+func postDocument(revision types.PostRevision) string {
+	doc := revision.Title + "\n\n" + revision.Content
+	if len(revision.Content) > 0 && revision.Content[len(revision.Content)-1] != '\n' {
+		doc += "\n"
+	}
+	return doc
+}
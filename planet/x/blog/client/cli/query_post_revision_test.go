@@ -0,0 +1,92 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	tmcli "github.com/cometbft/cometbft/libs/cli"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"planet/testutil/network"
+	"planet/testutil/nullify"
+	"planet/x/blog/client/cli"
+	"planet/x/blog/types"
+)
+
+func networkWithPostRevisionObjects(t *testing.T) (*network.Network, []types.PostRevision) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{Params: types.DefaultParams(), PortId: types.PortID}
+	state.PostList = []types.Post{{Id: 0, Title: "third", Content: "line 1\nline 2 edited\nline 3\n", Revision: 2}}
+	state.PostCount = 1
+	state.PostRevisionList = []types.PostRevision{
+		{PostID: 0, Revision: 0, Title: "first", Content: "line 1\nline 2\n"},
+		{PostID: 0, Revision: 1, Title: "second", Content: "line 1\nline 2\nline 3\n"},
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.PostRevisionList
+}
+
+func TestPostRevision(t *testing.T) {
+	net, objs := networkWithPostRevisionObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	t.Run("Show", func(t *testing.T) {
+		args := append([]string{"0", "1"}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPostRevision(), args)
+		require.NoError(t, err)
+		var resp types.QueryGetPostRevisionResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t,
+			nullify.Fill(&objs[1]),
+			nullify.Fill(&resp.PostRevision),
+		)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		args := append([]string{"0"}, common...)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPostRevision(), args)
+		require.NoError(t, err)
+		var resp types.QueryPostRevisionsResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.PostRevision),
+		)
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdDiffPostRevision(), []string{"0", "0", "1"})
+		require.NoError(t, err)
+		require.Equal(t, `--- post/0@0
++++ post/0@1
+@@ -1,5 +1,6 @@
+-first
++second
+ 
+ line 1
+ line 2
++line 3
+ 
+`, out.String())
+	})
+
+	t.Run("DiffCurrent", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdDiffPostRevision(), []string{"0", "1"})
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "+++ post/0@2\n")
+		require.Contains(t, out.String(), "-line 2\n+line 2 edited\n")
+	})
+
+	t.Run("DiffNotFound", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdDiffPostRevision(), []string{"0", "5"})
+		require.ErrorContains(t, err, "key not found")
+	})
+}
@@ -20,7 +20,7 @@ import (
 func networkWithPostObjects(t *testing.T, n int) (*network.Network, []types.Post) {
 	t.Helper()
 	cfg := network.DefaultConfig()
-	state := types.GenesisState{PortId: types.PortID}
+	state := types.GenesisState{Params: types.DefaultParams(), PortId: types.PortID}
 	for i := 0; i < n; i++ {
 		post := types.Post{
 			Id: uint64(i),
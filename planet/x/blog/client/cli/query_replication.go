@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdShowReplicationSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-replication-subscription [channel-id]",
+		Short: "shows the replication of the local posts over a channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetReplicationSubscriptionRequest{
+				ChannelID: args[0],
+			}
+
+			res, err := queryClient.ReplicationSubscription(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowReplica() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-replica [channel-id]",
+		Short: "shows the replica of the posts of the counterparty chain of a channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetReplicaRequest{
+				ChannelID: args[0],
+			}
+
+			res, err := queryClient.Replica(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowReplicatedPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-replicated-post [channel-id] [post-id]",
+		Short: "shows a post of the replica of the counterparty chain of a channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetReplicatedPostRequest{
+				ChannelID: args[0],
+				PostID:    args[1],
+			}
+
+			res, err := queryClient.ReplicatedPost(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
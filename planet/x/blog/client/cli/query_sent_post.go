@@ -80,3 +80,73 @@ func CmdShowSentPost() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowSentPostByRemote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-sent-post-by-remote [channel-id] [post-id]",
+		Short: "shows a sentPost from the channel it was sent on and its remote post ID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySentPostByRemoteRequest{
+				ChannelID: args[0],
+				PostID:    args[1],
+			}
+
+			res, err := queryClient.SentPostByRemote(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListSentPostByChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-sent-post-by-chain [chain-id]",
+		Short: "list the sentPosts sent to a destination chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySentPostByChainRequest{
+				ChainID:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SentPostByChain(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
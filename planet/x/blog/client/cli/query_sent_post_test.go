@@ -20,7 +20,7 @@ import (
 func networkWithSentPostObjects(t *testing.T, n int) (*network.Network, []types.SentPost) {
 	t.Helper()
 	cfg := network.DefaultConfig()
-	state := types.GenesisState{PortId: types.PortID}
+	state := types.GenesisState{Params: types.DefaultParams(), PortId: types.PortID}
 	for i := 0; i < n; i++ {
 		sentPost := types.SentPost{
 			Id: uint64(i),
@@ -20,7 +20,7 @@ import (
 func networkWithTimeoutPostObjects(t *testing.T, n int) (*network.Network, []types.TimeoutPost) {
 	t.Helper()
 	cfg := network.DefaultConfig()
-	state := types.GenesisState{PortId: types.PortID}
+	state := types.GenesisState{Params: types.DefaultParams(), PortId: types.PortID}
 	for i := 0; i < n; i++ {
 		timeoutPost := types.TimeoutPost{
 			Id: uint64(i),
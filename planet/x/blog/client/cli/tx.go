@@ -17,6 +17,7 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagPacketMemo             = "packet-memo"
 	listSeparator              = ","
 )
 
@@ -31,7 +32,18 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSendIbcPost())
+	cmd.AddCommand(CmdSendBatchPost())
+	cmd.AddCommand(CmdSendChunkedPost())
+	cmd.AddCommand(CmdFetchPost())
+	cmd.AddCommand(CmdSubscribeReplication())
+	cmd.AddCommand(CmdResyncReplica())
 	cmd.AddCommand(CmdSendUpdatePost())
+	cmd.AddCommand(CmdSendDeletePost())
+	cmd.AddCommand(CmdRetryTimeoutPost())
+	cmd.AddCommand(CmdBroadcastPost())
+	cmd.AddCommand(CmdCreatePost())
+	cmd.AddCommand(CmdUpdatePost())
+	cmd.AddCommand(CmdDeletePost())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v7/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const flagAtomic = "atomic"
+
+func CmdSendBatchPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-batch-post [src-port] [src-channel] [file]",
+		Short: "Send the posts of a file over IBC in one packet",
+		Long: `Send the posts of a JSON or Markdown file over IBC in one packet.
+
+A JSON file holds an array of posts: [{"title": "...", "content": "..."}].
+In a Markdown file every level 1 heading starts a post: the heading is the
+title and the text up to the next level 1 heading is the content.
+
+The receiving chain stores the valid posts, or with --atomic stores the posts
+only if all of them are valid.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+
+			posts, err := readBatchPosts(args[2])
+			if err != nil {
+				return err
+			}
+			atomic, err := cmd.Flags().GetBool(flagAtomic)
+			if err != nil {
+				return err
+			}
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgSendBatchPost(creator, srcPort, srcChannel, timeoutTimestamp, posts, atomic)
+			msg.Memo, err = cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagAtomic, false, "Store the posts only if all of them are valid")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes, 0 uses the defaultPacketTimeout param.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo written in the packet header")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readBatchPosts reads the posts of a JSON file, or of a Markdown file for the
+// .md and .markdown extensions
+func readBatchPosts(path string) ([]types.BatchPost, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		return parseMarkdownPosts(string(bz))
+	default:
+		var posts []types.BatchPost
+		if err := json.Unmarshal(bz, &posts); err != nil {
+			return nil, fmt.Errorf("invalid posts file %s: %w", path, err)
+		}
+		return posts, nil
+	}
+}
+
+// parseMarkdownPosts splits a Markdown document into posts at its level 1
+// headings. Only blank lines may come before the first heading.
+func parseMarkdownPosts(doc string) ([]types.BatchPost, error) {
+	var (
+		posts   []types.BatchPost
+		content []string
+	)
+	flush := func() {
+		if len(posts) > 0 {
+			posts[len(posts)-1].Content = strings.TrimSpace(strings.Join(content, "\n"))
+		}
+		content = nil
+	}
+	for i, line := range strings.Split(strings.ReplaceAll(doc, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, "# ") {
+			flush()
+			posts = append(posts, types.BatchPost{Title: strings.TrimSpace(strings.TrimPrefix(line, "# "))})
+			continue
+		}
+		if len(posts) == 0 && strings.TrimSpace(line) != "" {
+			return nil, fmt.Errorf("line %d: text before the first post title", i+1)
+		}
+		content = append(content, line)
+	}
+	flush()
+	return posts, nil
+}
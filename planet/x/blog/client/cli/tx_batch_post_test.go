@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"planet/x/blog/types"
+)
+
+func TestReadBatchPosts(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		desc  string
+		file  string
+		doc   string
+		posts []types.BatchPost
+		err   bool
+	}{
+		{
+			desc:  "json",
+			file:  "posts.json",
+			doc:   `[{"title":"first","content":"hello"},{"title":"second"}]`,
+			posts: []types.BatchPost{{Title: "first", Content: "hello"}, {Title: "second"}},
+		},
+		{
+			desc: "invalid json",
+			file: "posts.json",
+			doc:  `{"title":"first"}`,
+			err:  true,
+		},
+		{
+			desc: "markdown",
+			file: "posts.md",
+			doc:  "\n# First\n\nhello\n\n## Section\nworld\n\n#  Second \r\n# Third\nbye\n",
+			posts: []types.BatchPost{
+				{Title: "First", Content: "hello\n\n## Section\nworld"},
+				{Title: "Second"},
+				{Title: "Third", Content: "bye"},
+			},
+		},
+		{
+			desc: "markdown without title",
+			file: "posts.markdown",
+			doc:  "hello\n# First\n",
+			err:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			path := filepath.Join(dir, tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.doc), 0o600))
+			posts, err := readBatchPosts(path)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.posts, posts)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const (
+	flagChannels    = "channels"
+	flagAllChannels = "all-channels"
+)
+
+func CmdBroadcastPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-post [title] [content]",
+		Short: "Send a post over several IBC channels in one transaction",
+		Long: `Send a post over the channels given with --channels, or over every open blog
+channel with --all-channels. The post is sent on all the channels or on none.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argChannels, err := cmd.Flags().GetString(flagChannels)
+			if err != nil {
+				return err
+			}
+			var channelIDs []string
+			if argChannels != "" {
+				channelIDs = strings.Split(argChannels, listSeparator)
+			}
+			allChannels, err := cmd.Flags().GetBool(flagAllChannels)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBroadcastPost(
+				clientCtx.GetFromAddress().String(),
+				channelIDs,
+				allChannels,
+				timeoutTimestamp,
+				args[0],
+				args[1],
+			)
+			msg.Memo, err = cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagChannels, "", "Comma separated source channels of the post")
+	cmd.Flags().Bool(flagAllChannels, false, "Send the post over every open blog channel")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, 0, "Absolute packet timeout timestamp in nanoseconds. 0 uses the defaultPacketTimeout param.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo written in the packet headers, needs blog-2 channels")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v7/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const flagChunkSize = "chunk-size"
+
+// DefaultChunkSize is the default maximum size in bytes of the content of a chunk packet
+const DefaultChunkSize uint64 = 4096
+
+func CmdSendChunkedPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-chunked-post [src-port] [src-channel] [title] [content-file]",
+		Short: "Send a post over IBC with its content split in several packets",
+		Long: `Send a post over IBC with the content of a file split in several packets.
+
+The receiving chain keeps the chunks until all of them have arrived and stores
+the post if the content matches its hash. The chunks of an upload that is not
+complete within the uploadTimeout param of the receiving chain are dropped.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+
+			argTitle := args[2]
+			content, err := os.ReadFile(args[3])
+			if err != nil {
+				return err
+			}
+			chunkSize, err := cmd.Flags().GetUint64(flagChunkSize)
+			if err != nil {
+				return err
+			}
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgSendChunkedPost(creator, srcPort, srcChannel, timeoutTimestamp, argTitle, string(content), chunkSize)
+			msg.Memo, err = cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagChunkSize, DefaultChunkSize, "Maximum size in bytes of the content of a packet")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes, 0 uses the defaultPacketTimeout param.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo written in the packet headers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v7/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+func CmdFetchPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch-post [src-port] [src-channel] [post-id]",
+		Short: "Fetch a post of the counterparty chain over IBC",
+		Long: `Fetch a post of the counterparty chain over IBC.
+
+The counterparty answers with the post in the packet acknowledgement, and the
+post is cached until it is fetched again. Read the cached copy with
+show-remote-post.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+
+			argPostID := args[2]
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgFetchPost(creator, srcPort, srcChannel, timeoutTimestamp, argPostID)
+			msg.Memo, err = cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes, 0 uses the defaultPacketTimeout param.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo written in the packet header")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
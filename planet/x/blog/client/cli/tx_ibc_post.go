@@ -45,6 +45,10 @@ func CmdSendIbcPost() *cobra.Command {
 			}
 
 			msg := types.NewMsgSendIbcPost(creator, srcPort, srcChannel, timeoutTimestamp, argTitle, argContent)
+			msg.Memo, err = cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -52,7 +56,8 @@ func CmdSendIbcPost() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes, 0 uses the defaultPacketTimeout param.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo written in the packet header, needs a blog-2 channel")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v7/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+	"planet/x/blog/types"
+)
+
+const (
+	flagPort    = "port"
+	flagChannel = "channel"
+)
+
+func CmdRetryTimeoutPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-timeout-post [id]",
+		Short: "Re-send a timed out post or update over IBC",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			argID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			srcPort, err := cmd.Flags().GetString(flagPort)
+			if err != nil {
+				return err
+			}
+			srcChannel, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+
+			// The relative timeout is computed against the channel the packet goes out on
+			timeoutPort, timeoutChannel := srcPort, srcChannel
+			if timeoutPort == "" || timeoutChannel == "" {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.TimeoutPost(cmd.Context(), &types.QueryGetTimeoutPostRequest{Id: argID})
+				if err != nil {
+					return err
+				}
+				if timeoutPort == "" {
+					timeoutPort = res.TimeoutPost.Port
+				}
+				if timeoutChannel == "" {
+					timeoutChannel = res.TimeoutPost.ChannelID
+				}
+			}
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, timeoutPort, timeoutChannel)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgRetryTimeoutPost(creator, argID, srcPort, srcChannel, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPort, "", "Source port to retry on, defaults to the port of the timed out packet")
+	cmd.Flags().String(flagChannel, "", "Source channel to retry on, defaults to the channel of the timed out packet; updates can only be retried on it")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes, 0 uses the defaultPacketTimeout param.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const flagBaseRevision = "base-revision"
+
 func CmdSendUpdatePost() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send-update-post [src-port] [src-channel] [post-id] [title] [content]",
@@ -45,7 +47,16 @@ func CmdSendUpdatePost() *cobra.Command {
 				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
 			}
 
-			msg := types.NewMsgSendUpdatePost(creator, srcPort, srcChannel, timeoutTimestamp, argPostID, argTitle, argContent)
+			baseRevision, err := cmd.Flags().GetUint64(flagBaseRevision)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendUpdatePost(creator, srcPort, srcChannel, timeoutTimestamp, argPostID, argTitle, argContent, baseRevision)
+			msg.Memo, err = cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -53,7 +64,9 @@ func CmdSendUpdatePost() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes, 0 uses the defaultPacketTimeout param.")
+	cmd.Flags().Uint64(flagBaseRevision, 0, "Revision of the remote post the edit is based on, see the revision of its sent post")
+	cmd.Flags().String(flagPacketMemo, "", "Memo written in the packet header, needs a blog-2 channel")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
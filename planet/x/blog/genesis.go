@@ -1,6 +1,7 @@
 package blog
 
 import (
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"planet/x/blog/keeper"
 	"planet/x/blog/types"
@@ -10,25 +11,106 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// Set all the post
 	for _, elem := range genState.PostList {
-		k.SetPost(ctx, elem)
+		if err := k.Posts.Set(ctx, elem.Id, elem); err != nil {
+			panic(err)
+		}
 	}
 
 	// Set post count
-	k.SetPostCount(ctx, genState.PostCount)
+	if err := k.PostSeq.Set(ctx, genState.PostCount); err != nil {
+		panic(err)
+	}
 	// Set all the sentPost
 	for _, elem := range genState.SentPostList {
-		k.SetSentPost(ctx, elem)
+		if err := k.SentPosts.Set(ctx, elem.Id, elem); err != nil {
+			panic(err)
+		}
 	}
 
 	// Set sentPost count
-	k.SetSentPostCount(ctx, genState.SentPostCount)
+	if err := k.SentPostSeq.Set(ctx, genState.SentPostCount); err != nil {
+		panic(err)
+	}
 	// Set all the timeoutPost
 	for _, elem := range genState.TimeoutPostList {
-		k.SetTimeoutPost(ctx, elem)
+		if err := k.TimeoutPosts.Set(ctx, elem.Id, elem); err != nil {
+			panic(err)
+		}
 	}
 
 	// Set timeoutPost count
-	k.SetTimeoutPostCount(ctx, genState.TimeoutPostCount)
+	if err := k.TimeoutPostSeq.Set(ctx, genState.TimeoutPostCount); err != nil {
+		panic(err)
+	}
+	// Set all the outboundPost
+	for _, elem := range genState.OutboundPostList {
+		if err := k.SetOutboundPost(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set outboundPost count
+	if err := k.OutboundPostSeq.Set(ctx, genState.OutboundPostCount); err != nil {
+		panic(err)
+	}
+	// Set all the postRevision
+	for _, elem := range genState.PostRevisionList {
+		if err := k.SetPostRevision(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	// Set all the broadcast
+	for _, elem := range genState.BroadcastList {
+		if err := k.Broadcasts.Set(ctx, elem.Id, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set broadcast count
+	if err := k.BroadcastSeq.Set(ctx, genState.BroadcastCount); err != nil {
+		panic(err)
+	}
+	// Set all the stagedUpload
+	for _, elem := range genState.StagedUploadList {
+		if err := k.StagedUploads.Set(ctx, elem.Id, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set stagedUpload count
+	if err := k.StagedUploadSeq.Set(ctx, genState.StagedUploadCount); err != nil {
+		panic(err)
+	}
+	// Set all the uploadChunk
+	for _, elem := range genState.UploadChunkList {
+		if err := k.UploadChunks.Set(ctx, collections.Join(elem.StagedUploadID, elem.Index), elem); err != nil {
+			panic(err)
+		}
+	}
+	// Set all the remotePost
+	for _, elem := range genState.RemotePostList {
+		if err := k.RemotePosts.Set(ctx, collections.Join(elem.ChannelID, elem.PostID), elem); err != nil {
+			panic(err)
+		}
+	}
+	// Set all the replicationSubscription
+	for _, elem := range genState.ReplicationSubscriptionList {
+		if err := k.ReplicationSubscriptions.Set(ctx, elem.ChannelID, elem); err != nil {
+			panic(err)
+		}
+	}
+	// Set all the replica
+	for _, elem := range genState.ReplicaList {
+		if err := k.Replicas.Set(ctx, elem.ChannelID, elem); err != nil {
+			panic(err)
+		}
+	}
+	// Set all the replicatedPost
+	for _, elem := range genState.ReplicatedPostList {
+		if err := k.ReplicatedPosts.Set(ctx, collections.Join(elem.ChannelID, elem.PostID), elem); err != nil {
+			panic(err)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -41,7 +123,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic("could not claim port capability: " + err.Error())
 		}
 	}
-	k.SetParams(ctx, genState.Params)
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -50,12 +134,108 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.PortId = k.GetPort(ctx)
-	genesis.PostList = k.GetAllPost(ctx)
-	genesis.PostCount = k.GetPostCount(ctx)
-	genesis.SentPostList = k.GetAllSentPost(ctx)
-	genesis.SentPostCount = k.GetSentPostCount(ctx)
-	genesis.TimeoutPostList = k.GetAllTimeoutPost(ctx)
-	genesis.TimeoutPostCount = k.GetTimeoutPostCount(ctx)
+	err := keeper.Walk(ctx, k.Posts, func(_ uint64, post types.Post) bool {
+		genesis.PostList = append(genesis.PostList, post)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	if genesis.PostCount, err = k.PostSeq.Peek(ctx); err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.SentPosts, func(_ uint64, sentPost types.SentPost) bool {
+		genesis.SentPostList = append(genesis.SentPostList, sentPost)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	if genesis.SentPostCount, err = k.SentPostSeq.Peek(ctx); err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.TimeoutPosts, func(_ uint64, timeoutPost types.TimeoutPost) bool {
+		genesis.TimeoutPostList = append(genesis.TimeoutPostList, timeoutPost)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	if genesis.TimeoutPostCount, err = k.TimeoutPostSeq.Peek(ctx); err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.OutboundPosts, func(_ collections.Pair[string, uint64], outboundPost types.OutboundPost) bool {
+		genesis.OutboundPostList = append(genesis.OutboundPostList, outboundPost)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	if genesis.OutboundPostCount, err = k.OutboundPostSeq.Peek(ctx); err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.Revisions, func(_ collections.Pair[uint64, uint64], postRevision types.PostRevision) bool {
+		genesis.PostRevisionList = append(genesis.PostRevisionList, postRevision)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.Broadcasts, func(_ uint64, broadcast types.Broadcast) bool {
+		genesis.BroadcastList = append(genesis.BroadcastList, broadcast)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	if genesis.BroadcastCount, err = k.BroadcastSeq.Peek(ctx); err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.StagedUploads, func(_ uint64, stagedUpload types.StagedUpload) bool {
+		genesis.StagedUploadList = append(genesis.StagedUploadList, stagedUpload)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	if genesis.StagedUploadCount, err = k.StagedUploadSeq.Peek(ctx); err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.UploadChunks, func(_ collections.Pair[uint64, uint32], uploadChunk types.UploadChunk) bool {
+		genesis.UploadChunkList = append(genesis.UploadChunkList, uploadChunk)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.RemotePosts, func(_ collections.Pair[string, string], remotePost types.RemotePost) bool {
+		genesis.RemotePostList = append(genesis.RemotePostList, remotePost)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.ReplicationSubscriptions, func(_ string, replicationSubscription types.ReplicationSubscription) bool {
+		genesis.ReplicationSubscriptionList = append(genesis.ReplicationSubscriptionList, replicationSubscription)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.Replicas, func(_ string, replica types.Replica) bool {
+		genesis.ReplicaList = append(genesis.ReplicaList, replica)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.Walk(ctx, k.ReplicatedPosts, func(_ collections.Pair[string, string], replicatedPost types.RemotePost) bool {
+		genesis.ReplicatedPostList = append(genesis.ReplicatedPostList, replicatedPost)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
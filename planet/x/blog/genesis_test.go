@@ -1,6 +1,8 @@
 package blog_test
 
 import (
+	"time"
+
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +43,112 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		TimeoutPostCount: 2,
+		OutboundPostList: []types.OutboundPost{
+			{
+				Id:        0,
+				ChannelID: "channel-0",
+				Sequence:  1,
+			},
+			{
+				Id:        1,
+				ChannelID: "channel-0",
+				Sequence:  2,
+			},
+		},
+		OutboundPostCount: 2,
+		PostRevisionList: []types.PostRevision{
+			{
+				PostID:   0,
+				Revision: 0,
+			},
+			{
+				PostID:   0,
+				Revision: 1,
+			},
+		},
+		BroadcastList: []types.Broadcast{
+			{
+				Id: 0,
+				Destinations: []types.BroadcastDestination{
+					{ChannelID: "channel-0", Sequence: 1},
+					{ChannelID: "channel-1", Sequence: 1},
+				},
+			},
+			{
+				Id: 1,
+			},
+		},
+		BroadcastCount: 2,
+		StagedUploadList: []types.StagedUpload{
+			{
+				Id:         0,
+				ChannelID:  "channel-0",
+				UploadID:   "3",
+				ChunkCount: 2,
+				ExpiresAt:  time.Unix(1_700_000_000, 0).UTC(),
+			},
+			{
+				Id:         1,
+				ChannelID:  "channel-1",
+				UploadID:   "3",
+				ChunkCount: 4,
+				ExpiresAt:  time.Unix(1_700_000_000, 0).UTC(),
+			},
+		},
+		StagedUploadCount: 2,
+		UploadChunkList: []types.UploadChunk{
+			{StagedUploadID: 0, Index: 1, Data: []byte("chunk")},
+			{StagedUploadID: 1, Index: 0, Data: []byte("chunk")},
+		},
+		RemotePostList: []types.RemotePost{
+			{
+				ChannelID:   "channel-0",
+				PostID:      "3",
+				Post:        types.Post{Id: 3, Title: "title"},
+				FetchHeight: 10,
+				FetchedAt:   time.Unix(1_700_000_000, 0).UTC(),
+			},
+			{
+				ChannelID: "channel-1",
+				PostID:    "3",
+				FetchedAt: time.Unix(1_700_000_000, 0).UTC(),
+			},
+		},
+		ReplicationSubscriptionList: []types.ReplicationSubscription{
+			{
+				ChannelID:     "channel-0",
+				NextSequence:  4,
+				ResetSequence: 1,
+				Synced:        true,
+			},
+			{
+				ChannelID:       "channel-1",
+				NextSequence:    3,
+				ResetSequence:   1,
+				SnapshotPending: true,
+				SnapshotStarted: true,
+				SnapshotCursor:  5,
+			},
+		},
+		ReplicaList: []types.Replica{
+			{
+				ChannelID:     "channel-0",
+				NextSequence:  7,
+				AppliedHeight: 10,
+			},
+			{
+				ChannelID: "channel-1",
+			},
+		},
+		ReplicatedPostList: []types.RemotePost{
+			{
+				ChannelID:   "channel-0",
+				PostID:      "3",
+				Post:        types.Post{Id: 3, Title: "title"},
+				FetchHeight: 10,
+				FetchedAt:   time.Unix(1_700_000_000, 0).UTC(),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -60,5 +168,17 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.SentPostCount, got.SentPostCount)
 	require.ElementsMatch(t, genesisState.TimeoutPostList, got.TimeoutPostList)
 	require.Equal(t, genesisState.TimeoutPostCount, got.TimeoutPostCount)
+	require.ElementsMatch(t, genesisState.OutboundPostList, got.OutboundPostList)
+	require.Equal(t, genesisState.OutboundPostCount, got.OutboundPostCount)
+	require.ElementsMatch(t, genesisState.PostRevisionList, got.PostRevisionList)
+	require.ElementsMatch(t, genesisState.BroadcastList, got.BroadcastList)
+	require.Equal(t, genesisState.BroadcastCount, got.BroadcastCount)
+	require.ElementsMatch(t, genesisState.StagedUploadList, got.StagedUploadList)
+	require.Equal(t, genesisState.StagedUploadCount, got.StagedUploadCount)
+	require.ElementsMatch(t, genesisState.UploadChunkList, got.UploadChunkList)
+	require.ElementsMatch(t, genesisState.RemotePostList, got.RemotePostList)
+	require.ElementsMatch(t, genesisState.ReplicationSubscriptionList, got.ReplicationSubscriptionList)
+	require.ElementsMatch(t, genesisState.ReplicaList, got.ReplicaList)
+	require.ElementsMatch(t, genesisState.ReplicatedPostList, got.ReplicatedPostList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
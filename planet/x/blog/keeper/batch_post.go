@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"planet/x/blog/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// TransmitBatchPostPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version. Chains speaking only blog-1 predate batches.
+func (k Keeper) TransmitBatchPostPacket(
+	ctx sdk.Context,
+	packetData types.BatchPostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if version := k.ChannelVersion(ctx, sourcePort, sourceChannel); version == types.Version1 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel %s has no batches, it speaks %s", sourceChannel, version)
+	}
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_BatchPostPacket{BatchPostPacket: &packetData},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvBatchPostPacket processes packet reception. Each post is received like
+// the post of an ibcPost packet, in a cache context written only if the post is
+// accepted. The posts of an atomic batch are written only if all of them are.
+func (k Keeper) OnRecvBatchPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchPostPacketData) (packetAck types.BatchPostPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+	if err := k.validateInboundChannel(ctx, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+	if maxPosts := k.GetParams(ctx).MaxBatchPosts; uint64(len(data.Posts)) > maxPosts {
+		return packetAck, sdkerrors.Wrapf(types.ErrBatchTooLarge, "%d posts, max %d", len(data.Posts), maxPosts)
+	}
+
+	batchCtx, writeBatch := ctx, func() {}
+	if data.Atomic {
+		batchCtx, writeBatch = ctx.CacheContext()
+	}
+
+	failed := false
+	packetAck.Results = make([]types.BatchPostResult, len(data.Posts))
+	for i, post := range data.Posts {
+		postCtx, writePost := batchCtx.CacheContext()
+		postAck, err := k.OnRecvIbcPostPacket(postCtx, packet, post)
+		if err != nil {
+			packetAck.Results[i].Error = types.AckErrorCodeOf(err)
+			failed = true
+			continue
+		}
+		writePost()
+		packetAck.Results[i].PostID = postAck.PostID
+	}
+
+	if data.Atomic && failed {
+		for i := range packetAck.Results {
+			packetAck.Results[i].PostID = ""
+		}
+		return packetAck, nil
+	}
+	writeBatch()
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementBatchPostPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. Each stored post becomes a sentPost.
+func (k Keeper) OnAcknowledgementBatchPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchPostPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostFailed, "", dispatchedAck.Error)
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.BatchPostPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+		if len(packetAck.Results) != len(data.Posts) {
+			return fmt.Errorf("acknowledgment has %d results for %d posts", len(packetAck.Results), len(data.Posts))
+		}
+
+		chainID, err := k.CounterpartyChainID(ctx, packet.SourcePort, packet.SourceChannel)
+		if err != nil {
+			return err
+		}
+
+		var (
+			postIDs   []string
+			errorMsgs []string
+			errorCode types.AckErrorCode
+		)
+		for i, result := range packetAck.Results {
+			if result.PostID == "" {
+				if result.Error != types.AckErrorUnspecified {
+					errorMsgs = append(errorMsgs, fmt.Sprintf("post %d: %s", i, result.Error))
+					if errorCode == types.AckErrorUnspecified {
+						errorCode = result.Error
+					}
+				}
+				continue
+			}
+			if _, err := k.AppendSentPost(
+				ctx,
+				types.SentPost{
+					Creator:   data.Posts[i].Creator,
+					PostID:    result.PostID,
+					Title:     data.Posts[i].Title,
+					Chain:     packet.DestinationPort + "-" + packet.DestinationChannel,
+					ChannelID: packet.SourceChannel,
+					ChainID:   chainID,
+				},
+			); err != nil {
+				return err
+			}
+			postIDs = append(postIDs, result.PostID)
+		}
+
+		status := types.OutboundPostDelivered
+		switch {
+		case len(postIDs) == 0:
+			status = types.OutboundPostFailed
+		case len(postIDs) < len(data.Posts):
+			status = types.OutboundPostPartial
+		}
+		outboundPost, err := k.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		outboundPost.Status = status
+		outboundPost.PostID = strings.Join(postIDs, ",")
+		outboundPost.Error = strings.Join(errorMsgs, "; ")
+		outboundPost.ErrorCode = errorCode
+		return k.SetOutboundPost(ctx, outboundPost)
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutBatchPostPacket responds to the case where a packet has not been transmitted because of a timeout.
+// Each post of the batch becomes a timeoutPost that can be retried on its own.
+func (k Keeper) OnTimeoutBatchPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BatchPostPacketData) error {
+	for _, post := range data.Posts {
+		if _, err := k.AppendTimeoutPost(
+			ctx,
+			types.TimeoutPost{
+				Creator:   post.Creator,
+				Title:     post.Title,
+				Content:   post.Content,
+				Chain:     packet.DestinationPort + "-" + packet.DestinationChannel,
+				Port:      packet.SourcePort,
+				ChannelID: packet.SourceChannel,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostTimedOut, "", "")
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvBatchPostPacket(t *testing.T) {
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	posts := []types.IbcPostPacketData{
+		{Title: "first", Creator: creator},
+		{Title: "", Creator: creator},
+		{Title: "third", Creator: creator},
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		data    types.BatchPostPacketData
+		params  func(*types.Params)
+		results []types.BatchPostResult
+		stored  []string
+		err     error
+	}{
+		{
+			desc: "best effort",
+			data: types.BatchPostPacketData{Posts: posts},
+			results: []types.BatchPostResult{
+				{PostID: "0"},
+				{Error: types.AckErrorInvalidPayload},
+				{PostID: "1"},
+			},
+			stored: []string{"first", "third"},
+		},
+		{
+			desc: "atomic",
+			data: types.BatchPostPacketData{Posts: posts, Atomic: true},
+			results: []types.BatchPostResult{
+				{},
+				{Error: types.AckErrorInvalidPayload},
+				{},
+			},
+		},
+		{
+			desc: "atomic accepted",
+			data: types.BatchPostPacketData{Posts: []types.IbcPostPacketData{posts[0], posts[2]}, Atomic: true},
+			results: []types.BatchPostResult{
+				{PostID: "0"},
+				{PostID: "1"},
+			},
+			stored: []string{"first", "third"},
+		},
+		{
+			desc: "empty",
+			data: types.BatchPostPacketData{},
+			err:  types.ErrEmptyBatch,
+		},
+		{
+			desc:   "too many posts",
+			data:   types.BatchPostPacketData{Posts: posts},
+			params: func(p *types.Params) { p.MaxBatchPosts = 2 },
+			err:    types.ErrBatchTooLarge,
+		},
+		{
+			desc:   "inbound disabled",
+			data:   types.BatchPostPacketData{Posts: posts},
+			params: func(p *types.Params) { p.InboundEnabled = false },
+			err:    types.ErrInboundDisabled,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			if tc.params != nil {
+				params := keeper.GetParams(ctx)
+				tc.params(&params)
+				require.NoError(t, keeper.SetParams(ctx, params))
+			}
+
+			ack, err := keeper.OnRecvBatchPostPacket(ctx, packet, tc.data)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.results, ack.Results)
+
+			stored := keepertest.AllPosts(t, ctx, keeper.Posts)
+			require.Len(t, stored, len(tc.stored))
+			for i, title := range tc.stored {
+				require.Equal(t, title, stored[i].Title)
+				require.Equal(t, packet.SourceChannel, stored[i].OriginChannel)
+			}
+		})
+	}
+}
+
+func TestOnAcknowledgementBatchPostPacket(t *testing.T) {
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	data := types.BatchPostPacketData{Posts: []types.IbcPostPacketData{
+		{Title: "first", Creator: creator},
+		{Title: "second", Creator: creator},
+	}}
+
+	for _, tc := range []struct {
+		desc      string
+		ack       channeltypes.Acknowledgement
+		status    types.OutboundPostStatus
+		postIDs   string
+		errorCode types.AckErrorCode
+		sent      []string
+	}{
+		{
+			desc:    "delivered",
+			ack:     channeltypes.NewResultAcknowledgement([]byte(`{"results":[{"postID":"4"},{"postID":"5"}]}`)),
+			status:  types.OutboundPostDelivered,
+			postIDs: "4,5",
+			sent:    []string{"first", "second"},
+		},
+		{
+			desc:      "partial",
+			ack:       channeltypes.NewResultAcknowledgement([]byte(`{"results":[{"error":"ACK_ERROR_CODE_INVALID_PAYLOAD"},{"postID":"5"}]}`)),
+			status:    types.OutboundPostPartial,
+			postIDs:   "5",
+			errorCode: types.AckErrorInvalidPayload,
+			sent:      []string{"second"},
+		},
+		{
+			desc:      "atomic failure",
+			ack:       channeltypes.NewResultAcknowledgement([]byte(`{"results":[{},{"error":"ACK_ERROR_CODE_INVALID_PAYLOAD"}]}`)),
+			status:    types.OutboundPostFailed,
+			errorCode: types.AckErrorInvalidPayload,
+		},
+		{
+			desc:      "error",
+			ack:       types.PacketAckError{Code: types.AckErrorDisabled}.Acknowledgement(),
+			status:    types.OutboundPostFailed,
+			errorCode: types.AckErrorDisabled,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.BlogKeeper(t)
+			require.NoError(t, keeper.SetOutboundPost(ctx, types.OutboundPost{ChannelID: packet.SourceChannel, Sequence: packet.Sequence, Status: types.OutboundPostPending}))
+
+			require.NoError(t, keeper.OnAcknowledgementBatchPostPacket(ctx, packet, data, tc.ack))
+
+			outboundPost, err := keeper.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+			require.NoError(t, err)
+			require.Equal(t, tc.status, outboundPost.Status)
+			require.Equal(t, tc.postIDs, outboundPost.PostID)
+			require.Equal(t, tc.errorCode, outboundPost.ErrorCode)
+			sentPosts := keepertest.AllSentPosts(t, ctx, keeper.SentPosts)
+			require.Len(t, sentPosts, len(tc.sent))
+			for i, title := range tc.sent {
+				require.Equal(t, title, sentPosts[i].Title)
+				require.Equal(t, packet.SourceChannel, sentPosts[i].ChannelID)
+			}
+		})
+	}
+
+	keeper, ctx := keepertest.BlogKeeper(t)
+	ack := channeltypes.NewResultAcknowledgement([]byte(`{"results":[{"postID":"4"}]}`))
+	require.Error(t, keeper.OnAcknowledgementBatchPostPacket(ctx, packet, data, ack))
+}
+
+func TestOnTimeoutBatchPostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	require.NoError(t, keeper.SetOutboundPost(ctx, types.OutboundPost{ChannelID: packet.SourceChannel, Sequence: packet.Sequence, Status: types.OutboundPostPending}))
+
+	require.NoError(t, keeper.OnTimeoutBatchPostPacket(ctx, packet, types.BatchPostPacketData{Posts: []types.IbcPostPacketData{
+		{Title: "first", Content: "hello", Creator: creator},
+		{Title: "second", Creator: creator},
+	}}))
+
+	timeoutPosts := keepertest.AllTimeoutPosts(t, ctx, keeper.TimeoutPosts)
+	require.Len(t, timeoutPosts, 2)
+	require.Equal(t, "hello", timeoutPosts[0].Content)
+	require.Equal(t, "second", timeoutPosts[1].Title)
+	require.Equal(t, packet.SourceChannel, timeoutPosts[1].ChannelID)
+	outboundPost, err := keeper.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostTimedOut, outboundPost.Status)
+}
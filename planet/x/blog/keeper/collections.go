@@ -0,0 +1,393 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+
+	"planet/x/blog/types"
+)
+
+// PostIndexes defines the secondary indexes of the posts
+type PostIndexes struct {
+	// Creator indexes the posts by creator
+	Creator *indexes.Multi[string, uint64, types.Post]
+	// Channel indexes the posts received by local channel
+	Channel *indexes.Multi[string, uint64, types.Post]
+	// Chain indexes the posts received by origin chain
+	Chain *indexes.Multi[string, uint64, types.Post]
+}
+
+func (i PostIndexes) IndexesList() []collections.Index[uint64, types.Post] {
+	return []collections.Index[uint64, types.Post]{
+		sparseIndex[types.Post]{Index: i.Creator, indexed: func(post types.Post) bool { return post.Creator != "" }},
+		sparseIndex[types.Post]{Index: i.Channel, indexed: func(post types.Post) bool { return post.ChannelID != "" }},
+		sparseIndex[types.Post]{Index: i.Chain, indexed: func(post types.Post) bool { return post.OriginChainID != "" }},
+	}
+}
+
+func newPostIndexes(sb *collections.SchemaBuilder) PostIndexes {
+	return PostIndexes{
+		Creator: indexes.NewMulti(
+			sb, collections.NewPrefix(types.PostCreatorKey), "posts_by_creator", slashStringKey, collections.Uint64Key,
+			func(_ uint64, post types.Post) (string, error) { return post.Creator, nil },
+		),
+		Channel: indexes.NewMulti(
+			sb, collections.NewPrefix(types.PostChannelKey), "posts_by_channel", slashStringKey, collections.Uint64Key,
+			func(_ uint64, post types.Post) (string, error) { return post.ChannelID, nil },
+		),
+		Chain: indexes.NewMulti(
+			sb, collections.NewPrefix(types.PostChainKey), "posts_by_chain", slashStringKey, collections.Uint64Key,
+			func(_ uint64, post types.Post) (string, error) { return post.OriginChainID, nil },
+		),
+	}
+}
+
+// SentPostIndexes defines the secondary indexes of the sentPosts
+type SentPostIndexes struct {
+	// Remote indexes the sentPosts by local channel and post ID on the counterparty chain
+	Remote *indexes.Unique[collections.Pair[string, string], uint64, types.SentPost]
+	// Chain indexes the sentPosts by destination chain ID
+	Chain *indexes.Multi[string, uint64, types.SentPost]
+}
+
+func (i SentPostIndexes) IndexesList() []collections.Index[uint64, types.SentPost] {
+	return []collections.Index[uint64, types.SentPost]{
+		sparseIndex[types.SentPost]{Index: i.Remote, indexed: func(sentPost types.SentPost) bool {
+			return sentPost.ChannelID != "" && sentPost.PostID != ""
+		}},
+		sparseIndex[types.SentPost]{Index: i.Chain, indexed: func(sentPost types.SentPost) bool { return sentPost.ChainID != "" }},
+	}
+}
+
+func newSentPostIndexes(sb *collections.SchemaBuilder) SentPostIndexes {
+	return SentPostIndexes{
+		Remote: indexes.NewUnique(
+			sb, collections.NewPrefix(types.SentPostRemoteKey), "sent_posts_by_remote",
+			collections.PairKeyCodec(slashStringKey, collections.StringKey), collections.Uint64Key,
+			func(_ uint64, sentPost types.SentPost) (collections.Pair[string, string], error) {
+				return collections.Join(sentPost.ChannelID, sentPost.PostID), nil
+			},
+		),
+		Chain: indexes.NewMulti(
+			sb, collections.NewPrefix(types.SentPostChainKey), "sent_posts_by_chain", slashStringKey, collections.Uint64Key,
+			func(_ uint64, sentPost types.SentPost) (string, error) { return sentPost.ChainID, nil },
+		),
+	}
+}
+
+// StagedUploadIndexes defines the secondary indexes of the stagedUploads
+type StagedUploadIndexes struct {
+	// Remote indexes the stagedUploads by receiving channel and upload ID on the counterparty chain
+	Remote *indexes.Unique[collections.Pair[string, string], uint64, types.StagedUpload]
+	// Expiry indexes the stagedUploads by expiry time in Unix nanoseconds
+	Expiry *indexes.Multi[int64, uint64, types.StagedUpload]
+}
+
+func (i StagedUploadIndexes) IndexesList() []collections.Index[uint64, types.StagedUpload] {
+	return []collections.Index[uint64, types.StagedUpload]{i.Remote, i.Expiry}
+}
+
+func newStagedUploadIndexes(sb *collections.SchemaBuilder) StagedUploadIndexes {
+	return StagedUploadIndexes{
+		Remote: indexes.NewUnique(
+			sb, collections.NewPrefix(types.StagedUploadRemoteKey), "staged_uploads_by_remote",
+			collections.PairKeyCodec(slashStringKey, collections.StringKey), collections.Uint64Key,
+			func(_ uint64, stagedUpload types.StagedUpload) (collections.Pair[string, string], error) {
+				return collections.Join(stagedUpload.ChannelID, stagedUpload.UploadID), nil
+			},
+		),
+		Expiry: indexes.NewMulti(
+			sb, collections.NewPrefix(types.StagedUploadExpiryKey), "staged_uploads_by_expiry", collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, stagedUpload types.StagedUpload) (int64, error) {
+				return stagedUpload.ExpiresAt.UnixNano(), nil
+			},
+		),
+	}
+}
+
+// AppendPost stores a post under the next post ID and returns the ID
+func (k Keeper) AppendPost(ctx context.Context, post types.Post) (uint64, error) {
+	id, err := k.PostSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	post.Id = id
+	return id, k.SetPost(ctx, post)
+}
+
+// SetPost stores a post and queues its replication
+func (k Keeper) SetPost(ctx context.Context, post types.Post) error {
+	if err := k.Posts.Set(ctx, post.Id, post); err != nil {
+		return err
+	}
+	return k.queuePostChange(ctx, types.ReplicationOpSet, post)
+}
+
+// RemovePost removes a post with its revisions and queues its replication
+func (k Keeper) RemovePost(ctx context.Context, id uint64) error {
+	if err := k.Posts.Remove(ctx, id); err != nil {
+		return err
+	}
+	if err := k.RemovePostRevisions(ctx, id); err != nil {
+		return err
+	}
+	return k.queuePostChange(ctx, types.ReplicationOpRemove, types.Post{Id: id})
+}
+
+// AppendSentPost stores a sentPost under the next sentPost ID and returns the ID
+func (k Keeper) AppendSentPost(ctx context.Context, sentPost types.SentPost) (uint64, error) {
+	id, err := k.SentPostSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sentPost.Id = id
+	return id, k.SentPosts.Set(ctx, id, sentPost)
+}
+
+// AppendTimeoutPost stores a timeoutPost under the next timeoutPost ID and returns the ID
+func (k Keeper) AppendTimeoutPost(ctx context.Context, timeoutPost types.TimeoutPost) (uint64, error) {
+	id, err := k.TimeoutPostSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	timeoutPost.Id = id
+	return id, k.TimeoutPosts.Set(ctx, id, timeoutPost)
+}
+
+// AppendBroadcast stores a broadcast under the next broadcast ID and returns the ID
+func (k Keeper) AppendBroadcast(ctx context.Context, broadcast types.Broadcast) (uint64, error) {
+	id, err := k.BroadcastSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	broadcast.Id = id
+	return id, k.Broadcasts.Set(ctx, id, broadcast)
+}
+
+// AppendOutboundPost stores an outboundPost under its channel and sequence with
+// the next outboundPost ID, and returns the ID
+func (k Keeper) AppendOutboundPost(ctx context.Context, outboundPost types.OutboundPost) (uint64, error) {
+	id, err := k.OutboundPostSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	outboundPost.Id = id
+	return id, k.SetOutboundPost(ctx, outboundPost)
+}
+
+// SetOutboundPost stores an outboundPost under its channel and sequence
+func (k Keeper) SetOutboundPost(ctx context.Context, outboundPost types.OutboundPost) error {
+	return k.OutboundPosts.Set(ctx, collections.Join(outboundPost.ChannelID, outboundPost.Sequence), outboundPost)
+}
+
+// SetPostRevision stores a postRevision under its post ID and revision
+func (k Keeper) SetPostRevision(ctx context.Context, postRevision types.PostRevision) error {
+	return k.Revisions.Set(ctx, collections.Join(postRevision.PostID, postRevision.Revision), postRevision)
+}
+
+// RemovePostRevisions removes all the revisions of a post
+func (k Keeper) RemovePostRevisions(ctx context.Context, postID uint64) error {
+	var keys []collections.Pair[uint64, uint64]
+	err := k.Revisions.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](postID), func(key collections.Pair[uint64, uint64], _ types.PostRevision) bool {
+		keys = append(keys, key)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.Revisions.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSentPostByRemote returns a sentPost from the local channel it was sent on and the post
+// ID on the counterparty chain
+func (k Keeper) GetSentPostByRemote(ctx context.Context, channelID string, postID string) (types.SentPost, error) {
+	id, err := k.SentPosts.Indexes.Remote.MatchExact(ctx, collections.Join(channelID, postID))
+	if err != nil {
+		return types.SentPost{}, err
+	}
+	return k.SentPosts.Get(ctx, id)
+}
+
+// walker is a collection whose records can be walked
+type walker[K, V any] interface {
+	Walk(ctx context.Context, ranger collections.Ranger[K], walkFunc func(K, V) bool) error
+}
+
+// Walk walks all the records of a collection in ascending key order until
+// walkFunc returns true. Unlike the Walk method it doesn't fail on an empty
+// collection, which collections reports as an invalid iterator
+func Walk[K, V any, W walker[K, V]](ctx context.Context, collection W, walkFunc func(K, V) bool) error {
+	err := collection.Walk(ctx, nil, walkFunc)
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return nil
+	}
+	return err
+}
+
+// sparseIndex only references the values accepted by indexed, so that records
+// without a reference key don't get an index entry
+type sparseIndex[V any] struct {
+	collections.Index[uint64, V]
+	indexed func(V) bool
+}
+
+func (i sparseIndex[V]) Reference(ctx context.Context, pk uint64, newValue V, lazyOldValue func() (V, error)) error {
+	if i.indexed(newValue) {
+		return i.Index.Reference(ctx, pk, newValue, lazyOldValue)
+	}
+	return i.Unreference(ctx, pk, lazyOldValue)
+}
+
+func (i sparseIndex[V]) Unreference(ctx context.Context, pk uint64, lazyOldValue func() (V, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case !i.indexed(oldValue):
+		return nil
+	}
+	return i.Index.Unreference(ctx, pk, lazyOldValue)
+}
+
+// slashStringKey encodes string keys the way the blog store always has: in
+// multipart keys a string is terminated by a slash rather than a zero byte.
+// Identifiers and addresses never contain a slash, and chain IDs containing
+// one are rejected by CounterpartyChainID before they are stored.
+var slashStringKey collcodec.KeyCodec[string] = slashString{}
+
+type slashString struct{}
+
+func (slashString) Encode(buffer []byte, key string) (int, error) {
+	return collections.StringKey.Encode(buffer, key)
+}
+
+func (slashString) Decode(buffer []byte) (int, string, error) {
+	return collections.StringKey.Decode(buffer)
+}
+
+func (slashString) Size(key string) int { return len(key) }
+
+func (slashString) EncodeJSON(value string) ([]byte, error) { return json.Marshal(value) }
+
+func (slashString) DecodeJSON(b []byte) (value string, err error) {
+	err = json.Unmarshal(b, &value)
+	return value, err
+}
+
+func (slashString) Stringify(key string) string { return key }
+
+func (slashString) KeyType() string { return "slash_string" }
+
+func (slashString) EncodeNonTerminal(buffer []byte, key string) (int, error) {
+	if bytes.IndexByte([]byte(key), '/') != -1 {
+		return 0, fmt.Errorf("%w: key %q contains a slash", collcodec.ErrEncoding, key)
+	}
+	n := copy(buffer, key)
+	buffer[n] = '/'
+	return n + 1, nil
+}
+
+func (slashString) DecodeNonTerminal(buffer []byte) (int, string, error) {
+	i := bytes.IndexByte(buffer, '/')
+	if i == -1 {
+		return 0, "", fmt.Errorf("%w: no slash terminated key in %X", collcodec.ErrEncoding, buffer)
+	}
+	return i + 1, string(buffer[:i]), nil
+}
+
+func (slashString) SizeNonTerminal(key string) int { return len(key) + 1 }
+
+// protoMessage is a gogoproto message whose pointer is *T
+type protoMessage[T any] interface {
+	*T
+	codec.ProtoMarshaler
+}
+
+// protoValue encodes the values of a collection with the module codec
+type protoValue[T any, PT protoMessage[T]] struct {
+	cdc codec.BinaryCodec
+}
+
+func newProtoValue[T any, PT protoMessage[T]](cdc codec.BinaryCodec) collcodec.ValueCodec[T] {
+	return protoValue[T, PT]{cdc: cdc}
+}
+
+func (c protoValue[T, PT]) Encode(value T) ([]byte, error) {
+	return c.cdc.Marshal(PT(&value))
+}
+
+func (c protoValue[T, PT]) Decode(b []byte) (value T, err error) {
+	err = c.cdc.Unmarshal(b, PT(&value))
+	return value, err
+}
+
+func (c protoValue[T, PT]) EncodeJSON(value T) ([]byte, error) {
+	return codec.ProtoMarshalJSON(PT(&value), nil)
+}
+
+func (c protoValue[T, PT]) DecodeJSON(b []byte) (value T, err error) {
+	err = jsonpb.Unmarshal(bytes.NewReader(b), PT(&value))
+	return value, err
+}
+
+func (c protoValue[T, PT]) Stringify(value T) string {
+	return PT(&value).String()
+}
+
+func (c protoValue[T, PT]) ValueType() string {
+	var value T
+	return "gogoproto/" + proto.MessageName(PT(&value))
+}
+
+// kvStore exposes a module store through the store interface of collections
+type kvStore struct {
+	store storetypes.KVStore
+}
+
+func openKVStore(storeKey storetypes.StoreKey) func(ctx context.Context) corestore.KVStore {
+	return func(ctx context.Context) corestore.KVStore {
+		return kvStore{store: sdk.UnwrapSDKContext(ctx).KVStore(storeKey)}
+	}
+}
+
+func (s kvStore) Get(key []byte) ([]byte, error) { return s.store.Get(key), nil }
+
+func (s kvStore) Has(key []byte) (bool, error) { return s.store.Has(key), nil }
+
+func (s kvStore) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+func (s kvStore) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
+
+func (s kvStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+func (s kvStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNPost(t testing.TB, keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Post {
+	items := make([]types.Post, n)
+	for i := range items {
+		id, err := keeper.AppendPost(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	return items
+}
+
+func createNSentPost(t testing.TB, keeper *keeper.Keeper, ctx sdk.Context, n int) []types.SentPost {
+	items := make([]types.SentPost, n)
+	for i := range items {
+		items[i].ChannelID = "channel-0"
+		items[i].PostID = strconv.Itoa(i + 100)
+		id, err := keeper.AppendSentPost(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	return items
+}
+
+func createNTimeoutPost(t testing.TB, keeper *keeper.Keeper, ctx sdk.Context, n int) []types.TimeoutPost {
+	items := make([]types.TimeoutPost, n)
+	for i := range items {
+		id, err := keeper.AppendTimeoutPost(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	return items
+}
+
+func TestPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		got, err := keeper.Posts.Get(ctx, item.Id)
+		require.NoError(t, err)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		require.NoError(t, keeper.Posts.Remove(ctx, item.Id))
+		_, err := keeper.Posts.Get(ctx, item.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+}
+
+func TestPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPost(t, keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keepertest.AllPosts(t, ctx, keeper.Posts)),
+	)
+}
+
+func TestPostCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNPost(t, keeper, ctx, 10)
+	count, err := keeper.PostSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(items)), count)
+}
+
+func TestPostIndexes(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	id, err := keeper.AppendPost(ctx, types.Post{Creator: "alice", OriginChannel: "channel-5", ChannelID: "channel-0", OriginChainID: "earth"})
+	require.NoError(t, err)
+
+	byCreator := func(creator string) []types.Post {
+		resp, err := keeper.PostByCreator(wctx, &types.QueryPostByCreatorRequest{Creator: creator})
+		require.NoError(t, err)
+		return resp.Post
+	}
+	byChannel := func(channelID string) []types.Post {
+		resp, err := keeper.PostByChannel(wctx, &types.QueryPostByChannelRequest{ChannelID: channelID})
+		require.NoError(t, err)
+		return resp.Post
+	}
+	byChain := func(chainID string) []types.Post {
+		resp, err := keeper.PostByChain(wctx, &types.QueryPostByChainRequest{ChainID: chainID})
+		require.NoError(t, err)
+		return resp.Post
+	}
+	require.Len(t, byCreator("alice"), 1)
+	require.Len(t, byChannel("channel-0"), 1)
+	require.Len(t, byChain("earth"), 1)
+
+	require.NoError(t, keeper.Posts.Set(ctx, id, types.Post{Id: id, Creator: "bob", OriginChannel: "channel-5", ChannelID: "channel-1", OriginChainID: "venus"}))
+	require.Empty(t, byCreator("alice"))
+	require.Empty(t, byChannel("channel-0"))
+	require.Empty(t, byChain("earth"))
+	require.Len(t, byCreator("bob"), 1)
+	require.Len(t, byChannel("channel-1"), 1)
+	require.Len(t, byChain("venus"), 1)
+
+	// Local posts have no channel nor origin chain and are left out of their indexes
+	require.NoError(t, keeper.Posts.Set(ctx, id, types.Post{Id: id, Creator: "bob"}))
+	require.Empty(t, byChannel("channel-1"))
+	require.Empty(t, byChain("venus"))
+	require.Len(t, byCreator("bob"), 1)
+
+	require.NoError(t, keeper.Posts.Remove(ctx, id))
+	require.Empty(t, byCreator("bob"))
+}
+
+func TestSentPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNSentPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		got, err := keeper.SentPosts.Get(ctx, item.Id)
+		require.NoError(t, err)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestSentPostGetByRemote(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNSentPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		got, err := keeper.GetSentPostByRemote(ctx, item.ChannelID, item.PostID)
+		require.NoError(t, err)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+		_, err = keeper.GetSentPostByRemote(ctx, "channel-1", item.PostID)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+
+	// Moving a sentPost to another remote post updates the index
+	item := items[0]
+	item.PostID = "1"
+	require.NoError(t, keeper.SentPosts.Set(ctx, item.Id, item))
+	_, err := keeper.GetSentPostByRemote(ctx, items[0].ChannelID, items[0].PostID)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	got, err := keeper.GetSentPostByRemote(ctx, item.ChannelID, item.PostID)
+	require.NoError(t, err)
+	require.Equal(t, item.Id, got.Id)
+}
+
+func TestSentPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNSentPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		require.NoError(t, keeper.SentPosts.Remove(ctx, item.Id))
+		_, err := keeper.SentPosts.Get(ctx, item.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = keeper.GetSentPostByRemote(ctx, item.ChannelID, item.PostID)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+}
+
+func TestSentPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNSentPost(t, keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keepertest.AllSentPosts(t, ctx, keeper.SentPosts)),
+	)
+}
+
+func TestSentPostCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNSentPost(t, keeper, ctx, 10)
+	count, err := keeper.SentPostSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(items)), count)
+}
+
+func TestTimeoutPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNTimeoutPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		got, err := keeper.TimeoutPosts.Get(ctx, item.Id)
+		require.NoError(t, err)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestTimeoutPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNTimeoutPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		require.NoError(t, keeper.TimeoutPosts.Remove(ctx, item.Id))
+		_, err := keeper.TimeoutPosts.Get(ctx, item.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+}
+
+func TestTimeoutPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNTimeoutPost(t, keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keepertest.AllTimeoutPosts(t, ctx, keeper.TimeoutPosts)),
+	)
+}
+
+func TestTimeoutPostCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNTimeoutPost(t, keeper, ctx, 10)
+	count, err := keeper.TimeoutPostSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(items)), count)
+}
+
+func TestWalkEmpty(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	require.NoError(t, keeper.Walk(ctx, k.Posts, func(uint64, types.Post) bool {
+		t.Fatal("walked an empty collection")
+		return true
+	}))
+	require.Empty(t, keepertest.AllTimeoutPosts(t, ctx, k.TimeoutPosts))
+}
+
+func TestStoreLayout(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+	id, err := k.AppendPost(ctx, types.Post{Creator: "alice", OriginChannel: "channel-1", ChannelID: "channel-0", OriginChainID: "earth"})
+	require.NoError(t, err)
+	sentID, err := k.AppendSentPost(ctx, types.SentPost{ChannelID: "channel-0", PostID: "7", Chain: "blog-channel-1", ChainID: "earth"})
+	require.NoError(t, err)
+	_, err = k.AppendOutboundPost(ctx, types.OutboundPost{ChannelID: "channel-0", Sequence: 4})
+	require.NoError(t, err)
+	require.NoError(t, k.SetPostRevision(ctx, types.PostRevision{PostID: id, Revision: 2}))
+	store := ctx.KVStore(storeKey)
+
+	// The collections keep the key layout of the blog store
+	require.Equal(t, sdk.Uint64ToBigEndian(1), store.Get(types.KeyPrefix(types.PostCountKey)))
+	require.Equal(t, sdk.Uint64ToBigEndian(1), store.Get(types.KeyPrefix(types.SentPostCountKey)))
+	require.Equal(t, sdk.Uint64ToBigEndian(1), store.Get(types.KeyPrefix(types.OutboundPostCountKey)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.OutboundPostKey)).Has(types.OutboundPostIndex("channel-0", 4)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostRevisionKey)).Has(types.PostRevisionIndex(id, 2)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostKey)).Has(sdk.Uint64ToBigEndian(id)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostCreatorKey)).Has(types.PostCreatorIndex("alice", id)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostChannelKey)).Has(types.PostChannelIndex("channel-0", id)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.PostChainKey)).Has(types.PostChainIndex("earth", id)))
+	require.True(t, prefix.NewStore(store, types.KeyPrefix(types.SentPostChainKey)).Has(types.SentPostChainIndex("earth", sentID)))
+	require.Equal(t,
+		sdk.Uint64ToBigEndian(sentID),
+		prefix.NewStore(store, types.KeyPrefix(types.SentPostRemoteKey)).Get(types.SentPostRemoteIndex("channel-0", "7")),
+	)
+
+	// Sent posts not delivered yet have no remote index entry
+	_, err = k.AppendSentPost(ctx, types.SentPost{Chain: "blog-channel-0"})
+	require.NoError(t, err)
+	iter := prefix.NewStore(store, types.KeyPrefix(types.SentPostRemoteKey)).Iterator(nil, nil)
+	defer iter.Close()
+	var entries int
+	for ; iter.Valid(); iter.Next() {
+		entries++
+	}
+	require.Equal(t, 1, entries)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"planet/x/blog/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// TransmitDeletePostPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version
+func (k Keeper) TransmitDeletePostPacket(
+	ctx sdk.Context,
+	packetData types.DeletePostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_DeletePostPacket{DeletePostPacket: &packetData},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvDeletePostPacket processes packet reception
+func (k Keeper) OnRecvDeletePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.DeletePostPacketData) (packetAck types.DeletePostPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+	if err := k.validateInboundChannel(ctx, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+
+	postID, err := strconv.ParseUint(data.PostID, 10, 64)
+	if err != nil {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot parse postID: %s", err)
+	}
+
+	post, err := k.Posts.Get(ctx, postID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return packetAck, sdkerrors.Wrapf(types.ErrPostNotFound, "post %d", postID)
+	}
+	if err != nil {
+		return packetAck, err
+	}
+
+	// Only the original author, writing from the chain the post came from, may delete it
+	if !isPacketAuthor(post, packet, data.Creator) {
+		return packetAck, sdkerrors.Wrapf(types.ErrUnauthorized, "post %d", postID)
+	}
+
+	if err := k.RemovePost(ctx, postID); err != nil {
+		return packetAck, err
+	}
+
+	packetAck.IsSuccess = true
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementDeletePostPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain.
+func (k Keeper) OnAcknowledgementDeletePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.DeletePostPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		// A post missing on the counterparty chain is as good as deleted
+		if types.ParsePacketAckError(dispatchedAck.Error).Code == types.AckErrorNotFound {
+			return k.markSentPostDeleted(ctx, packet.SourceChannel, data.PostID)
+		}
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.DeletePostPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		if packetAck.IsSuccess {
+			return k.markSentPostDeleted(ctx, packet.SourceChannel, data.PostID)
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// markSentPostDeleted marks the sentPost of a post deleted on the counterparty chain
+func (k Keeper) markSentPostDeleted(ctx sdk.Context, channelID string, postID string) error {
+	sentPost, err := k.GetSentPostByRemote(ctx, channelID, postID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sentPost.Deleted = true
+	return k.SentPosts.Set(ctx, sentPost.Id, sentPost)
+}
+
+// OnTimeoutDeletePostPacket responds to the case where a packet has not been transmitted because of a timeout.
+// The post is left untouched on both chains so the deletion can simply be sent again.
+func (k Keeper) OnTimeoutDeletePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.DeletePostPacketData) error {
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvDeletePostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:    types.PortID,
+		SourceChannel: "channel-0",
+	}
+
+	ack, err := keeper.OnRecvIbcPostPacket(ctx, packet, types.IbcPostPacketData{
+		Title:   "title",
+		Content: "content",
+		Creator: creator,
+	})
+	require.NoError(t, err)
+	postID, err := strconv.ParseUint(ack.PostID, 10, 64)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		packet  channeltypes.Packet
+		postID  string
+		creator string
+		err     error
+		deleted bool
+	}{
+		{
+			desc:    "other sender",
+			packet:  packet,
+			postID:  ack.PostID,
+			creator: sample.AccAddress(),
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc: "other channel",
+			packet: channeltypes.Packet{
+				SourcePort:    types.PortID,
+				SourceChannel: "channel-1",
+			},
+			postID:  ack.PostID,
+			creator: creator,
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc:    "not found",
+			packet:  packet,
+			postID:  "10",
+			creator: creator,
+			err:     types.ErrPostNotFound,
+		},
+		{
+			desc:    "author",
+			packet:  packet,
+			postID:  ack.PostID,
+			creator: creator,
+			deleted: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			deleteAck, recvErr := keeper.OnRecvDeletePostPacket(ctx, tc.packet, types.DeletePostPacketData{
+				PostID:  tc.postID,
+				Creator: tc.creator,
+			})
+			found, err := keeper.Posts.Has(ctx, postID)
+			require.NoError(t, err)
+			if tc.err != nil {
+				require.ErrorIs(t, recvErr, tc.err)
+				require.True(t, found)
+				return
+			}
+			require.NoError(t, recvErr)
+			require.Equal(t, tc.deleted, deleteAck.IsSuccess)
+			require.Equal(t, !tc.deleted, found)
+		})
+	}
+}
+
+func TestOnAcknowledgementDeletePostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	id, err := keeper.AppendSentPost(ctx, types.SentPost{Title: "title", ChannelID: "channel-0", PostID: "0"})
+	require.NoError(t, err)
+	// Another counterparty also names its channel channel-1 and has a post 0
+	sibling, err := keeper.AppendSentPost(ctx, types.SentPost{Title: "sibling", ChannelID: "channel-2", PostID: "0"})
+	require.NoError(t, err)
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	data := types.DeletePostPacketData{PostID: "0"}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte(`{"isSuccess":false}`))
+	require.NoError(t, keeper.OnAcknowledgementDeletePostPacket(ctx, packet, data, ack))
+	sentPost, err := keeper.SentPosts.Get(ctx, id)
+	require.NoError(t, err)
+	require.False(t, sentPost.Deleted)
+
+	ack = types.PacketAckError{Code: types.AckErrorUnauthorized}.Acknowledgement()
+	require.NoError(t, keeper.OnAcknowledgementDeletePostPacket(ctx, packet, data, ack))
+	sentPost, err = keeper.SentPosts.Get(ctx, id)
+	require.NoError(t, err)
+	require.False(t, sentPost.Deleted)
+
+	ack = channeltypes.NewResultAcknowledgement([]byte(`{"isSuccess":true}`))
+	require.NoError(t, keeper.OnAcknowledgementDeletePostPacket(ctx, packet, data, ack))
+	sentPost, err = keeper.SentPosts.Get(ctx, id)
+	require.NoError(t, err)
+	require.True(t, sentPost.Deleted)
+	sentPost, err = keeper.SentPosts.Get(ctx, sibling)
+	require.NoError(t, err)
+	require.False(t, sentPost.Deleted)
+
+	// A post already missing on the counterparty chain counts as deleted
+	missing, err := keeper.AppendSentPost(ctx, types.SentPost{Title: "missing", ChannelID: "channel-0", PostID: "1"})
+	require.NoError(t, err)
+	ack = types.PacketAckError{Code: types.AckErrorNotFound}.Acknowledgement()
+	require.NoError(t, keeper.OnAcknowledgementDeletePostPacket(ctx, packet, types.DeletePostPacketData{PostID: "1"}, ack))
+	sentPost, err = keeper.SentPosts.Get(ctx, missing)
+	require.NoError(t, err)
+	require.True(t, sentPost.Deleted)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"planet/x/blog/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// TransmitFetchPostPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version. Chains speaking only blog-1 can't answer fetches.
+func (k Keeper) TransmitFetchPostPacket(
+	ctx sdk.Context,
+	packetData types.FetchPostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if version := k.ChannelVersion(ctx, sourcePort, sourceChannel); version == types.Version1 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel %s has no post fetches, it speaks %s", sourceChannel, version)
+	}
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_FetchPostPacket{FetchPostPacket: &packetData},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvFetchPostPacket processes packet reception. The post is answered in the
+// acknowledgement, nothing is written.
+func (k Keeper) OnRecvFetchPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FetchPostPacketData) (packetAck types.FetchPostPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+	if err := k.validateInboundChannel(ctx, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+
+	id, err := strconv.ParseUint(data.PostID, 10, 64)
+	if err != nil {
+		return packetAck, sdkerrors.Wrapf(types.ErrInvalidPostID, "%q", data.PostID)
+	}
+	post, err := k.Posts.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return packetAck, sdkerrors.Wrapf(types.ErrPostNotFound, "post %d", id)
+	}
+	if err != nil {
+		return packetAck, err
+	}
+	packetAck.Post = post
+	packetAck.ReadHeight = ctx.BlockHeight()
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementFetchPostPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. The fetched post is cached as a
+// remotePost, which is dropped if the counterparty no longer has the post. A copy
+// read before the cached one, whose acknowledgement arrived late, is ignored.
+func (k Keeper) OnAcknowledgementFetchPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FetchPostPacketData, ack channeltypes.Acknowledgement) error {
+	key := collections.Join(packet.SourceChannel, data.PostID)
+
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		if types.ParsePacketAckError(dispatchedAck.Error).Code == types.AckErrorNotFound {
+			return k.RemotePosts.Remove(ctx, key)
+		}
+
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.FetchPostPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		// The counterparty must answer with a valid copy of the requested post.
+		// An invalid answer is not cached, and is only logged so the
+		// acknowledgement is still processed.
+		if err := validateFetchedPost(data, packetAck.Post); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("fetched post rejected on channel %s: %s", packet.SourceChannel, err))
+			return nil
+		}
+
+		cached, err := k.RemotePosts.Get(ctx, key)
+		switch {
+		case err == nil && packetAck.ReadHeight < cached.ReadHeight:
+			return nil
+		case err != nil && !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+
+		return k.RemotePosts.Set(ctx, key, types.RemotePost{
+			ChannelID:   packet.SourceChannel,
+			PostID:      data.PostID,
+			Post:        packetAck.Post,
+			FetchHeight: ctx.BlockHeight(),
+			FetchedAt:   ctx.BlockTime(),
+			ReadHeight:  packetAck.ReadHeight,
+		})
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutFetchPostPacket responds to the case where a packet has not been transmitted because of a timeout.
+// The cached copy, if any, is kept.
+func (k Keeper) OnTimeoutFetchPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FetchPostPacketData) error {
+	return nil
+}
+
+// validateFetchedPost checks that the fetched post is a valid copy of the requested post
+func validateFetchedPost(data types.FetchPostPacketData, post types.Post) error {
+	if id, err := strconv.ParseUint(data.PostID, 10, 64); err != nil || post.Id != id {
+		return sdkerrors.Wrapf(types.ErrInvalidPostID, "fetched post %d, requested %s", post.Id, data.PostID)
+	}
+	return types.ValidateRemotePost(post)
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvFetchPostPacket(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		postID string
+		params func(*types.Params)
+		err    error
+	}{
+		{
+			desc:   "found",
+			postID: "0",
+		},
+		{
+			desc:   "not found",
+			postID: "1",
+			err:    types.ErrPostNotFound,
+		},
+		{
+			desc:   "invalid post ID",
+			postID: "first",
+			err:    types.ErrInvalidPostID,
+		},
+		{
+			desc:   "inbound disabled",
+			postID: "0",
+			params: func(p *types.Params) { p.InboundEnabled = false },
+			err:    types.ErrInboundDisabled,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(4)
+			post := types.Post{Title: "title", Content: "content", Creator: "creator"}
+			var err error
+			post.Id, err = k.AppendPost(ctx, post)
+			require.NoError(t, err)
+			if tc.params != nil {
+				params := k.GetParams(ctx)
+				tc.params(&params)
+				require.NoError(t, k.SetParams(ctx, params))
+			}
+
+			ack, err := k.OnRecvFetchPostPacket(ctx, packet, types.FetchPostPacketData{PostID: tc.postID})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, post, ack.Post)
+			require.Equal(t, int64(4), ack.ReadHeight)
+		})
+	}
+}
+
+func TestOnAcknowledgementFetchPostPacket(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	data := types.FetchPostPacketData{PostID: "3"}
+	key := collections.Join(packet.SourceChannel, data.PostID)
+	cached := types.RemotePost{ChannelID: packet.SourceChannel, PostID: "3", Post: types.Post{Id: 3, Title: "old"}, ReadHeight: 5}
+	fetched := func(post types.Post, readHeight int64) channeltypes.Acknowledgement {
+		return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.FetchPostPacketAck{
+			Post:       post,
+			ReadHeight: readHeight,
+		}))
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		ack    channeltypes.Acknowledgement
+		cached *types.RemotePost
+	}{
+		{
+			desc:   "fetched",
+			ack:    fetched(types.Post{Id: 3, Title: "new"}, 6),
+			cached: &types.RemotePost{ChannelID: packet.SourceChannel, PostID: "3", Post: types.Post{Id: 3, Title: "new"}, FetchHeight: 7, ReadHeight: 6},
+		},
+		{
+			// The acknowledgement of an earlier fetch arrived late
+			desc:   "read before the cached copy",
+			ack:    fetched(types.Post{Id: 3, Title: "older"}, 4),
+			cached: &cached,
+		},
+		{
+			// An invalid answer is not cached, the cached copy is kept
+			desc:   "another post",
+			ack:    fetched(types.Post{Id: 4, Title: "new"}, 6),
+			cached: &cached,
+		},
+		{
+			desc:   "invalid post",
+			ack:    fetched(types.Post{Id: 3, Title: "new", Content: "\x00"}, 6),
+			cached: &cached,
+		},
+		{
+			desc: "not found",
+			ack:  types.NewPacketAckError(types.ErrPostNotFound).Acknowledgement(),
+		},
+		{
+			desc:   "disabled",
+			ack:    types.NewPacketAckError(types.ErrInboundDisabled).Acknowledgement(),
+			cached: &cached,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			ctx = ctx.WithBlockHeight(7)
+			require.NoError(t, k.RemotePosts.Set(ctx, key, cached))
+
+			require.NoError(t, k.OnAcknowledgementFetchPostPacket(ctx, packet, data, tc.ack))
+
+			remotePost, err := k.RemotePosts.Get(ctx, key)
+			if tc.cached == nil {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			tc.cached.FetchedAt = remotePost.FetchedAt
+			require.Equal(t, *tc.cached, remotePost)
+		})
+	}
+}
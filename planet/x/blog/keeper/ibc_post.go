@@ -13,7 +13,8 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
-// TransmitIbcPostPacket transmits the packet over IBC with the specified source port and source channel
+// TransmitIbcPostPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version
 func (k Keeper) TransmitIbcPostPacket(
 	ctx sdk.Context,
 	packetData types.IbcPostPacketData,
@@ -21,52 +22,123 @@ func (k Keeper) TransmitIbcPostPacket(
 	sourceChannel string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) (uint64, error) {
 	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
 		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	packetBytes, err := packetData.GetBytes()
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_IbcPostPacket{IbcPostPacket: &packetData},
+	})
 	if err != nil {
-		return 0, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %w", err)
+		return 0, err
 	}
 
 	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
 }
 
+// transmitTrackedIbcPostPacket transmits the packet and tracks it with an outboundPost
+// until it is acknowledged or times out
+func (k Keeper) transmitTrackedIbcPostPacket(
+	ctx sdk.Context,
+	packetData types.IbcPostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutTimestamp uint64,
+	memo string,
+) (sequence uint64, outboundPostID uint64, err error) {
+	sequence, err = k.TransmitIbcPostPacket(
+		ctx,
+		packetData,
+		sourcePort,
+		sourceChannel,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		memo,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	outboundPostID, err = k.trackOutboundPost(ctx, sourcePort, sourceChannel, types.OutboundPost{
+		Sequence: sequence,
+		Creator:  packetData.Creator,
+		Title:    packetData.Title,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return sequence, outboundPostID, nil
+}
+
+// trackOutboundPost appends a pending outboundPost for a packet sent on the channel
+func (k Keeper) trackOutboundPost(ctx sdk.Context, sourcePort, sourceChannel string, outboundPost types.OutboundPost) (uint64, error) {
+	// Same chain format as sentPost and timeoutPost: the destination port and channel
+	if channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel); found {
+		outboundPost.Chain = channel.Counterparty.PortId + "-" + channel.Counterparty.ChannelId
+	}
+	outboundPost.ChannelID = sourceChannel
+	outboundPost.Status = types.OutboundPostPending
+
+	return k.AppendOutboundPost(ctx, outboundPost)
+}
+
 // OnRecvIbcPostPacket processes packet reception
 func (k Keeper) OnRecvIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData) (packetAck types.IbcPostPacketAck, err error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return packetAck, err
 	}
+	if err := k.validateInboundPost(ctx, packet.DestinationChannel, data.Title, data.Content); err != nil {
+		return packetAck, err
+	}
+
+	chainID, err := k.CounterpartyChainID(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return packetAck, err
+	}
 
-	// TODO: packet reception logic // Done
-	id := k.AppendPost(
+	id, err := k.AppendPost(
 		ctx,
 		types.Post{
-			Creator: packet.SourcePort + "-" + packet.SourceChannel + "-" + data.Creator,
-			Title:   data.Title,
-			Content: data.Content,
+			Creator:        data.Creator,
+			Title:          data.Title,
+			Content:        data.Content,
+			OriginPort:     packet.SourcePort,
+			OriginChannel:  packet.SourceChannel,
+			OriginChainID:  chainID,
+			ChannelID:      packet.DestinationChannel,
+			ReceivedHeight: ctx.BlockHeight(),
+			ReceivedAt:     ctx.BlockTime(),
 		},
 	)
+	if err != nil {
+		return packetAck, err
+	}
 
 	packetAck.PostID = strconv.FormatUint(id, 10)
 
 	return packetAck, nil
 }
 
+// isPacketAuthor reports whether the post was received on the packet channel from
+// the packet origin and written by the given sender
+func isPacketAuthor(post types.Post, packet channeltypes.Packet, sender string) bool {
+	return post.ChannelID == packet.DestinationChannel &&
+		post.OriginPort == packet.SourcePort &&
+		post.OriginChannel == packet.SourceChannel &&
+		post.Creator == sender
+}
+
 // OnAcknowledgementIbcPostPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain.
 func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData, ack channeltypes.Acknowledgement) error {
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-
-		// TODO: failed acknowledgement logic
-		_ = dispatchedAck.Error
-
-		return nil
+		return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostFailed, "", dispatchedAck.Error)
 	case *channeltypes.Acknowledgement_Result:
 		// Decode the packet acknowledgment
 		var packetAck types.IbcPostPacketAck
@@ -76,18 +148,24 @@ func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channelty
 			return errors.New("cannot unmarshal acknowledgment")
 		}
 
-		// TODO: successful acknowledgement logic	// Done
-		k.AppendSentPost(
+		chainID, err := k.CounterpartyChainID(ctx, packet.SourcePort, packet.SourceChannel)
+		if err != nil {
+			return err
+		}
+		if _, err := k.AppendSentPost(
 			ctx,
 			types.SentPost{
-				Creator: data.Creator,
-				PostID:  packetAck.PostID,
-				Title:   data.Title,
-				Chain:   packet.DestinationPort + "-" + packet.DestinationChannel,
+				Creator:   data.Creator,
+				PostID:    packetAck.PostID,
+				Title:     data.Title,
+				Chain:     packet.DestinationPort + "-" + packet.DestinationChannel,
+				ChannelID: packet.SourceChannel,
+				ChainID:   chainID,
 			},
-		)
-
-		return nil
+		); err != nil {
+			return err
+		}
+		return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostDelivered, packetAck.PostID, "")
 	default:
 		// The counter-party module doesn't implement the correct acknowledgment format
 		return errors.New("invalid acknowledgment format")
@@ -97,15 +175,19 @@ func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channelty
 // OnTimeoutIbcPostPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IbcPostPacketData) error {
 
-	// TODO: packet timeout logic	// Done
-	k.AppendTimeoutPost(
+	if _, err := k.AppendTimeoutPost(
 		ctx,
 		types.TimeoutPost{
-			Creator: data.Creator,
-			Title:   data.Title,
-			Chain:   packet.DestinationPort + "-" + packet.DestinationChannel,
+			Creator:   data.Creator,
+			Title:     data.Title,
+			Content:   data.Content,
+			Chain:     packet.DestinationPort + "-" + packet.DestinationChannel,
+			Port:      packet.SourcePort,
+			ChannelID: packet.SourceChannel,
+			Memo:      k.sentPacketMemo(ctx, packet),
 		},
-	)
-
-	return nil
+	); err != nil {
+		return err
+	}
+	return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostTimedOut, "", "")
 }
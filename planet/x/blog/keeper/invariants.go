@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"planet/x/blog/types"
+)
+
+// RegisterInvariants registers all blog invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "counts", CountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "indexes", IndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "channel-capabilities", ChannelCapabilityInvariant(k))
+}
+
+// AllInvariants runs all invariants of the blog module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			CountInvariant(k),
+			IndexInvariant(k),
+			ChannelCapabilityInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// CountInvariant checks that every stored post, sent post, timeout post, broadcast
+// and outbound post has an ID below the count of its store
+func CountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		postCount, err := k.PostSeq.Peek(ctx)
+		if err == nil {
+			err = Walk(ctx, k.Posts, func(id uint64, _ types.Post) bool {
+				if id >= postCount {
+					broken++
+					msg += fmt.Sprintf("\tpost %d is not below the post count %d\n", id, postCount)
+				}
+				return false
+			})
+		}
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read posts: %s\n", err)
+		}
+		sentPostCount, err := k.SentPostSeq.Peek(ctx)
+		if err == nil {
+			err = Walk(ctx, k.SentPosts, func(id uint64, _ types.SentPost) bool {
+				if id >= sentPostCount {
+					broken++
+					msg += fmt.Sprintf("\tsentPost %d is not below the sentPost count %d\n", id, sentPostCount)
+				}
+				return false
+			})
+		}
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read sentPosts: %s\n", err)
+		}
+		timeoutPostCount, err := k.TimeoutPostSeq.Peek(ctx)
+		if err == nil {
+			err = Walk(ctx, k.TimeoutPosts, func(id uint64, _ types.TimeoutPost) bool {
+				if id >= timeoutPostCount {
+					broken++
+					msg += fmt.Sprintf("\ttimeoutPost %d is not below the timeoutPost count %d\n", id, timeoutPostCount)
+				}
+				return false
+			})
+		}
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read timeoutPosts: %s\n", err)
+		}
+		broadcastCount, err := k.BroadcastSeq.Peek(ctx)
+		if err == nil {
+			err = Walk(ctx, k.Broadcasts, func(id uint64, _ types.Broadcast) bool {
+				if id >= broadcastCount {
+					broken++
+					msg += fmt.Sprintf("\tbroadcast %d is not below the broadcast count %d\n", id, broadcastCount)
+				}
+				return false
+			})
+		}
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read broadcasts: %s\n", err)
+		}
+		stagedUploadCount, err := k.StagedUploadSeq.Peek(ctx)
+		if err == nil {
+			err = Walk(ctx, k.StagedUploads, func(id uint64, _ types.StagedUpload) bool {
+				if id >= stagedUploadCount {
+					broken++
+					msg += fmt.Sprintf("\tstagedUpload %d is not below the stagedUpload count %d\n", id, stagedUploadCount)
+				}
+				return false
+			})
+		}
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read stagedUploads: %s\n", err)
+		}
+		outboundPostCount, err := k.OutboundPostSeq.Peek(ctx)
+		if err == nil {
+			err = Walk(ctx, k.OutboundPosts, func(_ collections.Pair[string, uint64], outboundPost types.OutboundPost) bool {
+				if outboundPost.Id >= outboundPostCount {
+					broken++
+					msg += fmt.Sprintf("\toutboundPost %d is not below the outboundPost count %d\n", outboundPost.Id, outboundPostCount)
+				}
+				return false
+			})
+		}
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read outboundPosts: %s\n", err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "counts",
+			fmt.Sprintf("%d stored IDs at or above their count\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// IndexInvariant checks that the secondary indexes and the primary store agree
+func IndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		creatorEntries := make(map[string]uint64)
+		channelEntries := make(map[string]uint64)
+		originEntries := make(map[string]uint64)
+		err := Walk(ctx, k.Posts, func(id uint64, post types.Post) bool {
+			if post.Creator != "" {
+				creatorEntries[string(types.PostCreatorIndex(post.Creator, id))] = id
+			}
+			if post.ChannelID != "" {
+				channelEntries[string(types.PostChannelIndex(post.ChannelID, id))] = id
+			}
+			if post.OriginChainID != "" {
+				originEntries[string(types.PostChainIndex(post.OriginChainID, id))] = id
+			}
+			return false
+		})
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read posts: %s\n", err)
+		}
+		remoteEntries := make(map[string]uint64)
+		chainEntries := make(map[string]uint64)
+		err = Walk(ctx, k.SentPosts, func(id uint64, sentPost types.SentPost) bool {
+			if sentPost.ChannelID != "" && sentPost.PostID != "" {
+				remoteEntries[string(types.SentPostRemoteIndex(sentPost.ChannelID, sentPost.PostID))] = id
+			}
+			if sentPost.ChainID != "" {
+				chainEntries[string(types.SentPostChainIndex(sentPost.ChainID, id))] = id
+			}
+			return false
+		})
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read sentPosts: %s\n", err)
+		}
+
+		for _, index := range []struct {
+			key     string
+			entries map[string]uint64
+			unique  bool
+		}{
+			{types.PostCreatorKey, creatorEntries, false},
+			{types.PostChannelKey, channelEntries, false},
+			{types.PostChainKey, originEntries, false},
+			{types.SentPostRemoteKey, remoteEntries, true},
+			{types.SentPostChainKey, chainEntries, false},
+		} {
+			n, m := k.checkIndex(ctx, index.key, index.entries, index.unique)
+			broken += n
+			msg += m
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "indexes",
+			fmt.Sprintf("%d index entries disagree with the primary store\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// checkIndex compares the entries of the index stored under key with the entries
+// expected from the primary store, from index key to record ID. Unique indexes
+// store the record ID, other indexes store no value.
+func (k Keeper) checkIndex(ctx sdk.Context, key string, expected map[string]uint64, unique bool) (broken int, msg string) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(key))
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+	defer iterator.Close()
+
+	found := make(map[string]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		id, ok := expected[string(iterator.Key())]
+		value := []byte{}
+		if unique {
+			value = sdk.Uint64ToBigEndian(id)
+		}
+		if !ok || !bytes.Equal(iterator.Value(), value) {
+			broken++
+			msg += fmt.Sprintf("\tindex entry %s%X doesn't match a stored record\n", key, iterator.Key())
+			continue
+		}
+		found[string(iterator.Key())] = true
+	}
+	entries := make([]string, 0, len(expected))
+	for entry := range expected {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+	for _, entry := range entries {
+		if !found[entry] {
+			broken++
+			msg += fmt.Sprintf("\trecord %d is missing its index entry %s%X\n", expected[entry], key, entry)
+		}
+	}
+
+	return broken, msg
+}
+
+// ChannelCapabilityInvariant checks that every sent post that references a channel was
+// sent through a local channel the module holds the capability of.
+func ChannelCapabilityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		port := k.GetPort(ctx)
+		err := Walk(ctx, k.SentPosts, func(id uint64, sentPost types.SentPost) bool {
+			if sentPost.ChannelID == "" {
+				return false
+			}
+			if _, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(port, sentPost.ChannelID)); !ok {
+				broken++
+				msg += fmt.Sprintf("\tsentPost %d references channel %s without a module channel capability\n", id, sentPost.ChannelID)
+			}
+			return false
+		})
+		if err != nil {
+			broken++
+			msg += fmt.Sprintf("\tcannot read sentPosts: %s\n", err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "channel-capabilities",
+			fmt.Sprintf("%d sent posts reference channels the module doesn't own\n%s", broken, msg),
+		), broken != 0
+	}
+}
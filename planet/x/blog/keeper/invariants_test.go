@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestInvariants(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		corrupt   func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey)
+		invariant func(k keeper.Keeper) sdk.Invariant
+	}{
+		{
+			desc: "post above count",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				require.NoError(t, k.Posts.Set(ctx, 10, types.Post{Id: 10, Title: "title"}))
+			},
+			invariant: keeper.CountInvariant,
+		},
+		{
+			desc: "sentPost above count",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				require.NoError(t, k.SentPostSeq.Set(ctx, 0))
+			},
+			invariant: keeper.CountInvariant,
+		},
+		{
+			desc: "timeoutPost above count",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				require.NoError(t, k.TimeoutPosts.Set(ctx, 10, types.TimeoutPost{Id: 10}))
+			},
+			invariant: keeper.CountInvariant,
+		},
+		{
+			desc: "missing remote index",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostRemoteKey))
+				store.Delete(types.SentPostRemoteIndex(keepertest.TestChannelID, "7"))
+			},
+			invariant: keeper.IndexInvariant,
+		},
+		{
+			desc: "dangling remote index",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostRemoteKey))
+				store.Set(types.SentPostRemoteIndex(keepertest.TestChannelID, "8"), sdk.Uint64ToBigEndian(0))
+			},
+			invariant: keeper.IndexInvariant,
+		},
+		{
+			desc: "missing creator index",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostCreatorKey))
+				store.Delete(types.PostCreatorIndex("alice", 0))
+			},
+			invariant: keeper.IndexInvariant,
+		},
+		{
+			desc: "stale channel index",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostChannelKey))
+				store.Set(types.PostChannelIndex("channel-9", 0), []byte{})
+			},
+			invariant: keeper.IndexInvariant,
+		},
+		{
+			desc: "stale origin chain index",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostChainKey))
+				store.Set(types.PostChainIndex("venus", 0), []byte{})
+			},
+			invariant: keeper.IndexInvariant,
+		},
+		{
+			desc: "missing chain index",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostChainKey))
+				store.Delete(types.SentPostChainIndex("counterparty", 0))
+			},
+			invariant: keeper.IndexInvariant,
+		},
+		{
+			desc: "unknown channel",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				_, err := k.AppendSentPost(ctx, types.SentPost{PostID: "8", ChannelID: "channel-9"})
+				require.NoError(t, err)
+			},
+			invariant: keeper.ChannelCapabilityInvariant,
+		},
+		{
+			desc: "counterparty channel",
+			corrupt: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, storeKey storetypes.StoreKey) {
+				// the counterparty ID of an owned channel isn't a local channel
+				_, err := k.AppendSentPost(ctx, types.SentPost{PostID: "8", ChannelID: keepertest.TestCounterpartyChannelID})
+				require.NoError(t, err)
+			},
+			invariant: keeper.ChannelCapabilityInvariant,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+			_, err := k.AppendPost(ctx, types.Post{Title: "title", Creator: "alice", OriginChannel: keepertest.TestCounterpartyChannelID, ChannelID: keepertest.TestChannelID, OriginChainID: "counterparty"})
+			require.NoError(t, err)
+			_, err = k.AppendSentPost(ctx, types.SentPost{PostID: "7", ChannelID: keepertest.TestChannelID, Chain: "blog-" + keepertest.TestCounterpartyChannelID, ChainID: "counterparty"})
+			require.NoError(t, err)
+			_, err = k.AppendSentPost(ctx, types.SentPost{Title: "not delivered"})
+			require.NoError(t, err)
+			_, err = k.AppendTimeoutPost(ctx, types.TimeoutPost{Title: "title"})
+			require.NoError(t, err)
+
+			_, broken := keeper.AllInvariants(*k)(ctx)
+			require.False(t, broken)
+
+			tc.corrupt(t, k, ctx, storeKey)
+			msg, broken := tc.invariant(*k)(ctx)
+			require.True(t, broken, msg)
+			_, broken = keeper.AllInvariants(*k)(ctx)
+			require.True(t, broken)
+		})
+	}
+}
@@ -3,30 +3,72 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 
 	"planet/x/blog/types"
 )
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   storetypes.StoreKey
-		memKey     storetypes.StoreKey
-		paramstore paramtypes.Subspace
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+		memKey   storetypes.StoreKey
 
 		channelKeeper types.ChannelKeeper
 		portKeeper    types.PortKeeper
 		scopedKeeper  exported.ScopedKeeper
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		Schema         collections.Schema
+		PostSeq        collections.Sequence
+		Posts          *collections.IndexedMap[uint64, types.Post, PostIndexes]
+		SentPostSeq    collections.Sequence
+		SentPosts      *collections.IndexedMap[uint64, types.SentPost, SentPostIndexes]
+		TimeoutPostSeq collections.Sequence
+		TimeoutPosts   collections.Map[uint64, types.TimeoutPost]
+		BroadcastSeq   collections.Sequence
+		Broadcasts     collections.Map[uint64, types.Broadcast]
+		// OutboundPostSeq numbers the outboundPosts, which track the packets
+		// sent by source channel and packet sequence
+		OutboundPostSeq collections.Sequence
+		OutboundPosts   collections.Map[collections.Pair[string, uint64], types.OutboundPost]
+		// Revisions archive the previous versions of the posts by post ID and
+		// revision
+		Revisions collections.Map[collections.Pair[uint64, uint64], types.PostRevision]
+		// StagedUploadSeq and StagedUploads hold the posts received in chunks
+		// until all of their chunks, stored in UploadChunks, have arrived
+		StagedUploadSeq collections.Sequence
+		StagedUploads   *collections.IndexedMap[uint64, types.StagedUpload, StagedUploadIndexes]
+		UploadChunks    collections.Map[collections.Pair[uint64, uint32], types.UploadChunk]
+		// RemotePosts caches the posts fetched from counterparty chains by
+		// local channel and remote post ID
+		RemotePosts collections.Map[collections.Pair[string, string], types.RemotePost]
+		// ReplicationSubscriptions are the channels the local posts are
+		// replicated on, PostChanges queues their changes until the end of the block
+		ReplicationSubscriptions collections.Map[string, types.ReplicationSubscription]
+		PostChangeSeq            collections.Sequence
+		PostChanges              collections.Map[uint64, types.PostChange]
+		// Replicas are the channels the posts of the counterparty chain are
+		// replicated from, into ReplicatedPosts by local channel and remote
+		// post ID. They are kept apart from the fetched RemotePosts.
+		Replicas        collections.Map[string, types.Replica]
+		ReplicatedPosts collections.Map[collections.Pair[string, string], types.RemotePost]
+		// InboundPacketCounts counts the packets accepted on each channel during
+		// the block, they are cleared at the end of the block
+		InboundPacketCounts collections.Map[string, uint64]
 	}
 )
 
@@ -34,27 +76,103 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey storetypes.StoreKey,
-	ps paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	scopedKeeper exported.ScopedKeeper,
-
+	authority string,
 ) *Keeper {
-	// set KeyTable if it has not already been set
-	if !ps.HasKeyTable() {
-		ps = ps.WithKeyTable(types.ParamKeyTable())
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
+	sb := collections.NewSchemaBuilderFromAccessor(openKVStore(storeKey))
+	k := &Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		memKey:   memKey,
 
 		channelKeeper: channelKeeper,
 		portKeeper:    portKeeper,
 		scopedKeeper:  scopedKeeper,
+		authority:     authority,
+
+		PostSeq: collections.NewSequence(sb, collections.NewPrefix(types.PostCountKey), "post_sequence"),
+		Posts: collections.NewIndexedMap(
+			sb, collections.NewPrefix(types.PostKey), "posts",
+			collections.Uint64Key, newProtoValue[types.Post](cdc), newPostIndexes(sb),
+		),
+		SentPostSeq: collections.NewSequence(sb, collections.NewPrefix(types.SentPostCountKey), "sent_post_sequence"),
+		SentPosts: collections.NewIndexedMap(
+			sb, collections.NewPrefix(types.SentPostKey), "sent_posts",
+			collections.Uint64Key, newProtoValue[types.SentPost](cdc), newSentPostIndexes(sb),
+		),
+		TimeoutPostSeq: collections.NewSequence(sb, collections.NewPrefix(types.TimeoutPostCountKey), "timeout_post_sequence"),
+		TimeoutPosts: collections.NewMap(
+			sb, collections.NewPrefix(types.TimeoutPostKey), "timeout_posts",
+			collections.Uint64Key, newProtoValue[types.TimeoutPost](cdc),
+		),
+		BroadcastSeq: collections.NewSequence(sb, collections.NewPrefix(types.BroadcastCountKey), "broadcast_sequence"),
+		Broadcasts: collections.NewMap(
+			sb, collections.NewPrefix(types.BroadcastKey), "broadcasts",
+			collections.Uint64Key, newProtoValue[types.Broadcast](cdc),
+		),
+		OutboundPostSeq: collections.NewSequence(sb, collections.NewPrefix(types.OutboundPostCountKey), "outbound_post_sequence"),
+		OutboundPosts: collections.NewMap(
+			sb, collections.NewPrefix(types.OutboundPostKey), "outbound_posts",
+			collections.PairKeyCodec(slashStringKey, collections.Uint64Key), newProtoValue[types.OutboundPost](cdc),
+		),
+		Revisions: collections.NewMap(
+			sb, collections.NewPrefix(types.PostRevisionKey), "post_revisions",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), newProtoValue[types.PostRevision](cdc),
+		),
+		StagedUploadSeq: collections.NewSequence(sb, collections.NewPrefix(types.StagedUploadCountKey), "staged_upload_sequence"),
+		StagedUploads: collections.NewIndexedMap(
+			sb, collections.NewPrefix(types.StagedUploadKey), "staged_uploads",
+			collections.Uint64Key, newProtoValue[types.StagedUpload](cdc), newStagedUploadIndexes(sb),
+		),
+		UploadChunks: collections.NewMap(
+			sb, collections.NewPrefix(types.UploadChunkKey), "upload_chunks",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), newProtoValue[types.UploadChunk](cdc),
+		),
+		RemotePosts: collections.NewMap(
+			sb, collections.NewPrefix(types.RemotePostKey), "remote_posts",
+			collections.PairKeyCodec(slashStringKey, collections.StringKey), newProtoValue[types.RemotePost](cdc),
+		),
+		ReplicationSubscriptions: collections.NewMap(
+			sb, collections.NewPrefix(types.ReplicationSubscriptionKey), "replication_subscriptions",
+			collections.StringKey, newProtoValue[types.ReplicationSubscription](cdc),
+		),
+		PostChangeSeq: collections.NewSequence(sb, collections.NewPrefix(types.PostChangeCountKey), "post_change_sequence"),
+		PostChanges: collections.NewMap(
+			sb, collections.NewPrefix(types.PostChangeKey), "post_changes",
+			collections.Uint64Key, newProtoValue[types.PostChange](cdc),
+		),
+		Replicas: collections.NewMap(
+			sb, collections.NewPrefix(types.ReplicaKey), "replicas",
+			collections.StringKey, newProtoValue[types.Replica](cdc),
+		),
+		ReplicatedPosts: collections.NewMap(
+			sb, collections.NewPrefix(types.ReplicatedPostKey), "replicated_posts",
+			collections.PairKeyCodec(slashStringKey, collections.StringKey), newProtoValue[types.RemotePost](cdc),
+		),
+		InboundPacketCounts: collections.NewMap(
+			sb, collections.NewPrefix(types.InboundPacketCountKey), "inbound_packet_counts",
+			collections.StringKey, collections.Uint64Value,
+		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // ----------------------------------------------------------------------------
@@ -71,6 +189,34 @@ func (k Keeper) ChanCloseInit(ctx sdk.Context, portID, channelID string) error {
 	return k.channelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap)
 }
 
+// CounterpartyChainID resolves the chain ID of the counterparty through the client
+// state of the channel's connection. Clients that don't track a chain ID resolve to
+// an empty string. Chain IDs the indexes by chain can't store are rejected.
+func (k Keeper) CounterpartyChainID(ctx sdk.Context, portID, channelID string) (string, error) {
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return "", nil
+	}
+	if err := types.ValidateChainID(tmClientState.ChainId); err != nil {
+		return "", err
+	}
+	return tmClientState.ChainId, nil
+}
+
+// GetOpenChannels returns the open channels of the module port
+func (k Keeper) GetOpenChannels(ctx sdk.Context) (channels []channeltypes.IdentifiedChannel) {
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, k.GetPort(ctx)) {
+		if channel.PortId == k.GetPort(ctx) && channel.State == channeltypes.OPEN {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
 // IsBound checks if the IBC app module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"planet/x/blog/exported"
+	v10 "planet/x/blog/migrations/v10"
+	v11 "planet/x/blog/migrations/v11"
+	v12 "planet/x/blog/migrations/v12"
+	v2 "planet/x/blog/migrations/v2"
+	v3 "planet/x/blog/migrations/v3"
+	v4 "planet/x/blog/migrations/v4"
+	v5 "planet/x/blog/migrations/v5"
+	v6 "planet/x/blog/migrations/v6"
+	v7 "planet/x/blog/migrations/v7"
+	v8 "planet/x/blog/migrations/v8"
+	v9 "planet/x/blog/migrations/v9"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.originChainID)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates from version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.localChannelID)
+}
+
+// Migrate9to10 migrates from version 9 to 10.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate10to11 migrates from version 10 to 11.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	return v11.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate11to12 migrates from version 11 to 12.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	return v12.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.localChannelID, m.keeper.originChainID)
+}
+
+// originChainID resolves the chain ID of a counterparty port and channel through
+// the local channel connected to it. It is empty when no such channel is open.
+func (k Keeper) originChainID(ctx sdk.Context, port, channel string) string {
+	for _, ch := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, k.GetPort(ctx)) {
+		if ch.Counterparty.PortId != port || ch.Counterparty.ChannelId != channel {
+			continue
+		}
+		chainID, err := k.CounterpartyChainID(ctx, ch.PortId, ch.ChannelId)
+		if err != nil {
+			return ""
+		}
+		return chainID
+	}
+	return ""
+}
+
+// localChannelID resolves the local channel the module owns that is connected to a
+// counterparty port and channel. It is empty when there is no such channel, or
+// when several counterparties use the same port and channel.
+func (k Keeper) localChannelID(ctx sdk.Context, port, channel string) string {
+	var channelID string
+	for _, ch := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, k.GetPort(ctx)) {
+		if ch.PortId != k.GetPort(ctx) || ch.Counterparty.PortId != port || ch.Counterparty.ChannelId != channel {
+			continue
+		}
+		if _, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(ch.PortId, ch.ChannelId)); !ok {
+			continue
+		}
+		if channelID != "" {
+			return ""
+		}
+		channelID = ch.ChannelId
+	}
+	return channelID
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendBatchPost(goCtx context.Context, msg *types.MsgSendBatchPost) (*types.MsgSendBatchPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if maxPosts := k.GetParams(ctx).MaxBatchPosts; uint64(len(msg.Posts)) > maxPosts {
+		return nil, sdkerrors.Wrapf(types.ErrBatchTooLarge, "%d posts, max %d", len(msg.Posts), maxPosts)
+	}
+
+	// Construct the packet
+	packet := types.BatchPostPacketData{
+		Posts:  make([]types.IbcPostPacketData, len(msg.Posts)),
+		Atomic: msg.Atomic,
+	}
+	for i, post := range msg.Posts {
+		if err := k.validatePost(ctx, msg.ChannelID, post.Title, post.Content); err != nil {
+			return nil, sdkerrors.Wrapf(err, "post %d", i)
+		}
+		packet.Posts[i] = types.IbcPostPacketData{
+			Title:   post.Title,
+			Content: post.Content,
+			Creator: msg.Creator,
+		}
+	}
+
+	// Transmit the packet
+	sequence, err := k.TransmitBatchPostPacket(
+		ctx,
+		packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The batch is tracked by a single outboundPost, titled after its first post
+	id, err := k.trackOutboundPost(ctx, msg.Port, msg.ChannelID, types.OutboundPost{
+		Sequence: sequence,
+		Creator:  msg.Creator,
+		Title:    msg.Posts[0].Title,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendBatchPostResponse{
+		Sequence:       sequence,
+		OutboundPostID: id,
+		ChannelID:      msg.ChannelID,
+	}, nil
+}
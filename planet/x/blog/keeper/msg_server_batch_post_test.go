@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMsgServerSendBatchPost(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	params.MaxBatchPosts = 2
+	params.MaxTitleLength = 8
+	require.NoError(t, k.SetParams(ctx, params))
+	creator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgSendBatchPost
+		err  error
+	}{
+		{
+			desc: "too many posts",
+			msg:  *types.NewMsgSendBatchPost(creator, types.PortID, keepertest.TestChannelID, 0, []types.BatchPost{{Title: "a"}, {Title: "b"}, {Title: "c"}}, false),
+			err:  types.ErrBatchTooLarge,
+		},
+		{
+			desc: "title too long",
+			msg:  *types.NewMsgSendBatchPost(creator, types.PortID, keepertest.TestChannelID, 0, []types.BatchPost{{Title: "a"}, {Title: "too long title"}}, false),
+			err:  types.ErrTitleTooLong,
+		},
+		{
+			desc: "channel not owned",
+			msg:  *types.NewMsgSendBatchPost(creator, types.PortID, "channel-9", 0, []types.BatchPost{{Title: "a"}}, false),
+			err:  channeltypes.ErrChannelCapabilityNotFound,
+		},
+		{
+			// The channels of the test keeper speak blog-1
+			desc: "blog-1 channel",
+			msg:  *types.NewMsgSendBatchPost(creator, types.PortID, keepertest.TestChannelID, 0, []types.BatchPost{{Title: "a"}}, false),
+			err:  types.ErrInvalidVersion,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SendBatchPost(wctx, &tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+	require.Empty(t, keepertest.AllOutboundPosts(t, ctx, k.OutboundPosts))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) BroadcastPost(goCtx context.Context, msg *types.MsgBroadcastPost) (*types.MsgBroadcastPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelIDs := msg.ChannelIDs
+	if msg.AllChannels {
+		channelIDs = k.broadcastChannels(ctx)
+		if len(channelIDs) == 0 {
+			return nil, sdkerrors.Wrap(types.ErrChannelNotAllowed, "no open blog channel to broadcast on")
+		}
+	}
+
+	packet := types.IbcPostPacketData{
+		Title:   msg.Title,
+		Content: msg.Content,
+		Creator: msg.Creator,
+	}
+	broadcast := types.Broadcast{
+		Creator: msg.Creator,
+		Title:   msg.Title,
+	}
+	timeoutTimestamp := k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp)
+
+	// The message fails as a whole if any packet can't be sent, which reverts
+	// the packets already sent on the other channels
+	for _, channelID := range channelIDs {
+		if err := k.validatePost(ctx, channelID, msg.Title, msg.Content); err != nil {
+			return nil, err
+		}
+		sequence, _, err := k.transmitTrackedIbcPostPacket(
+			ctx,
+			packet,
+			k.GetPort(ctx),
+			channelID,
+			timeoutTimestamp,
+			msg.Memo,
+		)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "channel %s", channelID)
+		}
+		broadcast.Destinations = append(broadcast.Destinations, types.BroadcastDestination{
+			ChannelID: channelID,
+			Sequence:  sequence,
+		})
+	}
+
+	id, err := k.AppendBroadcast(ctx, broadcast)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBroadcastPostResponse{BroadcastID: id}, nil
+}
+
+// broadcastChannels returns the open blog channels the params allow to send posts on
+func (k Keeper) broadcastChannels(ctx sdk.Context) (channelIDs []string) {
+	params := k.GetParams(ctx)
+	for _, channel := range k.GetOpenChannels(ctx) {
+		if params.IsChannelAllowed(channel.ChannelId) {
+			channelIDs = append(channelIDs, channel.ChannelId)
+		}
+	}
+	return channelIDs
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMsgServerBroadcastPost(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc     string
+		msg      types.MsgBroadcastPost
+		channels []string
+		err      error
+	}{
+		{
+			desc:     "all channels",
+			msg:      types.MsgBroadcastPost{Creator: creator, AllChannels: true, Title: "title", Content: "content"},
+			channels: []string{keepertest.TestChannelID},
+		},
+		{
+			desc:     "channel list",
+			msg:      types.MsgBroadcastPost{Creator: creator, ChannelIDs: []string{keepertest.TestChannelID}, Title: "title"},
+			channels: []string{keepertest.TestChannelID},
+		},
+		{
+			desc: "channel not owned",
+			msg:  types.MsgBroadcastPost{Creator: creator, ChannelIDs: []string{keepertest.TestChannelID, "channel-9"}, Title: "title"},
+			err:  channeltypes.ErrChannelCapabilityNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := srv.BroadcastPost(wctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			broadcast, err := k.Broadcasts.Get(ctx, res.BroadcastID)
+			require.NoError(t, err)
+			require.Equal(t, creator, broadcast.Creator)
+			require.Len(t, broadcast.Destinations, len(tc.channels))
+			for i, channelID := range tc.channels {
+				require.Equal(t, channelID, broadcast.Destinations[i].ChannelID)
+			}
+		})
+	}
+
+	// Channels the params don't allow are left out of broadcasts to all channels
+	params := types.DefaultParams()
+	params.AllowedChannels = []string{"channel-9"}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err := srv.BroadcastPost(wctx, &types.MsgBroadcastPost{Creator: creator, AllChannels: true, Title: "title"})
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendChunkedPost(goCtx context.Context, msg *types.MsgSendChunkedPost) (*types.MsgSendChunkedPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validatePost(ctx, msg.ChannelID, msg.Title, msg.Content); err != nil {
+		return nil, err
+	}
+
+	// The upload is identified by the ID of the outboundPost tracking its first chunk
+	nextID, err := k.OutboundPostSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uploadID := strconv.FormatUint(nextID, 10)
+	chunks := types.SplitContent(msg.Content, msg.ChunkSize)
+	contentHash := types.ContentHash([]byte(msg.Content))
+	timeoutTimestamp := k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp)
+
+	sequences := make([]uint64, len(chunks))
+	for i, chunk := range chunks {
+		// Construct the packet
+		packet := types.PostChunkPacketData{
+			UploadID:    uploadID,
+			Title:       msg.Title,
+			Creator:     msg.Creator,
+			ContentHash: contentHash,
+			Index:       uint32(i),
+			ChunkCount:  uint32(len(chunks)),
+			Chunk:       chunk,
+		}
+
+		// Transmit the packet
+		sequence, err := k.TransmitPostChunkPacket(
+			ctx,
+			packet,
+			msg.Port,
+			msg.ChannelID,
+			clienttypes.ZeroHeight(),
+			timeoutTimestamp,
+			msg.Memo,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Each chunk is tracked by an outboundPost
+		if _, err := k.trackOutboundPost(ctx, msg.Port, msg.ChannelID, types.OutboundPost{
+			Sequence: sequence,
+			Creator:  msg.Creator,
+			Title:    msg.Title,
+			UploadID: uploadID,
+		}); err != nil {
+			return nil, err
+		}
+		sequences[i] = sequence
+	}
+
+	return &types.MsgSendChunkedPostResponse{
+		UploadID:  uploadID,
+		Sequences: sequences,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMsgServerSendChunkedPost(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	params.MaxContentLength = 8
+	require.NoError(t, k.SetParams(ctx, params))
+	creator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgSendChunkedPost
+		err  error
+	}{
+		{
+			desc: "content too long",
+			msg:  *types.NewMsgSendChunkedPost(creator, types.PortID, keepertest.TestChannelID, 0, "title", "too long content", 4),
+			err:  types.ErrContentTooLong,
+		},
+		{
+			desc: "channel not owned",
+			msg:  *types.NewMsgSendChunkedPost(creator, types.PortID, "channel-9", 0, "title", "content", 4),
+			err:  channeltypes.ErrChannelCapabilityNotFound,
+		},
+		{
+			// The channels of the test keeper speak blog-1
+			desc: "blog-1 channel",
+			msg:  *types.NewMsgSendChunkedPost(creator, types.PortID, keepertest.TestChannelID, 0, "title", "content", 4),
+			err:  types.ErrInvalidVersion,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SendChunkedPost(wctx, &tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+	require.Empty(t, keepertest.AllOutboundPosts(t, ctx, k.OutboundPosts))
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SendDeletePost(goCtx context.Context, msg *types.MsgSendDeletePost) (*types.MsgSendDeletePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsChannelAllowed(msg.ChannelID) {
+		return nil, sdkerrors.Wrap(types.ErrChannelNotAllowed, msg.ChannelID)
+	}
+
+	// Construct the packet
+	var packet types.DeletePostPacketData
+
+	packet.PostID = msg.PostID
+	packet.Creator = msg.Creator
+
+	// Transmit the packet
+	_, err := k.TransmitDeletePostPacket(
+		ctx,
+		packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendDeletePostResponse{}, nil
+}
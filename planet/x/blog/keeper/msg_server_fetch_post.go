@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) FetchPost(goCtx context.Context, msg *types.MsgFetchPost) (*types.MsgFetchPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsChannelAllowed(msg.ChannelID) {
+		return nil, sdkerrors.Wrap(types.ErrChannelNotAllowed, msg.ChannelID)
+	}
+
+	// Construct the packet
+	packet := types.FetchPostPacketData{
+		PostID: msg.PostID,
+	}
+
+	// Transmit the packet
+	sequence, err := k.TransmitFetchPostPacket(
+		ctx,
+		packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFetchPostResponse{
+		Sequence: sequence,
+	}, nil
+}
@@ -4,14 +4,15 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"planet/x/blog/types"
 )
 
 func (k msgServer) SendIbcPost(goCtx context.Context, msg *types.MsgSendIbcPost) (*types.MsgSendIbcPostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: logic before transmitting the packet
+	if err := k.validatePost(ctx, msg.ChannelID, msg.Title, msg.Content); err != nil {
+		return nil, err
+	}
 
 	// Construct the packet
 	var packet types.IbcPostPacketData
@@ -21,17 +22,21 @@ func (k msgServer) SendIbcPost(goCtx context.Context, msg *types.MsgSendIbcPost)
 	packet.Creator = msg.Creator // Add Creator Done
 
 	// Transmit the packet
-	_, err := k.TransmitIbcPostPacket(
+	sequence, id, err := k.transmitTrackedIbcPostPacket(
 		ctx,
 		packet,
 		msg.Port,
 		msg.ChannelID,
-		clienttypes.ZeroHeight(),
-		msg.TimeoutTimestamp,
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSendIbcPostResponse{}, nil
+	return &types.MsgSendIbcPostResponse{
+		Sequence:       sequence,
+		OutboundPostID: id,
+		ChannelID:      msg.ChannelID,
+	}, nil
 }
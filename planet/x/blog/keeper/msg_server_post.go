@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := validatePostLength(k.GetParams(ctx), msg.Title, msg.Content); err != nil {
+		return nil, err
+	}
+
+	var post = types.Post{
+		Creator: msg.Creator,
+		Title:   msg.Title,
+		Content: msg.Content,
+	}
+
+	id, err := k.AppendPost(
+		ctx,
+		post,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPostCreated{
+		Id:      id,
+		Creator: msg.Creator,
+		Title:   msg.Title,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePostResponse{
+		Id: id,
+	}, nil
+}
+
+func (k msgServer) UpdatePost(goCtx context.Context, msg *types.MsgUpdatePost) (*types.MsgUpdatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, err := k.getOwnLocalPost(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePostLength(k.GetParams(ctx), msg.Title, msg.Content); err != nil {
+		return nil, err
+	}
+
+	if err := k.archivePostRevision(ctx, post, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	post.Title = msg.Title
+	post.Content = msg.Content
+	post.Revision++
+	if err := k.SetPost(ctx, post); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPostUpdated{
+		Id:      msg.Id,
+		Creator: msg.Creator,
+		Title:   msg.Title,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePostResponse{}, nil
+}
+
+func (k msgServer) DeletePost(goCtx context.Context, msg *types.MsgDeletePost) (*types.MsgDeletePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getOwnLocalPost(ctx, msg.Id, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.RemovePost(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPostDeleted{
+		Id:      msg.Id,
+		Creator: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeletePostResponse{}, nil
+}
+
+// getOwnLocalPost returns the post if it was written on this chain by the creator.
+// Posts received over IBC can only be changed by packets from their origin.
+func (k Keeper) getOwnLocalPost(ctx sdk.Context, id uint64, creator string) (types.Post, error) {
+	post, err := k.Posts.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return post, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "post %d", id)
+	}
+	if err != nil {
+		return post, err
+	}
+	if post.OriginChannel != "" {
+		return post, sdkerrors.Wrapf(types.ErrUnauthorized, "post %d was received over IBC", id)
+	}
+	if creator != post.Creator {
+		return post, sdkerrors.Wrapf(types.ErrUnauthorized, "post %d", id)
+	}
+	return post, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestPostMsgServerCreate(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+	for i := 0; i < 5; i++ {
+		resp, err := srv.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: "title"})
+		require.NoError(t, err)
+		require.Equal(t, i, int(resp.Id))
+	}
+	post, err := k.Posts.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, creator, post.Creator)
+	require.Empty(t, post.OriginChannel)
+
+	_, err = srv.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: strings.Repeat("a", 257)})
+	require.ErrorIs(t, err, types.ErrTitleTooLong)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "planet.blog.EventPostCreated", events[len(events)-1].Type)
+}
+
+func TestPostMsgServerUpdate(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdatePost
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdatePost{Creator: creator, Title: "updated"},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUpdatePost{Creator: "B", Title: "updated"},
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc:    "Unauthorized remote post",
+			request: &types.MsgUpdatePost{Creator: creator, Id: 1, Title: "updated"},
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc:    "Too long",
+			request: &types.MsgUpdatePost{Creator: creator, Title: "updated", Content: strings.Repeat("a", 10241)},
+			err:     types.ErrContentTooLong,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgUpdatePost{Creator: creator, Id: 10, Title: "updated"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+
+			_, err := srv.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: "title"})
+			require.NoError(t, err)
+			_, err = k.AppendPost(ctx, types.Post{Creator: creator, Title: "title", OriginPort: types.PortID, OriginChannel: "channel-1"})
+			require.NoError(t, err)
+
+			_, err = srv.UpdatePost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				if post, err := k.Posts.Get(ctx, tc.request.Id); err == nil {
+					require.Equal(t, "title", post.Title)
+				}
+				return
+			}
+			require.NoError(t, err)
+			post, err := k.Posts.Get(ctx, tc.request.Id)
+			require.NoError(t, err)
+			require.Equal(t, "updated", post.Title)
+		})
+	}
+}
+
+func TestPostMsgServerDelete(t *testing.T) {
+	creator := "A"
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgDeletePost
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgDeletePost{Creator: creator},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgDeletePost{Creator: "B"},
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc:    "Unauthorized remote post",
+			request: &types.MsgDeletePost{Creator: creator, Id: 1},
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgDeletePost{Creator: creator, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			srv := keeper.NewMsgServerImpl(*k)
+			wctx := sdk.WrapSDKContext(ctx)
+
+			_, err := srv.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: "title"})
+			require.NoError(t, err)
+			_, err = k.AppendPost(ctx, types.Post{Creator: creator, Title: "title", OriginPort: types.PortID, OriginChannel: "channel-1"})
+			require.NoError(t, err)
+
+			_, err = srv.DeletePost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			_, err = k.Posts.Get(ctx, tc.request.Id)
+			require.ErrorIs(t, err, collections.ErrNotFound)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) RetryTimeoutPost(goCtx context.Context, msg *types.MsgRetryTimeoutPost) (*types.MsgRetryTimeoutPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timeoutPost, err := k.TimeoutPosts.Get(ctx, msg.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "timeoutPost %d", msg.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if msg.Creator != timeoutPost.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "timeoutPost %d", msg.Id)
+	}
+
+	// Retry on the original channel unless another one is given. The remote post
+	// of an update only exists on the counterparty of the original channel.
+	port, channelID := timeoutPost.Port, timeoutPost.ChannelID
+	if timeoutPost.PostID != "" && (msg.Port != "" && msg.Port != port || msg.ChannelID != "" && msg.ChannelID != channelID) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "timeoutPost %d updates a post on channel %s and can't be retried on another channel", msg.Id, channelID)
+	}
+	if msg.Port != "" {
+		port = msg.Port
+	}
+	if msg.ChannelID != "" {
+		channelID = msg.ChannelID
+	}
+	if port == "" || channelID == "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no channel to retry timeoutPost %d on", msg.Id)
+	}
+	if err := k.validatePost(ctx, channelID, timeoutPost.Title, timeoutPost.Content); err != nil {
+		return nil, err
+	}
+	timeoutTimestamp := k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp)
+
+	var sequence uint64
+	if timeoutPost.PostID == "" {
+		sequence, _, err = k.transmitTrackedIbcPostPacket(
+			ctx,
+			types.IbcPostPacketData{
+				Title:   timeoutPost.Title,
+				Content: timeoutPost.Content,
+				Creator: timeoutPost.Creator,
+			},
+			port,
+			channelID,
+			timeoutTimestamp,
+			timeoutPost.Memo,
+		)
+	} else {
+		sequence, _, err = k.transmitTrackedUpdatePostPacket(
+			ctx,
+			types.UpdatePostPacketData{
+				PostID:       timeoutPost.PostID,
+				Title:        timeoutPost.Title,
+				Content:      timeoutPost.Content,
+				Creator:      timeoutPost.Creator,
+				BaseRevision: timeoutPost.BaseRevision,
+			},
+			port,
+			channelID,
+			timeoutTimestamp,
+			timeoutPost.Memo,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The packet is in flight again: a new timeout records it anew
+	if err := k.TimeoutPosts.Remove(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryTimeoutPostResponse{Sequence: sequence}, nil
+}
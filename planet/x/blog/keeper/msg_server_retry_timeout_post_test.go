@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMsgServerRetryTimeoutPost(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      keepertest.TestChannelID,
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-7",
+	}
+	require.NoError(t, k.OnTimeoutIbcPostPacket(ctx, packet, types.IbcPostPacketData{
+		Title:   "title",
+		Content: "content",
+		Creator: creator,
+	}))
+	require.NoError(t, k.OnTimeoutUpdatePostPacket(ctx, packet, types.UpdatePostPacketData{
+		PostID:  "3",
+		Title:   "title",
+		Content: "content",
+		Creator: creator,
+	}))
+	timeoutPosts := keepertest.AllTimeoutPosts(t, ctx, k.TimeoutPosts)
+	require.Len(t, timeoutPosts, 2)
+	require.Equal(t, "content", timeoutPosts[0].Content)
+	require.Equal(t, keepertest.TestChannelID, timeoutPosts[0].ChannelID)
+	require.Equal(t, "3", timeoutPosts[1].PostID)
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgRetryTimeoutPost
+		err  error
+	}{
+		{
+			desc: "not found",
+			msg:  types.MsgRetryTimeoutPost{Creator: creator, Id: 10, TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "unauthorized",
+			msg:  types.MsgRetryTimeoutPost{Creator: sample.AccAddress(), Id: 0, TimeoutTimestamp: 100},
+			err:  types.ErrUnauthorized,
+		},
+		{
+			desc: "channel not owned",
+			msg:  types.MsgRetryTimeoutPost{Creator: creator, Id: 0, Port: types.PortID, ChannelID: "channel-9", TimeoutTimestamp: 100},
+			err:  channeltypes.ErrChannelCapabilityNotFound,
+		},
+		{
+			desc: "update on another channel",
+			msg:  types.MsgRetryTimeoutPost{Creator: creator, Id: 1, Port: types.PortID, ChannelID: "channel-9", TimeoutTimestamp: 100},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc: "post",
+			msg:  types.MsgRetryTimeoutPost{Creator: creator, Id: 0, TimeoutTimestamp: 100},
+		},
+		{
+			desc: "update",
+			msg:  types.MsgRetryTimeoutPost{Creator: creator, Id: 1, Port: types.PortID, ChannelID: keepertest.TestChannelID, TimeoutTimestamp: 100},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.RetryTimeoutPost(wctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			_, err = k.TimeoutPosts.Get(ctx, tc.msg.Id)
+			require.ErrorIs(t, err, collections.ErrNotFound)
+		})
+	}
+
+	// The retried post is tracked again
+	outboundPosts := keepertest.AllOutboundPosts(t, ctx, k.OutboundPosts)
+	require.Len(t, outboundPosts, 1)
+	require.Equal(t, types.OutboundPostPending, outboundPosts[0].Status)
+}
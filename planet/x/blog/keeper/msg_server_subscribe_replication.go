@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"planet/x/blog/types"
+)
+
+func (k msgServer) SubscribeReplication(goCtx context.Context, msg *types.MsgSubscribeReplication) (*types.MsgSubscribeReplicationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsChannelAllowed(msg.ChannelID) {
+		return nil, sdkerrors.Wrap(types.ErrChannelNotAllowed, msg.ChannelID)
+	}
+
+	// The replica waits for the snapshot of the counterparty chain
+	has, err := k.Replicas.Has(ctx, msg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		if err := k.Replicas.Set(ctx, msg.ChannelID, types.Replica{ChannelID: msg.ChannelID}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Transmit the packet
+	sequence, err := k.TransmitSubscribeReplicationPacket(
+		ctx,
+		types.SubscribeReplicationPacketData{},
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubscribeReplicationResponse{
+		Sequence: sequence,
+	}, nil
+}
+
+func (k msgServer) ResyncReplica(goCtx context.Context, msg *types.MsgResyncReplica) (*types.MsgResyncReplicaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Replicas.Get(ctx, msg.ChannelID); errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.Wrapf(types.ErrReplicationNotFound, "channel %s", msg.ChannelID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Transmit the packet
+	sequence, err := k.TransmitSubscribeReplicationPacket(
+		ctx,
+		types.SubscribeReplicationPacketData{Resync: true},
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResyncReplicaResponse{
+		Sequence: sequence,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMsgServerSubscribeReplication(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	params.AllowedChannels = []string{keepertest.TestChannelID, "channel-9"}
+	require.NoError(t, k.SetParams(ctx, params))
+	creator := sample.AccAddress()
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgSubscribeReplication
+		err  error
+	}{
+		{
+			desc: "channel not allowed",
+			msg:  *types.NewMsgSubscribeReplication(creator, types.PortID, "channel-1", 0),
+			err:  types.ErrChannelNotAllowed,
+		},
+		{
+			desc: "channel not owned",
+			msg:  *types.NewMsgSubscribeReplication(creator, types.PortID, "channel-9", 0),
+			err:  channeltypes.ErrChannelCapabilityNotFound,
+		},
+		{
+			// The channels of the test keeper speak blog-1
+			desc: "blog-1 channel",
+			msg:  *types.NewMsgSubscribeReplication(creator, types.PortID, keepertest.TestChannelID, 0),
+			err:  types.ErrInvalidVersion,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SubscribeReplication(wctx, &tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestMsgServerResyncReplica(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	_, err := srv.ResyncReplica(wctx, types.NewMsgResyncReplica(creator, types.PortID, keepertest.TestChannelID, 0))
+	require.ErrorIs(t, err, types.ErrReplicationNotFound)
+
+	require.NoError(t, k.Replicas.Set(ctx, keepertest.TestChannelID, types.Replica{ChannelID: keepertest.TestChannelID, NextSequence: 4}))
+	_, err = srv.ResyncReplica(wctx, types.NewMsgResyncReplica(creator, types.PortID, keepertest.TestChannelID, 0))
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.MaxTitleLength = 10
+	params.AllowedChannels = []string{keepertest.TestChannelID}
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgUpdateParams
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg:  types.MsgUpdateParams{Authority: sample.AccAddress(), Params: params},
+			err:  govtypes.ErrInvalidSigner,
+		},
+		{
+			desc: "valid",
+			msg:  types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdateParams(wctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, params, k.GetParams(ctx))
+		})
+	}
+}
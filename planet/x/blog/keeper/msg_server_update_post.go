@@ -4,14 +4,15 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"planet/x/blog/types"
 )
 
 func (k msgServer) SendUpdatePost(goCtx context.Context, msg *types.MsgSendUpdatePost) (*types.MsgSendUpdatePostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: logic before transmitting the packet
+	if err := k.validatePost(ctx, msg.ChannelID, msg.Title, msg.Content); err != nil {
+		return nil, err
+	}
 
 	// Construct the packet
 	var packet types.UpdatePostPacketData
@@ -19,19 +20,25 @@ func (k msgServer) SendUpdatePost(goCtx context.Context, msg *types.MsgSendUpdat
 	packet.PostID = msg.PostID
 	packet.Title = msg.Title
 	packet.Content = msg.Content
+	packet.Creator = msg.Creator
+	packet.BaseRevision = msg.BaseRevision
 
 	// Transmit the packet
-	_, err := k.TransmitUpdatePostPacket(
+	sequence, id, err := k.transmitTrackedUpdatePostPacket(
 		ctx,
 		packet,
 		msg.Port,
 		msg.ChannelID,
-		clienttypes.ZeroHeight(),
-		msg.TimeoutTimestamp,
+		k.packetTimeoutTimestamp(ctx, msg.TimeoutTimestamp),
+		msg.Memo,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSendUpdatePostResponse{}, nil
+	return &types.MsgSendUpdatePostResponse{
+		Sequence:       sequence,
+		OutboundPostID: id,
+		ChannelID:      msg.ChannelID,
+	}, nil
 }
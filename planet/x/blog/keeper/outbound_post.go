@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// setOutboundPostStatus updates the status of the outboundPost tracking a packet, if any.
+// errMsg is the error acknowledgement of a failed packet, from which the error code is read.
+func (k Keeper) setOutboundPostStatus(
+	ctx sdk.Context,
+	channelID string,
+	sequence uint64,
+	status types.OutboundPostStatus,
+	postID string,
+	errMsg string,
+) error {
+	outboundPost, err := k.OutboundPosts.Get(ctx, collections.Join(channelID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	outboundPost.Status = status
+	outboundPost.PostID = postID
+	outboundPost.Error = errMsg
+	outboundPost.ErrorCode = types.ParsePacketAckError(errMsg).Code
+	return k.SetOutboundPost(ctx, outboundPost)
+}
+
+// setOutboundPostRevision records the counterparty revision of the post of an outboundPost
+func (k Keeper) setOutboundPostRevision(ctx sdk.Context, channelID string, sequence uint64, revision uint64) error {
+	outboundPost, err := k.OutboundPosts.Get(ctx, collections.Join(channelID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	outboundPost.Revision = revision
+	return k.SetOutboundPost(ctx, outboundPost)
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func createNOutboundPost(t testing.TB, keeper *keeper.Keeper, ctx sdk.Context, n int) []types.OutboundPost {
+	items := make([]types.OutboundPost, n)
+	for i := range items {
+		items[i].ChannelID = fmt.Sprintf("channel-%d", i%2)
+		items[i].Sequence = uint64(i)
+		items[i].Status = types.OutboundPostStatus(i%4 + 1)
+		items[i].UploadID = strconv.Itoa(i % 3)
+		id, err := keeper.AppendOutboundPost(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	return items
+}
+
+func TestOutboundPostGet(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNOutboundPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		got, err := keeper.OutboundPosts.Get(ctx, collections.Join(item.ChannelID, item.Sequence))
+		require.NoError(t, err)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestOutboundPostRemove(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNOutboundPost(t, keeper, ctx, 10)
+	for _, item := range items {
+		require.NoError(t, keeper.OutboundPosts.Remove(ctx, collections.Join(item.ChannelID, item.Sequence)))
+		_, err := keeper.OutboundPosts.Get(ctx, collections.Join(item.ChannelID, item.Sequence))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+}
+
+func TestOutboundPostGetAll(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNOutboundPost(t, keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keepertest.AllOutboundPosts(t, ctx, keeper.OutboundPosts)),
+	)
+}
+
+func TestOutboundPostCount(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	items := createNOutboundPost(t, keeper, ctx, 10)
+	count, err := keeper.OutboundPostSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(items)), count)
+}
+
+func TestOutboundPostLifecycle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	data := types.IbcPostPacketData{Title: "title", Content: "content"}
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{
+			Sequence:           sequence,
+			SourcePort:         types.PortID,
+			SourceChannel:      "channel-0",
+			DestinationPort:    types.PortID,
+			DestinationChannel: "channel-1",
+		}
+	}
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		_, err := keeper.AppendOutboundPost(ctx, types.OutboundPost{
+			ChannelID: "channel-0",
+			Sequence:  sequence,
+			Status:    types.OutboundPostPending,
+		})
+		require.NoError(t, err)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte(`{"postID":"7"}`))
+	require.NoError(t, keeper.OnAcknowledgementIbcPostPacket(ctx, packet(1), data, ack))
+	got, err := keeper.OutboundPosts.Get(ctx, collections.Join("channel-0", uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostDelivered, got.Status)
+	require.Equal(t, "7", got.PostID)
+
+	ack = channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Error{Error: "failure"}}
+	require.NoError(t, keeper.OnAcknowledgementIbcPostPacket(ctx, packet(2), data, ack))
+	got, err = keeper.OutboundPosts.Get(ctx, collections.Join("channel-0", uint64(2)))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostFailed, got.Status)
+	require.Equal(t, "failure", got.Error)
+
+	require.NoError(t, keeper.OnTimeoutIbcPostPacket(ctx, packet(3), data))
+	got, err = keeper.OutboundPosts.Get(ctx, collections.Join("channel-0", uint64(3)))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostTimedOut, got.Status)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"planet/x/blog/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// ChannelVersion returns the negotiated version of a channel. Channels the
+// channel keeper doesn't know speak blog-1.
+func (k Keeper) ChannelVersion(ctx sdk.Context, portID, channelID string) string {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || channel.Version == "" {
+		return types.Version1
+	}
+	return channel.Version
+}
+
+// encodePacket encodes the packet data sent on a channel in the format of its
+// version. The memo needs a blog-2 channel since blog-1 packets have no header.
+func (k Keeper) encodePacket(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	memo string,
+	data types.BlogPacketData,
+) ([]byte, error) {
+	version := k.ChannelVersion(ctx, sourcePort, sourceChannel)
+	if memo != "" && version == types.Version1 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel %s has no packet memo, it speaks %s", sourceChannel, version)
+	}
+
+	header := types.PacketHeader{
+		ChainID: ctx.ChainID(),
+		Memo:    memo,
+	}
+	packetBytes, err := types.EncodePacket(version, header, data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+	return packetBytes, nil
+}
+
+// DecodeRecvPacket decodes a packet received on one of the module channels. The
+// header of blog-2 packets must come from the counterparty chain of the channel.
+func (k Keeper) DecodeRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (types.PacketHeader, types.BlogPacketData, error) {
+	version := k.ChannelVersion(ctx, packet.DestinationPort, packet.DestinationChannel)
+	header, data, err := types.DecodePacket(version, packet.GetData())
+	if err != nil || version == types.Version1 {
+		return header, data, err
+	}
+
+	chainID, err := k.CounterpartyChainID(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return header, data, err
+	}
+	if chainID != "" && header.ChainID != chainID {
+		return header, data, sdkerrors.Wrapf(types.ErrInvalidPacketHeader, "sent by %s, channel %s is connected to %s", header.ChainID, packet.DestinationChannel, chainID)
+	}
+	return header, data, nil
+}
+
+// DecodeSentPacket decodes a packet sent on one of the module channels, when it
+// is acknowledged or times out
+func (k Keeper) DecodeSentPacket(ctx sdk.Context, packet channeltypes.Packet) (types.PacketHeader, types.BlogPacketData, error) {
+	version := k.ChannelVersion(ctx, packet.SourcePort, packet.SourceChannel)
+	return types.DecodePacket(version, packet.GetData())
+}
+
+// sentPacketMemo returns the memo of the header of a packet sent on one of the
+// module channels, empty for blog-1 packets
+func (k Keeper) sentPacketMemo(ctx sdk.Context, packet channeltypes.Packet) string {
+	header, _, err := k.DecodeSentPacket(ctx, packet)
+	if err != nil {
+		return ""
+	}
+	return header.Memo
+}
@@ -1,16 +1,126 @@
 package keeper
 
 import (
+	"errors"
+
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"planet/x/blog/types"
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
 
 // SetParams set the params
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// validatePost checks a post sent on the channel against the params. The chain
+// ID of the counterparty is checked before sending, since the sent post is
+// indexed by chain once acknowledged.
+func (k Keeper) validatePost(ctx sdk.Context, channelID, title, content string) error {
+	params := k.GetParams(ctx)
+	if !params.IsChannelAllowed(channelID) {
+		return sdkerrors.Wrap(types.ErrChannelNotAllowed, channelID)
+	}
+	if _, err := k.CounterpartyChainID(ctx, k.GetPort(ctx), channelID); err != nil {
+		return err
+	}
+	return validatePostLength(params, title, content)
+}
+
+// validatePostLength checks the title and content sizes against the params
+func validatePostLength(params types.Params, title, content string) error {
+	if uint64(len(title)) > params.MaxTitleLength {
+		return sdkerrors.Wrapf(types.ErrTitleTooLong, "%d bytes, max %d", len(title), params.MaxTitleLength)
+	}
+	if uint64(len(content)) > params.MaxContentLength {
+		return sdkerrors.Wrapf(types.ErrContentTooLong, "%d bytes, max %d", len(content), params.MaxContentLength)
+	}
+	return nil
+}
+
+// validateInboundPost checks a post received on the channel against the params
+func (k Keeper) validateInboundPost(ctx sdk.Context, channelID, title, content string) error {
+	if err := k.validateInboundChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return validatePostLength(k.GetParams(ctx), title, content)
+}
+
+// validateInboundChannel checks that packets may be received on the channel
+func (k Keeper) validateInboundChannel(ctx sdk.Context, channelID string) error {
+	params := k.GetParams(ctx)
+	if !params.InboundEnabled {
+		return types.ErrInboundDisabled
+	}
+	if !params.IsChannelAllowed(channelID) {
+		return sdkerrors.Wrap(types.ErrChannelNotAllowed, channelID)
+	}
+	return nil
+}
+
+// CountInboundPacket counts a received packet against the limit of packets
+// accepted on the channel in the block. It is called once per packet, so a
+// batch counts as one packet whatever its number of posts. The count of a
+// rejected packet is reverted with its state.
+func (k Keeper) CountInboundPacket(ctx sdk.Context, channelID string) error {
+	params := k.GetParams(ctx)
+	count, err := k.InboundPacketCounts.Get(ctx, channelID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if count >= params.MaxInboundPacketsPerBlock {
+		return sdkerrors.Wrapf(types.ErrRateLimited, "%d packets accepted on channel %s in this block", count, channelID)
+	}
+	return k.InboundPacketCounts.Set(ctx, channelID, count+1)
+}
+
+// ClearInboundPacketCounts resets the counts of the packets accepted in the
+// block, at the end of the block
+func (k Keeper) ClearInboundPacketCounts(ctx sdk.Context) error {
+	var channelIDs []string
+	if err := Walk(ctx, k.InboundPacketCounts, func(channelID string, _ uint64) bool {
+		channelIDs = append(channelIDs, channelID)
+		return false
+	}); err != nil {
+		return err
+	}
+	for _, channelID := range channelIDs {
+		if err := k.InboundPacketCounts.Remove(ctx, channelID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packetTimeoutTimestamp returns the timeout timestamp, or the default packet
+// timeout from the current block time when it is zero
+func (k Keeper) packetTimeoutTimestamp(ctx sdk.Context, timeoutTimestamp uint64) uint64 {
+	if timeoutTimestamp != 0 {
+		return timeoutTimestamp
+	}
+	return uint64(ctx.BlockTime().Add(k.GetParams(ctx).DefaultPacketTimeout).UnixNano())
 }
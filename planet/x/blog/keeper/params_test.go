@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 	testkeeper "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
 	"planet/x/blog/types"
 )
 
@@ -12,7 +17,128 @@ func TestGetParams(t *testing.T) {
 	k, ctx := testkeeper.BlogKeeper(t)
 	params := types.DefaultParams()
 
-	k.SetParams(ctx, params)
+	require.NoError(t, k.SetParams(ctx, params))
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestSetParamsInvalid(t *testing.T) {
+	k, ctx := testkeeper.BlogKeeper(t)
+
+	require.Error(t, k.SetParams(ctx, types.Params{}))
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestParamsSendPost(t *testing.T) {
+	k, ctx := testkeeper.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.MaxTitleLength = 5
+	params.MaxContentLength = 10
+	params.AllowedChannels = []string{testkeeper.TestChannelID}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for _, tc := range []struct {
+		desc string
+		msg  types.MsgSendIbcPost
+		err  error
+	}{
+		{
+			desc: "channel not allowed",
+			msg:  types.MsgSendIbcPost{ChannelID: "channel-1", Title: "title"},
+			err:  types.ErrChannelNotAllowed,
+		},
+		{
+			desc: "title too long",
+			msg:  types.MsgSendIbcPost{ChannelID: testkeeper.TestChannelID, Title: "title!"},
+			err:  types.ErrTitleTooLong,
+		},
+		{
+			desc: "content too long",
+			msg:  types.MsgSendIbcPost{ChannelID: testkeeper.TestChannelID, Title: "title", Content: strings.Repeat("a", 11)},
+			err:  types.ErrContentTooLong,
+		},
+		{
+			desc: "default timeout",
+			msg:  types.MsgSendIbcPost{ChannelID: testkeeper.TestChannelID, Title: "title", Content: "content"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc.msg.Creator = sample.AccAddress()
+			tc.msg.Port = types.PortID
+			_, err := srv.SendIbcPost(wctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsRecvPost(t *testing.T) {
+	k, ctx := testkeeper.BlogKeeper(t)
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-3",
+		DestinationPort:    types.PortID,
+		DestinationChannel: testkeeper.TestChannelID,
+	}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: sample.AccAddress()}
+
+	params := types.DefaultParams()
+	params.InboundEnabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err := k.OnRecvIbcPostPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrInboundDisabled)
+
+	params.InboundEnabled = true
+	params.AllowedChannels = []string{"channel-1"}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.OnRecvIbcPostPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+
+	params.AllowedChannels = nil
+	params.MaxContentLength = 3
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.OnRecvIbcPostPacket(ctx, packet, data)
+	require.ErrorIs(t, err, types.ErrContentTooLong)
+
+	require.Empty(t, testkeeper.AllPosts(t, ctx, k.Posts))
+}
+
+func TestParamsRecvRateLimit(t *testing.T) {
+	k, ctx := testkeeper.BlogKeeper(t)
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      testkeeper.TestCounterpartyChannelID,
+		DestinationPort:    types.PortID,
+		DestinationChannel: testkeeper.TestChannelID,
+	}
+	data := types.IbcPostPacketData{Title: "title", Content: "content", Creator: sample.AccAddress()}
+
+	params := types.DefaultParams()
+	params.MaxInboundPacketsPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, k.CountInboundPacket(ctx, testkeeper.TestChannelID))
+	}
+	err := k.CountInboundPacket(ctx, testkeeper.TestChannelID)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+	require.Equal(t, types.AckErrorRateLimited, types.NewPacketAckError(err).Code)
+
+	// The posts of a packet are not counted on their own, a batch of more
+	// posts than the limit is accepted as one packet
+	batch := types.BatchPostPacketData{Posts: []types.IbcPostPacketData{data, data, data}}
+	_, err = k.OnRecvBatchPostPacket(ctx, packet, batch)
+	require.NoError(t, err)
+	require.Len(t, testkeeper.AllPosts(t, ctx, k.Posts), 3)
+
+	// The packets are counted by channel and block
+	require.NoError(t, k.CountInboundPacket(ctx, "channel-2"))
+	require.NoError(t, k.ClearInboundPacketCounts(ctx))
+	require.NoError(t, k.CountInboundPacket(ctx, testkeeper.TestChannelID))
+}
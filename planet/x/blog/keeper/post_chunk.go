@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+
+	"planet/x/blog/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// TransmitPostChunkPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version. Chains speaking only blog-1 predate chunked posts.
+func (k Keeper) TransmitPostChunkPacket(
+	ctx sdk.Context,
+	packetData types.PostChunkPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if version := k.ChannelVersion(ctx, sourcePort, sourceChannel); version == types.Version1 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel %s has no chunked posts, it speaks %s", sourceChannel, version)
+	}
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_PostChunkPacket{PostChunkPacket: &packetData},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvPostChunkPacket processes packet reception. The chunk is staged with the
+// other chunks of its upload. The chunk completing the upload puts the content
+// back together and, if it matches the content hash, stores the post like the
+// post of an ibcPost packet.
+func (k Keeper) OnRecvPostChunkPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PostChunkPacketData) (packetAck types.PostChunkPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+	if err := k.validateInboundChannel(ctx, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+	params := k.GetParams(ctx)
+	if err := validatePostLength(params, data.Title, ""); err != nil {
+		return packetAck, err
+	}
+
+	stagedUpload, err := k.stagedUpload(ctx, packet.DestinationChannel, data)
+	if err != nil {
+		return packetAck, err
+	}
+	chunkKey := collections.Join(stagedUpload.Id, data.Index)
+	if has, err := k.UploadChunks.Has(ctx, chunkKey); err != nil {
+		return packetAck, err
+	} else if has {
+		return packetAck, sdkerrors.Wrapf(types.ErrInvalidChunk, "chunk %d of upload %s already received", data.Index, data.UploadID)
+	}
+	stagedUpload.ReceivedBytes += uint64(len(data.Chunk))
+	if stagedUpload.ReceivedBytes > params.MaxContentLength {
+		return packetAck, sdkerrors.Wrapf(types.ErrContentTooLong, "%d bytes received, max %d", stagedUpload.ReceivedBytes, params.MaxContentLength)
+	}
+	stagedUpload.ReceivedChunks++
+	packetAck.ReceivedChunks = stagedUpload.ReceivedChunks
+
+	if stagedUpload.ReceivedChunks < stagedUpload.ChunkCount {
+		if err := k.UploadChunks.Set(ctx, chunkKey, types.UploadChunk{
+			StagedUploadID: stagedUpload.Id,
+			Index:          data.Index,
+			Data:           data.Chunk,
+		}); err != nil {
+			return packetAck, err
+		}
+		return packetAck, k.StagedUploads.Set(ctx, stagedUpload.Id, stagedUpload)
+	}
+
+	// The last chunk completes the upload
+	content := make([]byte, 0, stagedUpload.ReceivedBytes)
+	for i := uint32(0); i < stagedUpload.ChunkCount; i++ {
+		if i == data.Index {
+			content = append(content, data.Chunk...)
+			continue
+		}
+		chunk, err := k.UploadChunks.Get(ctx, collections.Join(stagedUpload.Id, i))
+		if err != nil {
+			return packetAck, err
+		}
+		content = append(content, chunk.Data...)
+	}
+	if !bytes.Equal(types.ContentHash(content), stagedUpload.ContentHash) {
+		return packetAck, sdkerrors.Wrapf(types.ErrContentHashMismatch, "upload %s", data.UploadID)
+	}
+	// An upload of a single chunk was never staged
+	if stagedUpload.ChunkCount > 1 {
+		if err := k.removeStagedUpload(ctx, stagedUpload); err != nil {
+			return packetAck, err
+		}
+	}
+
+	postAck, err := k.OnRecvIbcPostPacket(ctx, packet, types.IbcPostPacketData{
+		Title:   stagedUpload.Title,
+		Content: string(content),
+		Creator: stagedUpload.Creator,
+	})
+	if err != nil {
+		return packetAck, err
+	}
+	packetAck.PostID = postAck.PostID
+
+	return packetAck, nil
+}
+
+// stagedUpload returns the stagedUpload a chunk belongs to, which is created by
+// the first chunk received. The chunks of an upload must agree on its metadata.
+func (k Keeper) stagedUpload(ctx sdk.Context, channelID string, data types.PostChunkPacketData) (types.StagedUpload, error) {
+	id, err := k.StagedUploads.Indexes.Remote.MatchExact(ctx, collections.Join(channelID, data.UploadID))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		stagedUpload := types.StagedUpload{
+			ChannelID:   channelID,
+			UploadID:    data.UploadID,
+			Creator:     data.Creator,
+			Title:       data.Title,
+			ContentHash: data.ContentHash,
+			ChunkCount:  data.ChunkCount,
+			ExpiresAt:   ctx.BlockTime().Add(k.GetParams(ctx).UploadTimeout),
+		}
+		stagedUpload.Id, err = k.StagedUploadSeq.Next(ctx)
+		return stagedUpload, err
+	case err != nil:
+		return types.StagedUpload{}, err
+	}
+
+	stagedUpload, err := k.StagedUploads.Get(ctx, id)
+	if err != nil {
+		return types.StagedUpload{}, err
+	}
+	if stagedUpload.Creator != data.Creator ||
+		stagedUpload.Title != data.Title ||
+		!bytes.Equal(stagedUpload.ContentHash, data.ContentHash) ||
+		stagedUpload.ChunkCount != data.ChunkCount {
+		return types.StagedUpload{}, sdkerrors.Wrapf(types.ErrInvalidChunk, "chunk %d doesn't match upload %s", data.Index, data.UploadID)
+	}
+	return stagedUpload, nil
+}
+
+// removeStagedUpload removes a stagedUpload and its chunks
+func (k Keeper) removeStagedUpload(ctx sdk.Context, stagedUpload types.StagedUpload) error {
+	for i := uint32(0); i < stagedUpload.ChunkCount; i++ {
+		if err := k.UploadChunks.Remove(ctx, collections.Join(stagedUpload.Id, i)); err != nil {
+			return err
+		}
+	}
+	return k.StagedUploads.Remove(ctx, stagedUpload.Id)
+}
+
+// ExpireUploads removes the stagedUploads whose chunks haven't all arrived by
+// their expiry time
+func (k Keeper) ExpireUploads(ctx sdk.Context) error {
+	var ids []uint64
+	ranger := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockTime().UnixNano(), uint64(math.MaxUint64)))
+	err := k.StagedUploads.Indexes.Expiry.Walk(ctx, ranger, func(_ int64, id uint64) bool {
+		ids = append(ids, id)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, id := range ids {
+		stagedUpload, err := k.StagedUploads.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.removeStagedUpload(ctx, stagedUpload); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUploadExpired,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChannel, stagedUpload.ChannelID),
+				sdk.NewAttribute(types.AttributeKeyUploadID, stagedUpload.UploadID),
+			),
+		)
+	}
+	return nil
+}
+
+// OnAcknowledgementPostChunkPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. The acknowledgement of the chunk
+// completing the upload carries the post ID, which becomes a sentPost.
+func (k Keeper) OnAcknowledgementPostChunkPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PostChunkPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		if err := k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostFailed, "", dispatchedAck.Error); err != nil {
+			return err
+		}
+		emitUploadFailed(ctx, packet, data)
+
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.PostChunkPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		if packetAck.PostID != "" {
+			chainID, err := k.CounterpartyChainID(ctx, packet.SourcePort, packet.SourceChannel)
+			if err != nil {
+				return err
+			}
+			if _, err := k.AppendSentPost(
+				ctx,
+				types.SentPost{
+					Creator:   data.Creator,
+					PostID:    packetAck.PostID,
+					Title:     data.Title,
+					Chain:     packet.DestinationPort + "-" + packet.DestinationChannel,
+					ChannelID: packet.SourceChannel,
+					ChainID:   chainID,
+				},
+			); err != nil {
+				return err
+			}
+		}
+		return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostDelivered, packetAck.PostID, "")
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutPostChunkPacket responds to the case where a packet has not been transmitted because of a timeout.
+// A chunk doesn't carry the whole content, so no timeoutPost is stored: the post must be sent again.
+func (k Keeper) OnTimeoutPostChunkPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PostChunkPacketData) error {
+	if err := k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostTimedOut, "", ""); err != nil {
+		return err
+	}
+	emitUploadFailed(ctx, packet, data)
+
+	return nil
+}
+
+// emitUploadFailed reports the upload of a chunk that failed or timed out. The
+// outboundPosts of the other chunks of the upload are listed by upload ID.
+func emitUploadFailed(ctx sdk.Context, packet channeltypes.Packet, data types.PostChunkPacketData) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUploadFailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyUploadID, data.UploadID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		),
+	)
+}
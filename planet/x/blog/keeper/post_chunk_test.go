@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/types"
+)
+
+// postChunks returns the chunk packets of a content split in chunks of chunkSize bytes
+func postChunks(uploadID, creator, content string, chunkSize uint64) []types.PostChunkPacketData {
+	chunks := types.SplitContent(content, chunkSize)
+	data := make([]types.PostChunkPacketData, len(chunks))
+	for i, chunk := range chunks {
+		data[i] = types.PostChunkPacketData{
+			UploadID:    uploadID,
+			Title:       "title",
+			Creator:     creator,
+			ContentHash: types.ContentHash([]byte(content)),
+			Index:       uint32(i),
+			ChunkCount:  uint32(len(chunks)),
+			Chunk:       chunk,
+		}
+	}
+	return data
+}
+
+func TestOnRecvPostChunkPacket(t *testing.T) {
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		chunks := postChunks("7", creator, "hello world", 4)
+		require.Len(t, chunks, 3)
+
+		// Chunks may arrive in any order
+		for i, index := range []int{2, 0} {
+			ack, err := k.OnRecvPostChunkPacket(ctx, packet, chunks[index])
+			require.NoError(t, err)
+			require.Equal(t, types.PostChunkPacketAck{ReceivedChunks: uint32(i + 1)}, ack)
+		}
+		stagedUpload, err := k.StagedUploads.Get(ctx, 0)
+		require.NoError(t, err)
+		require.Equal(t, "channel-1", stagedUpload.ChannelID)
+		require.Equal(t, uint64(7), stagedUpload.ReceivedBytes)
+		require.Empty(t, keepertest.AllPosts(t, ctx, k.Posts))
+
+		ack, err := k.OnRecvPostChunkPacket(ctx, packet, chunks[1])
+		require.NoError(t, err)
+		require.Equal(t, types.PostChunkPacketAck{ReceivedChunks: 3, PostID: "0"}, ack)
+
+		posts := keepertest.AllPosts(t, ctx, k.Posts)
+		require.Len(t, posts, 1)
+		require.Equal(t, "hello world", posts[0].Content)
+		require.Equal(t, creator, posts[0].Creator)
+		require.Equal(t, packet.SourceChannel, posts[0].OriginChannel)
+
+		// The staging is removed with its chunks
+		has, err := k.StagedUploads.Has(ctx, 0)
+		require.NoError(t, err)
+		require.False(t, has)
+		has, err = k.UploadChunks.Has(ctx, collections.Join(uint64(0), uint32(2)))
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("single chunk", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		ack, err := k.OnRecvPostChunkPacket(ctx, packet, postChunks("7", creator, "", 4)[0])
+		require.NoError(t, err)
+		require.Equal(t, "0", ack.PostID)
+	})
+
+	for _, tc := range []struct {
+		desc   string
+		data   func(chunks []types.PostChunkPacketData) types.PostChunkPacketData
+		params func(*types.Params)
+		err    error
+	}{
+		{
+			desc: "duplicated chunk",
+			data: func(chunks []types.PostChunkPacketData) types.PostChunkPacketData { return chunks[0] },
+			err:  types.ErrInvalidChunk,
+		},
+		{
+			desc: "other title",
+			data: func(chunks []types.PostChunkPacketData) types.PostChunkPacketData {
+				chunks[1].Title = "other"
+				return chunks[1]
+			},
+			err: types.ErrInvalidChunk,
+		},
+		{
+			desc: "other chunk count",
+			data: func(chunks []types.PostChunkPacketData) types.PostChunkPacketData {
+				chunks[1].ChunkCount = 4
+				return chunks[1]
+			},
+			err: types.ErrInvalidChunk,
+		},
+		{
+			desc:   "content too long",
+			data:   func(chunks []types.PostChunkPacketData) types.PostChunkPacketData { return chunks[1] },
+			params: func(p *types.Params) { p.MaxContentLength = 6 },
+			err:    types.ErrContentTooLong,
+		},
+		{
+			desc:   "inbound disabled",
+			data:   func(chunks []types.PostChunkPacketData) types.PostChunkPacketData { return chunks[1] },
+			params: func(p *types.Params) { p.InboundEnabled = false },
+			err:    types.ErrInboundDisabled,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			chunks := postChunks("7", creator, "hello world", 4)
+			_, err := k.OnRecvPostChunkPacket(ctx, packet, chunks[0])
+			require.NoError(t, err)
+			if tc.params != nil {
+				params := k.GetParams(ctx)
+				tc.params(&params)
+				require.NoError(t, k.SetParams(ctx, params))
+			}
+
+			_, err = k.OnRecvPostChunkPacket(ctx, packet, tc.data(chunks))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	t.Run("hash mismatch", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		chunks := postChunks("7", creator, "hello world", 6)
+		_, err := k.OnRecvPostChunkPacket(ctx, packet, chunks[0])
+		require.NoError(t, err)
+
+		chunks[1].Chunk = []byte("WORLD")
+		_, err = k.OnRecvPostChunkPacket(ctx, packet, chunks[1])
+		require.ErrorIs(t, err, types.ErrContentHashMismatch)
+		require.Equal(t, types.AckErrorInvalidPayload, types.AckErrorCodeOf(err))
+	})
+
+	t.Run("uploads by channel", func(t *testing.T) {
+		k, ctx := keepertest.BlogKeeper(t)
+		chunks := postChunks("7", creator, "hello world", 6)
+		_, err := k.OnRecvPostChunkPacket(ctx, packet, chunks[0])
+		require.NoError(t, err)
+
+		// The same upload ID from another channel is another upload
+		other := packet
+		other.DestinationChannel = "channel-2"
+		ack, err := k.OnRecvPostChunkPacket(ctx, other, chunks[1])
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), ack.ReceivedChunks)
+	})
+}
+
+func TestExpireUploads(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	start := time.Unix(1_700_000_000, 0).UTC()
+	timeout := k.GetParams(ctx).UploadTimeout
+
+	ctx = ctx.WithBlockTime(start)
+	_, err := k.OnRecvPostChunkPacket(ctx, packet, postChunks("1", creator, "hello world", 4)[0])
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	_, err = k.OnRecvPostChunkPacket(ctx, packet, postChunks("2", creator, "hello world", 4)[0])
+	require.NoError(t, err)
+
+	// Nothing expires before the upload timeout
+	ctx = ctx.WithBlockTime(start.Add(timeout - time.Second))
+	require.NoError(t, k.ExpireUploads(ctx))
+	require.Len(t, keepertest.AllStagedUploads(t, ctx, k.StagedUploads), 2)
+
+	ctx = ctx.WithBlockTime(start.Add(timeout)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ExpireUploads(ctx))
+	stagedUploads := keepertest.AllStagedUploads(t, ctx, k.StagedUploads)
+	require.Len(t, stagedUploads, 1)
+	require.Equal(t, "2", stagedUploads[0].UploadID)
+	has, err := k.UploadChunks.Has(ctx, collections.Join(uint64(0), uint32(0)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUploadExpired, events[0].Type)
+	attrs := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, "channel-1", attrs[types.AttributeKeyChannel])
+	require.Equal(t, "1", attrs[types.AttributeKeyUploadID])
+
+	// The expired upload starts over from its first chunk
+	ack, err := k.OnRecvPostChunkPacket(ctx, packet, postChunks("1", creator, "hello world", 4)[1])
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), ack.ReceivedChunks)
+	require.NoError(t, k.ExpireUploads(ctx))
+	require.Len(t, keepertest.AllStagedUploads(t, ctx, k.StagedUploads), 2)
+}
+
+func TestOnAcknowledgementPostChunkPacket(t *testing.T) {
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	data := postChunks("7", creator, "hello world", 6)[1]
+
+	for _, tc := range []struct {
+		desc      string
+		ack       channeltypes.Acknowledgement
+		status    types.OutboundPostStatus
+		postID    string
+		sentPosts int
+		failed    bool
+	}{
+		{
+			desc:   "chunk received",
+			ack:    channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.PostChunkPacketAck{ReceivedChunks: 1})),
+			status: types.OutboundPostDelivered,
+		},
+		{
+			desc:      "upload complete",
+			ack:       channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(&types.PostChunkPacketAck{ReceivedChunks: 2, PostID: "3"})),
+			status:    types.OutboundPostDelivered,
+			postID:    "3",
+			sentPosts: 1,
+		},
+		{
+			desc:   "error",
+			ack:    types.NewPacketAckError(types.ErrContentHashMismatch).Acknowledgement(),
+			status: types.OutboundPostFailed,
+			failed: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			k.AppendOutboundPost(ctx, types.OutboundPost{ChannelID: packet.SourceChannel, Sequence: packet.Sequence})
+
+			require.NoError(t, k.OnAcknowledgementPostChunkPacket(ctx, packet, data, tc.ack))
+
+			outboundPost, err := k.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+			require.NoError(t, err)
+			require.Equal(t, tc.status, outboundPost.Status)
+			require.Equal(t, tc.postID, outboundPost.PostID)
+
+			require.Len(t, keepertest.AllSentPosts(t, ctx, k.SentPosts), tc.sentPosts)
+
+			// A failed chunk fails the whole upload
+			attrs := make(map[string]string)
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeUploadFailed {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					attrs[attr.Key] = attr.Value
+				}
+			}
+			if tc.failed {
+				require.Equal(t, data.UploadID, attrs[types.AttributeKeyUploadID])
+			} else {
+				require.Empty(t, attrs)
+			}
+		})
+	}
+}
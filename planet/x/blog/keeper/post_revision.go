@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// archivePostRevision records the current version of the post before the editor replaces it
+func (k Keeper) archivePostRevision(ctx sdk.Context, post types.Post, editor string) error {
+	return k.SetPostRevision(ctx, types.PostRevision{
+		PostID:   post.Id,
+		Revision: post.Revision,
+		Title:    post.Title,
+		Content:  post.Content,
+		Editor:   editor,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+	})
+}
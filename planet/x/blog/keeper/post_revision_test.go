@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestPostRevisionOnUpdate(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockHeight(5)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := "A"
+
+	resp, err := srv.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: "v0", Content: "content v0"})
+	require.NoError(t, err)
+	for _, title := range []string{"v1", "v2"} {
+		_, err = srv.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator, Id: resp.Id, Title: title, Content: "content " + title})
+		require.NoError(t, err)
+	}
+
+	post, err := k.Posts.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), post.Revision)
+	require.Equal(t, "v2", post.Title)
+
+	for i, title := range []string{"v0", "v1"} {
+		revision, err := k.Revisions.Get(ctx, collections.Join(resp.Id, uint64(i)))
+		require.NoError(t, err)
+		require.Equal(t, title, revision.Title)
+		require.Equal(t, "content "+title, revision.Content)
+		require.Equal(t, creator, revision.Editor)
+		require.Equal(t, int64(5), revision.Height)
+	}
+	_, err = k.Revisions.Get(ctx, collections.Join(resp.Id, uint64(2)))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// Deleting the post deletes its revisions, not those of other posts
+	other := createPostRevisions(t, k, ctx, resp.Id+1, 1)
+	_, err = srv.DeletePost(wctx, &types.MsgDeletePost{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+	for i := range []string{"v0", "v1"} {
+		_, err := k.Revisions.Get(ctx, collections.Join(resp.Id, uint64(i)))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+	require.Equal(t, other, keepertest.AllPostRevisions(t, ctx, k.Revisions))
+}
+
+func createPostRevisions(t testing.TB, keeper *keeper.Keeper, ctx sdk.Context, postID uint64, n int) []types.PostRevision {
+	items := make([]types.PostRevision, n)
+	for i := range items {
+		items[i].PostID = postID
+		items[i].Revision = uint64(i)
+		items[i].Title = "title"
+		require.NoError(t, keeper.SetPostRevision(ctx, items[i]))
+	}
+	return items
+}
+
+func TestPostRevisionQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createPostRevisions(t, keeper, ctx, 3, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetPostRevisionRequest
+		response *types.QueryGetPostRevisionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetPostRevisionRequest{PostID: 3, Revision: 0},
+			response: &types.QueryGetPostRevisionResponse{PostRevision: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetPostRevisionRequest{PostID: 3, Revision: 1},
+			response: &types.QueryGetPostRevisionResponse{PostRevision: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetPostRevisionRequest{PostID: 4, Revision: 0},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PostRevision(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPostRevisionQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createPostRevisions(t, keeper, ctx, 3, 5)
+	// Revisions of other posts are not listed
+	createPostRevisions(t, keeper, ctx, 2, 2)
+	createPostRevisions(t, keeper, ctx, 4, 2)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryPostRevisionsRequest {
+		return &types.QueryPostRevisionsRequest{
+			PostID: 3,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostRevisions(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PostRevision), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PostRevision),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostRevisions(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PostRevision), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PostRevision),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PostRevisions(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.PostRevision),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PostRevisions(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
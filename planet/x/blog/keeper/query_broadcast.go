@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) Broadcast(goCtx context.Context, req *types.QueryGetBroadcastRequest) (*types.QueryGetBroadcastResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	broadcast, err := k.Broadcasts.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	outboundPosts := make([]types.OutboundPost, 0, len(broadcast.Destinations))
+	for _, destination := range broadcast.Destinations {
+		outboundPost, err := k.OutboundPosts.Get(ctx, collections.Join(destination.ChannelID, destination.Sequence))
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.Internal, "outboundPost of channel %s and sequence %d not found", destination.ChannelID, destination.Sequence)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		outboundPosts = append(outboundPosts, outboundPost)
+	}
+
+	return &types.QueryGetBroadcastResponse{Broadcast: broadcast, OutboundPost: outboundPosts}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestBroadcastQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	outboundPosts := createNOutboundPost(t, keeper, ctx, 2)
+	broadcast := types.Broadcast{Title: "title"}
+	for _, outboundPost := range outboundPosts {
+		broadcast.Destinations = append(broadcast.Destinations, types.BroadcastDestination{
+			ChannelID: outboundPost.ChannelID,
+			Sequence:  outboundPost.Sequence,
+		})
+	}
+	var err error
+	broadcast.Id, err = keeper.AppendBroadcast(ctx, broadcast)
+	require.NoError(t, err)
+	// A broadcast of an outbound post that isn't tracked
+	dangling, err := keeper.AppendBroadcast(ctx, types.Broadcast{
+		Destinations: []types.BroadcastDestination{{ChannelID: "channel-0", Sequence: 100000}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetBroadcastRequest
+		response *types.QueryGetBroadcastResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetBroadcastRequest{Id: broadcast.Id},
+			response: &types.QueryGetBroadcastResponse{Broadcast: broadcast, OutboundPost: outboundPosts},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetBroadcastRequest{Id: 100000},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "OutboundPostNotFound",
+			request: &types.QueryGetBroadcastRequest{Id: dangling},
+			err:     status.Error(codes.Internal, "outboundPost of channel channel-0 and sequence 100000 not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Broadcast(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) OutboundPostAll(goCtx context.Context, req *types.QueryAllOutboundPostRequest) (*types.QueryAllOutboundPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var outboundPosts []types.OutboundPost
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	outboundPostStore := prefix.NewStore(store, types.KeyPrefix(types.OutboundPostKey))
+
+	pageRes, err := query.FilteredPaginate(outboundPostStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		outboundPost, err := k.OutboundPosts.ValueCodec().Decode(value)
+		if err != nil {
+			return false, err
+		}
+
+		if req.Status != types.OutboundPostStatusUnspecified && outboundPost.Status != req.Status {
+			return false, nil
+		}
+		if req.UploadID != "" && outboundPost.UploadID != req.UploadID {
+			return false, nil
+		}
+
+		if accumulate {
+			outboundPosts = append(outboundPosts, outboundPost)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllOutboundPostResponse{OutboundPost: outboundPosts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) OutboundPost(goCtx context.Context, req *types.QueryGetOutboundPostRequest) (*types.QueryGetOutboundPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	outboundPost, err := k.OutboundPosts.Get(ctx, collections.Join(req.ChannelID, req.Sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetOutboundPostResponse{OutboundPost: outboundPost}, nil
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOutboundPostQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNOutboundPost(t, keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetOutboundPostRequest
+		response *types.QueryGetOutboundPostResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetOutboundPostRequest{ChannelID: msgs[0].ChannelID, Sequence: msgs[0].Sequence},
+			response: &types.QueryGetOutboundPostResponse{OutboundPost: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetOutboundPostRequest{ChannelID: msgs[1].ChannelID, Sequence: msgs[1].Sequence},
+			response: &types.QueryGetOutboundPostResponse{OutboundPost: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetOutboundPostRequest{ChannelID: msgs[0].ChannelID, Sequence: 100000},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.OutboundPost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestOutboundPostQueryFromSendResponse(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// The send response holds the key of the outbound record
+	resp, err := srv.SendIbcPost(wctx, types.NewMsgSendIbcPost(sample.AccAddress(), types.PortID, keepertest.TestChannelID, 0, "title", "content"))
+	require.NoError(t, err)
+	require.Equal(t, keepertest.TestChannelID, resp.ChannelID)
+	got, err := k.OutboundPost(wctx, &types.QueryGetOutboundPostRequest{ChannelID: resp.ChannelID, Sequence: resp.Sequence})
+	require.NoError(t, err)
+	require.Equal(t, resp.OutboundPostID, got.OutboundPost.Id)
+	require.Equal(t, types.OutboundPostPending, got.OutboundPost.Status)
+}
+
+func TestOutboundPostQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNOutboundPost(t, keeper, ctx, 8)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllOutboundPostRequest {
+		return &types.QueryAllOutboundPostRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.OutboundPostAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.OutboundPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.OutboundPost),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.OutboundPostAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.OutboundPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.OutboundPost),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.OutboundPostAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.OutboundPost),
+		)
+	})
+	t.Run("ByStatus", func(t *testing.T) {
+		req := request(nil, 0, 0, true)
+		req.Status = types.OutboundPostFailed
+		resp, err := keeper.OutboundPostAll(wctx, req)
+		require.NoError(t, err)
+		var expected []types.OutboundPost
+		for _, msg := range msgs {
+			if msg.Status == types.OutboundPostFailed {
+				expected = append(expected, msg)
+			}
+		}
+		require.Equal(t, len(expected), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(expected),
+			nullify.Fill(resp.OutboundPost),
+		)
+	})
+	t.Run("ByUploadID", func(t *testing.T) {
+		req := request(nil, 0, 0, true)
+		req.UploadID = "1"
+		resp, err := keeper.OutboundPostAll(wctx, req)
+		require.NoError(t, err)
+		var expected []types.OutboundPost
+		for _, msg := range msgs {
+			if msg.UploadID == "1" {
+				expected = append(expected, msg)
+			}
+		}
+		require.Equal(t, len(expected), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(expected),
+			nullify.Fill(resp.OutboundPost),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.OutboundPostAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
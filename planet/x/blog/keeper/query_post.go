@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -24,8 +26,8 @@ func (k Keeper) PostAll(goCtx context.Context, req *types.QueryAllPostRequest) (
 	postStore := prefix.NewStore(store, types.KeyPrefix(types.PostKey))
 
 	pageRes, err := query.Paginate(postStore, req.Pagination, func(key []byte, value []byte) error {
-		var post types.Post
-		if err := k.cdc.Unmarshal(value, &post); err != nil {
+		post, err := k.Posts.ValueCodec().Decode(value)
+		if err != nil {
 			return err
 		}
 
@@ -46,10 +48,86 @@ func (k Keeper) Post(goCtx context.Context, req *types.QueryGetPostRequest) (*ty
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	post, found := k.GetPost(ctx, req.Id)
-	if !found {
+	post, err := k.Posts.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryGetPostResponse{Post: post}, nil
 }
+
+func (k Keeper) PostByCreator(goCtx context.Context, req *types.QueryPostByCreatorRequest) (*types.QueryPostByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.PostCreatorKey), types.PostCreatorPrefix(req.Creator)...))
+
+	posts, pageRes, err := k.paginatePostIndex(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPostByCreatorResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostByChannel(goCtx context.Context, req *types.QueryPostByChannelRequest) (*types.QueryPostByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.PostChannelKey), types.PostChannelPrefix(req.ChannelID)...))
+
+	posts, pageRes, err := k.paginatePostIndex(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPostByChannelResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostByChain(goCtx context.Context, req *types.QueryPostByChainRequest) (*types.QueryPostByChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.PostChainKey), types.PostChainPrefix(req.ChainID)...))
+
+	posts, pageRes, err := k.paginatePostIndex(ctx, indexStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPostByChainResponse{Post: posts, Pagination: pageRes}, nil
+}
+
+// paginatePostIndex pages over an index store of post IDs and returns the indexed posts
+func (k Keeper) paginatePostIndex(ctx sdk.Context, indexStore prefix.Store, pageReq *query.PageRequest) ([]types.Post, *query.PageResponse, error) {
+	var posts []types.Post
+
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, value []byte) error {
+		_, id, err := collections.Uint64Key.Decode(key)
+		if err != nil {
+			return err
+		}
+		post, err := k.Posts.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		posts = append(posts, post)
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return posts, pageRes, nil
+}
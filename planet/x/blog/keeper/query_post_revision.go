@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) PostRevisions(goCtx context.Context, req *types.QueryPostRevisionsRequest) (*types.QueryPostRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var postRevisions []types.PostRevision
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	postRevisionStore := prefix.NewStore(store, append(types.KeyPrefix(types.PostRevisionKey), types.PostRevisionPrefix(req.PostID)...))
+
+	pageRes, err := query.Paginate(postRevisionStore, req.Pagination, func(key []byte, value []byte) error {
+		postRevision, err := k.Revisions.ValueCodec().Decode(value)
+		if err != nil {
+			return err
+		}
+
+		postRevisions = append(postRevisions, postRevision)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostRevisionsResponse{PostRevision: postRevisions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostRevision(goCtx context.Context, req *types.QueryGetPostRevisionRequest) (*types.QueryGetPostRevisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	postRevision, err := k.Revisions.Get(ctx, collections.Join(req.PostID, req.Revision))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetPostRevisionResponse{PostRevision: postRevision}, nil
+}
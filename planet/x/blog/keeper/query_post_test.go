@@ -18,7 +18,7 @@ import (
 func TestPostQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNPost(keeper, ctx, 2)
+	msgs := createNPost(t, keeper, ctx, 2)
 	tests := []struct {
 		desc     string
 		request  *types.QueryGetPostRequest
@@ -64,7 +64,7 @@ func TestPostQuerySingle(t *testing.T) {
 func TestPostQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNPost(keeper, ctx, 5)
+	msgs := createNPost(t, keeper, ctx, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPostRequest {
 		return &types.QueryAllPostRequest{
@@ -116,3 +116,175 @@ func TestPostQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestPostQueryByCreator(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	var msgs []types.Post
+	for i := 0; i < 5; i++ {
+		post := types.Post{Creator: "alice"}
+		if i%2 == 1 {
+			post.Creator = "bob"
+		}
+		id, err := keeper.AppendPost(ctx, post)
+		require.NoError(t, err)
+		post.Id = id
+		if i%2 == 0 {
+			msgs = append(msgs, post)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryPostByCreatorRequest {
+		return &types.QueryPostByCreatorRequest{
+			Creator: "alice",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostByCreator(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Post), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Post),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PostByCreator(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Post),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PostByCreator(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestPostQueryByChannel(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	var msgs []types.Post
+	for i := 0; i < 5; i++ {
+		// both counterparties name their channel channel-5
+		post := types.Post{OriginChannel: "channel-5", ChannelID: "channel-0"}
+		if i%2 == 1 {
+			post.ChannelID = "channel-1"
+		}
+		id, err := keeper.AppendPost(ctx, post)
+		require.NoError(t, err)
+		post.Id = id
+		if i%2 == 0 {
+			msgs = append(msgs, post)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryPostByChannelRequest {
+		return &types.QueryPostByChannelRequest{
+			ChannelID: "channel-0",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostByChannel(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Post), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Post),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PostByChannel(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Post),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PostByChannel(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestPostQueryByChain(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	var msgs []types.Post
+	for i := 0; i < 5; i++ {
+		post := types.Post{OriginChainID: "earth"}
+		if i%2 == 1 {
+			post.OriginChainID = "venus"
+		}
+		id, err := keeper.AppendPost(ctx, post)
+		require.NoError(t, err)
+		post.Id = id
+		if i%2 == 0 {
+			msgs = append(msgs, post)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryPostByChainRequest {
+		return &types.QueryPostByChainRequest{
+			ChainID: "earth",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PostByChain(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Post), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Post),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PostByChain(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Post),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PostByChain(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
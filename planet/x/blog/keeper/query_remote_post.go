@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) RemotePost(goCtx context.Context, req *types.QueryGetRemotePostRequest) (*types.QueryGetRemotePostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	remotePost, err := k.RemotePosts.Get(ctx, collections.Join(req.ChannelID, req.PostID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetRemotePostResponse{RemotePost: remotePost}, nil
+}
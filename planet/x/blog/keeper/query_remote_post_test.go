@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestRemotePostQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	remotePost := types.RemotePost{
+		ChannelID:   "channel-0",
+		PostID:      "3",
+		Post:        types.Post{Id: 3, Title: "title"},
+		FetchHeight: 10,
+		FetchedAt:   time.Unix(1_700_000_000, 0).UTC(),
+	}
+	require.NoError(t, keeper.RemotePosts.Set(ctx, collections.Join(remotePost.ChannelID, remotePost.PostID), remotePost))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetRemotePostRequest
+		response *types.QueryGetRemotePostResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetRemotePostRequest{ChannelID: "channel-0", PostID: "3"},
+			response: &types.QueryGetRemotePostResponse{RemotePost: remotePost},
+		},
+		{
+			desc:    "OtherChannel",
+			request: &types.QueryGetRemotePostRequest{ChannelID: "channel-1", PostID: "3"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetRemotePostRequest{ChannelID: "channel-0", PostID: "100000"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.RemotePost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
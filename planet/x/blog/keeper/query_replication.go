@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"planet/x/blog/types"
+)
+
+func (k Keeper) ReplicationSubscription(goCtx context.Context, req *types.QueryGetReplicationSubscriptionRequest) (*types.QueryGetReplicationSubscriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	subscription, err := k.ReplicationSubscriptions.Get(ctx, req.ChannelID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetReplicationSubscriptionResponse{ReplicationSubscription: subscription}, nil
+}
+
+func (k Keeper) Replica(goCtx context.Context, req *types.QueryGetReplicaRequest) (*types.QueryGetReplicaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	replica, err := k.Replicas.Get(ctx, req.ChannelID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetReplicaResponse{Replica: replica}, nil
+}
+
+func (k Keeper) ReplicatedPost(goCtx context.Context, req *types.QueryGetReplicatedPostRequest) (*types.QueryGetReplicatedPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	replicatedPost, err := k.ReplicatedPosts.Get(ctx, collections.Join(req.ChannelID, req.PostID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetReplicatedPostResponse{ReplicatedPost: replicatedPost}, nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/nullify"
+	"planet/x/blog/types"
+)
+
+func TestReplicationSubscriptionQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	subscription := types.ReplicationSubscription{ChannelID: "channel-0", NextSequence: 4, ResetSequence: 1, Synced: true}
+	require.NoError(t, keeper.ReplicationSubscriptions.Set(ctx, subscription.ChannelID, subscription))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetReplicationSubscriptionRequest
+		response *types.QueryGetReplicationSubscriptionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetReplicationSubscriptionRequest{ChannelID: "channel-0"},
+			response: &types.QueryGetReplicationSubscriptionResponse{ReplicationSubscription: subscription},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetReplicationSubscriptionRequest{ChannelID: "channel-1"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ReplicationSubscription(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestReplicaQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	replica := types.Replica{ChannelID: "channel-0", NextSequence: 7, AppliedHeight: 10}
+	require.NoError(t, keeper.Replicas.Set(ctx, replica.ChannelID, replica))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetReplicaRequest
+		response *types.QueryGetReplicaResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetReplicaRequest{ChannelID: "channel-0"},
+			response: &types.QueryGetReplicaResponse{Replica: replica},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetReplicaRequest{ChannelID: "channel-1"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Replica(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestReplicatedPostQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	replicatedPost := types.RemotePost{
+		ChannelID:   "channel-0",
+		PostID:      "3",
+		Post:        types.Post{Id: 3, Title: "title"},
+		FetchHeight: 10,
+		FetchedAt:   time.Unix(1_700_000_000, 0).UTC(),
+	}
+	require.NoError(t, keeper.ReplicatedPosts.Set(ctx, collections.Join(replicatedPost.ChannelID, replicatedPost.PostID), replicatedPost))
+	// A post fetched over the channel isn't part of the replica
+	require.NoError(t, keeper.RemotePosts.Set(ctx, collections.Join("channel-0", "4"), types.RemotePost{ChannelID: "channel-0", PostID: "4"}))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetReplicatedPostRequest
+		response *types.QueryGetReplicatedPostResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetReplicatedPostRequest{ChannelID: "channel-0", PostID: "3"},
+			response: &types.QueryGetReplicatedPostResponse{ReplicatedPost: replicatedPost},
+		},
+		{
+			desc:    "Fetched",
+			request: &types.QueryGetReplicatedPostRequest{ChannelID: "channel-0", PostID: "4"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetReplicatedPostRequest{ChannelID: "channel-1", PostID: "3"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ReplicatedPost(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
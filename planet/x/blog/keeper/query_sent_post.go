@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -24,8 +26,8 @@ func (k Keeper) SentPostAll(goCtx context.Context, req *types.QueryAllSentPostRe
 	sentPostStore := prefix.NewStore(store, types.KeyPrefix(types.SentPostKey))
 
 	pageRes, err := query.Paginate(sentPostStore, req.Pagination, func(key []byte, value []byte) error {
-		var sentPost types.SentPost
-		if err := k.cdc.Unmarshal(value, &sentPost); err != nil {
+		sentPost, err := k.SentPosts.ValueCodec().Decode(value)
+		if err != nil {
 			return err
 		}
 
@@ -46,10 +48,61 @@ func (k Keeper) SentPost(goCtx context.Context, req *types.QueryGetSentPostReque
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	sentPost, found := k.GetSentPost(ctx, req.Id)
-	if !found {
+	sentPost, err := k.SentPosts.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryGetSentPostResponse{SentPost: sentPost}, nil
 }
+
+func (k Keeper) SentPostByRemote(goCtx context.Context, req *types.QuerySentPostByRemoteRequest) (*types.QuerySentPostByRemoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sentPost, err := k.GetSentPostByRemote(ctx, req.ChannelID, req.PostID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySentPostByRemoteResponse{SentPost: sentPost}, nil
+}
+
+func (k Keeper) SentPostByChain(goCtx context.Context, req *types.QuerySentPostByChainRequest) (*types.QuerySentPostByChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var sentPosts []types.SentPost
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.SentPostChainKey), types.SentPostChainPrefix(req.ChainID)...))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		_, id, err := collections.Uint64Key.Decode(key)
+		if err != nil {
+			return err
+		}
+		sentPost, err := k.SentPosts.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		sentPosts = append(sentPosts, sentPost)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySentPostByChainResponse{SentPost: sentPosts, Pagination: pageRes}, nil
+}
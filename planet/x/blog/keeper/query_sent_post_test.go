@@ -18,7 +18,7 @@ import (
 func TestSentPostQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNSentPost(keeper, ctx, 2)
+	msgs := createNSentPost(t, keeper, ctx, 2)
 	tests := []struct {
 		desc     string
 		request  *types.QueryGetSentPostRequest
@@ -61,10 +61,56 @@ func TestSentPostQuerySingle(t *testing.T) {
 	}
 }
 
+func TestSentPostQueryByRemote(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNSentPost(t, keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QuerySentPostByRemoteRequest
+		response *types.QuerySentPostByRemoteResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QuerySentPostByRemoteRequest{ChannelID: msgs[0].ChannelID, PostID: msgs[0].PostID},
+			response: &types.QuerySentPostByRemoteResponse{SentPost: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QuerySentPostByRemoteRequest{ChannelID: msgs[1].ChannelID, PostID: msgs[1].PostID},
+			response: &types.QuerySentPostByRemoteResponse{SentPost: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QuerySentPostByRemoteRequest{ChannelID: msgs[0].ChannelID, PostID: "100000"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.SentPostByRemote(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
 func TestSentPostQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNSentPost(keeper, ctx, 5)
+	msgs := createNSentPost(t, keeper, ctx, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllSentPostRequest {
 		return &types.QueryAllSentPostRequest{
@@ -116,3 +162,60 @@ func TestSentPostQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestSentPostQueryByChain(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	var msgs []types.SentPost
+	for i := 0; i < 5; i++ {
+		sentPost := types.SentPost{ChainID: "earth"}
+		if i%2 == 1 {
+			sentPost.ChainID = "venus"
+		}
+		id, err := keeper.AppendSentPost(ctx, sentPost)
+		require.NoError(t, err)
+		sentPost.Id = id
+		if i%2 == 0 {
+			msgs = append(msgs, sentPost)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QuerySentPostByChainRequest {
+		return &types.QuerySentPostByChainRequest{
+			ChainID: "earth",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.SentPostByChain(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.SentPost), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.SentPost),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.SentPostByChain(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.SentPost),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.SentPostByChain(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
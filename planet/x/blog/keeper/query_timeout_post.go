@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -24,8 +26,8 @@ func (k Keeper) TimeoutPostAll(goCtx context.Context, req *types.QueryAllTimeout
 	timeoutPostStore := prefix.NewStore(store, types.KeyPrefix(types.TimeoutPostKey))
 
 	pageRes, err := query.Paginate(timeoutPostStore, req.Pagination, func(key []byte, value []byte) error {
-		var timeoutPost types.TimeoutPost
-		if err := k.cdc.Unmarshal(value, &timeoutPost); err != nil {
+		timeoutPost, err := k.TimeoutPosts.ValueCodec().Decode(value)
+		if err != nil {
 			return err
 		}
 
@@ -46,10 +48,13 @@ func (k Keeper) TimeoutPost(goCtx context.Context, req *types.QueryGetTimeoutPos
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	timeoutPost, found := k.GetTimeoutPost(ctx, req.Id)
-	if !found {
+	timeoutPost, err := k.TimeoutPosts.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryGetTimeoutPostResponse{TimeoutPost: timeoutPost}, nil
 }
@@ -18,7 +18,7 @@ import (
 func TestTimeoutPostQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNTimeoutPost(keeper, ctx, 2)
+	msgs := createNTimeoutPost(t, keeper, ctx, 2)
 	tests := []struct {
 		desc     string
 		request  *types.QueryGetTimeoutPostRequest
@@ -64,7 +64,7 @@ func TestTimeoutPostQuerySingle(t *testing.T) {
 func TestTimeoutPostQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.BlogKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNTimeoutPost(keeper, ctx, 5)
+	msgs := createNTimeoutPost(t, keeper, ctx, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllTimeoutPostRequest {
 		return &types.QueryAllTimeoutPostRequest{
@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"planet/x/blog/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// TransmitReplicatePostPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version. Chains speaking only blog-1 can't replicate their posts.
+func (k Keeper) TransmitReplicatePostPacket(
+	ctx sdk.Context,
+	packetData types.ReplicatePostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if version := k.ChannelVersion(ctx, sourcePort, sourceChannel); version == types.Version1 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel %s has no replication, it speaks %s", sourceChannel, version)
+	}
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, "", types.BlogPacketData{
+		Packet: &types.BlogPacketData_ReplicatePostPacket{ReplicatePostPacket: &packetData},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvReplicatePostPacket processes packet reception. The changes are applied
+// to the replica in sequence order: a change the replica already had is
+// skipped, a change following a missing one fails until the replica re-syncs.
+func (k Keeper) OnRecvReplicatePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.ReplicatePostPacketData) (packetAck types.ReplicatePostPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	channelID := packet.DestinationChannel
+	replica, err := k.Replicas.Get(ctx, channelID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return packetAck, sdkerrors.Wrapf(types.ErrReplicationNotFound, "channel %s", channelID)
+	}
+	if err != nil {
+		return packetAck, err
+	}
+
+	switch {
+	case data.Sequence < replica.NextSequence:
+		return packetAck, nil
+	case data.Op == types.ReplicationOpReset:
+		if err := k.removeReplicatedPosts(ctx, channelID); err != nil {
+			return packetAck, err
+		}
+	case replica.NextSequence == 0 || data.Sequence > replica.NextSequence:
+		return packetAck, sdkerrors.Wrapf(types.ErrSequenceGap, "expected %d, got %d", replica.NextSequence, data.Sequence)
+	case data.Op == types.ReplicationOpSet:
+		postID := strconv.FormatUint(data.Post.Id, 10)
+		if err := k.ReplicatedPosts.Set(ctx, collections.Join(channelID, postID), types.RemotePost{
+			ChannelID:   channelID,
+			PostID:      postID,
+			Post:        data.Post,
+			FetchHeight: ctx.BlockHeight(),
+			FetchedAt:   ctx.BlockTime(),
+		}); err != nil {
+			return packetAck, err
+		}
+	case data.Op == types.ReplicationOpRemove:
+		if err := k.ReplicatedPosts.Remove(ctx, collections.Join(channelID, strconv.FormatUint(data.Post.Id, 10))); err != nil {
+			return packetAck, err
+		}
+	}
+
+	replica.NextSequence = data.Sequence + 1
+	replica.AppliedHeight = ctx.BlockHeight()
+	packetAck.Applied = true
+
+	return packetAck, k.Replicas.Set(ctx, channelID, replica)
+}
+
+// removeReplicatedPosts removes the replicatedPosts of a channel
+func (k Keeper) removeReplicatedPosts(ctx sdk.Context, channelID string) error {
+	var keys []collections.Pair[string, string]
+	err := k.ReplicatedPosts.Walk(ctx, collections.NewPrefixedPairRange[string, string](channelID), func(key collections.Pair[string, string], _ types.RemotePost) bool {
+		keys = append(keys, key)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ReplicatedPosts.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnAcknowledgementReplicatePostPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. The replication is out of
+// sync once a change fails.
+func (k Keeper) OnAcknowledgementReplicatePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.ReplicatePostPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.setReplicationOutOfSync(ctx, packet.SourceChannel, data.Sequence)
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.ReplicatePostPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutReplicatePostPacket responds to the case where a packet has not been transmitted because of a timeout.
+// The replication is out of sync since the replica missed the change.
+func (k Keeper) OnTimeoutReplicatePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.ReplicatePostPacketData) error {
+	return k.setReplicationOutOfSync(ctx, packet.SourceChannel, data.Sequence)
+}
+
+// setReplicationOutOfSync stops sending changes on a channel whose replica
+// missed the change of the sequence, and the snapshot in progress on it.
+// Changes sent before the last snapshot and snapshots already requested are
+// left alone.
+func (k Keeper) setReplicationOutOfSync(ctx sdk.Context, channelID string, sequence uint64) error {
+	subscription, err := k.ReplicationSubscriptions.Get(ctx, channelID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if sequence < subscription.ResetSequence || (!subscription.SnapshotStarted && (!subscription.Synced || subscription.SnapshotPending)) {
+		return nil
+	}
+
+	subscription.Synced = false
+	stopSnapshot(&subscription)
+	if err := k.ReplicationSubscriptions.Set(ctx, channelID, subscription); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReplicationOutOfSync,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+	return nil
+}
+
+// stopSnapshot clears the pending snapshot of a subscription
+func stopSnapshot(subscription *types.ReplicationSubscription) {
+	subscription.SnapshotPending = false
+	subscription.SnapshotStarted = false
+	subscription.SnapshotCursor = 0
+}
+
+// queuePostChange queues the change of a local post until the end of the
+// block, when it is sent to the replicas. Nothing is queued without
+// subscriptions.
+func (k Keeper) queuePostChange(ctx context.Context, op types.ReplicationOp, post types.Post) error {
+	subscribed := false
+	if err := Walk(ctx, k.ReplicationSubscriptions, func(string, types.ReplicationSubscription) bool {
+		subscribed = true
+		return true
+	}); err != nil || !subscribed {
+		return err
+	}
+
+	id, err := k.PostChangeSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	return k.PostChanges.Set(ctx, id, types.PostChange{Op: op, Post: post})
+}
+
+// ReplicatePosts sends the postChanges queued during the block to the synced
+// replicas, and a page of the snapshot of the posts to the replicas that
+// subscribed or re-synced. A channel the packets can't be sent on is out of sync.
+func (k Keeper) ReplicatePosts(ctx sdk.Context) error {
+	var (
+		changeIDs []uint64
+		changes   []types.PostChange
+	)
+	if err := Walk(ctx, k.PostChanges, func(id uint64, change types.PostChange) bool {
+		changeIDs = append(changeIDs, id)
+		changes = append(changes, change)
+		return false
+	}); err != nil {
+		return err
+	}
+	for _, id := range changeIDs {
+		if err := k.PostChanges.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	var subscriptions []types.ReplicationSubscription
+	if err := Walk(ctx, k.ReplicationSubscriptions, func(_ string, subscription types.ReplicationSubscription) bool {
+		subscriptions = append(subscriptions, subscription)
+		return false
+	}); err != nil {
+		return err
+	}
+
+	pageSize := k.GetParams(ctx).SnapshotPageSize
+	for _, subscription := range subscriptions {
+		if !subscription.SnapshotPending && (!subscription.Synced || len(changes) == 0) {
+			continue
+		}
+
+		// Packets sent before a failure are reverted along with the sequence
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.sendReplication(cacheCtx, subscription, changes, pageSize); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("replication on channel %s failed: %s", subscription.ChannelID, err))
+
+			subscription.Synced = false
+			stopSnapshot(&subscription)
+			if err := k.ReplicationSubscriptions.Set(ctx, subscription.ChannelID, subscription); err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeReplicationOutOfSync,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyChannel, subscription.ChannelID),
+					sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(subscription.NextSequence, 10)),
+				),
+			)
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// sendReplication sends the changes, or the next page of the pending snapshot,
+// on the channel of a subscription
+func (k Keeper) sendReplication(ctx sdk.Context, subscription types.ReplicationSubscription, changes []types.PostChange, pageSize uint64) error {
+	if subscription.SnapshotPending {
+		var err error
+		if changes, err = k.snapshotPage(ctx, &subscription, changes, pageSize); err != nil {
+			return err
+		}
+	}
+
+	timeoutTimestamp := k.packetTimeoutTimestamp(ctx, 0)
+	for _, change := range changes {
+		if _, err := k.TransmitReplicatePostPacket(
+			ctx,
+			types.ReplicatePostPacketData{Sequence: subscription.NextSequence, Op: change.Op, Post: change.Post},
+			k.GetPort(ctx),
+			subscription.ChannelID,
+			clienttypes.ZeroHeight(),
+			timeoutTimestamp,
+		); err != nil {
+			return err
+		}
+		subscription.NextSequence++
+	}
+	return k.ReplicationSubscriptions.Set(ctx, subscription.ChannelID, subscription)
+}
+
+// snapshotPage returns the changes sent on a channel with a pending snapshot:
+// the reset starting the snapshot, or the changes of the posts already sent,
+// followed by the next page of posts from the cursor. The subscription is
+// synced once the last page is sent.
+func (k Keeper) snapshotPage(ctx sdk.Context, subscription *types.ReplicationSubscription, changes []types.PostChange, pageSize uint64) ([]types.PostChange, error) {
+	var page []types.PostChange
+	if !subscription.SnapshotStarted {
+		// The changes of the block are part of the snapshot
+		page = append(page, types.PostChange{Op: types.ReplicationOpReset})
+		subscription.ResetSequence = subscription.NextSequence
+		subscription.Synced = false
+		subscription.SnapshotStarted = true
+		subscription.SnapshotCursor = 0
+	} else {
+		// The posts from the cursor on are sent by the next pages
+		for _, change := range changes {
+			if change.Post.Id < subscription.SnapshotCursor {
+				page = append(page, change)
+			}
+		}
+	}
+
+	var (
+		sent uint64
+		more bool
+	)
+	err := k.Posts.Walk(ctx, new(collections.Range[uint64]).StartInclusive(subscription.SnapshotCursor), func(id uint64, post types.Post) bool {
+		if sent == pageSize {
+			more = true
+			return true
+		}
+		page = append(page, types.PostChange{Op: types.ReplicationOpSet, Post: post})
+		subscription.SnapshotCursor = id + 1
+		sent++
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	if !more {
+		stopSnapshot(subscription)
+		subscription.Synced = true
+	}
+	return page, nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestQueuePostChange(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+
+	// Nothing is queued without subscriptions
+	id, err := k.AppendPost(ctx, types.Post{Title: "first"})
+	require.NoError(t, err)
+	require.Empty(t, keepertest.AllPostChanges(t, ctx, k.PostChanges))
+
+	require.NoError(t, k.ReplicationSubscriptions.Set(ctx, "channel-1", types.ReplicationSubscription{ChannelID: "channel-1"}))
+	second, err := k.AppendPost(ctx, types.Post{Title: "second"})
+	require.NoError(t, err)
+	require.NoError(t, k.SetPost(ctx, types.Post{Id: id, Title: "edited"}))
+	require.NoError(t, k.RemovePost(ctx, second))
+
+	require.Equal(t, []types.PostChange{
+		{Op: types.ReplicationOpSet, Post: types.Post{Id: second, Title: "second"}},
+		{Op: types.ReplicationOpSet, Post: types.Post{Id: id, Title: "edited"}},
+		{Op: types.ReplicationOpRemove, Post: types.Post{Id: second}},
+	}, keepertest.AllPostChanges(t, ctx, k.PostChanges))
+}
+
+func TestOnRecvReplicatePostPacket(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	ctx = ctx.WithBlockHeight(9)
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	recv := func(sequence uint64, op types.ReplicationOp, post types.Post) (types.ReplicatePostPacketAck, error) {
+		return k.OnRecvReplicatePostPacket(ctx, packet, types.ReplicatePostPacketData{Sequence: sequence, Op: op, Post: post})
+	}
+
+	_, err := recv(1, types.ReplicationOpReset, types.Post{})
+	require.ErrorIs(t, err, types.ErrReplicationNotFound)
+
+	// A replica waiting for its snapshot only accepts a reset
+	require.NoError(t, k.Replicas.Set(ctx, "channel-1", types.Replica{ChannelID: "channel-1"}))
+	require.NoError(t, k.ReplicatedPosts.Set(ctx, collections.Join("channel-1", "0"), types.RemotePost{ChannelID: "channel-1", PostID: "0"}))
+	require.NoError(t, k.ReplicatedPosts.Set(ctx, collections.Join("channel-2", "0"), types.RemotePost{ChannelID: "channel-2", PostID: "0"}))
+	require.NoError(t, k.RemotePosts.Set(ctx, collections.Join("channel-1", "3"), types.RemotePost{ChannelID: "channel-1", PostID: "3"}))
+	_, err = recv(1, types.ReplicationOpSet, types.Post{Id: 1, Title: "title"})
+	require.ErrorIs(t, err, types.ErrSequenceGap)
+
+	// The reset drops the replicated posts of the channel only
+	ack, err := recv(5, types.ReplicationOpReset, types.Post{})
+	require.NoError(t, err)
+	require.True(t, ack.Applied)
+	_, err = k.ReplicatedPosts.Get(ctx, collections.Join("channel-1", "0"))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = k.ReplicatedPosts.Get(ctx, collections.Join("channel-2", "0"))
+	require.NoError(t, err)
+
+	// A set must carry a valid post
+	_, err = recv(6, types.ReplicationOpSet, types.Post{Id: 3})
+	require.ErrorIs(t, err, types.ErrEmptyTitle)
+	_, err = recv(6, types.ReplicationOpSet, types.Post{Id: 3, Title: "title", Content: "con\x00tent"})
+	require.ErrorIs(t, err, types.ErrControlCharacter)
+
+	ack, err = recv(6, types.ReplicationOpSet, types.Post{Id: 3, Title: "title"})
+	require.NoError(t, err)
+	require.True(t, ack.Applied)
+	replicatedPost, err := k.ReplicatedPosts.Get(ctx, collections.Join("channel-1", "3"))
+	require.NoError(t, err)
+	require.Equal(t, "title", replicatedPost.Post.Title)
+	require.Equal(t, int64(9), replicatedPost.FetchHeight)
+
+	// A change already applied is skipped
+	ack, err = recv(6, types.ReplicationOpSet, types.Post{Id: 3, Title: "stale"})
+	require.NoError(t, err)
+	require.False(t, ack.Applied)
+	replicatedPost, err = k.ReplicatedPosts.Get(ctx, collections.Join("channel-1", "3"))
+	require.NoError(t, err)
+	require.Equal(t, "title", replicatedPost.Post.Title)
+
+	_, err = recv(8, types.ReplicationOpRemove, types.Post{Id: 3})
+	require.ErrorIs(t, err, types.ErrSequenceGap)
+
+	ack, err = recv(7, types.ReplicationOpRemove, types.Post{Id: 3})
+	require.NoError(t, err)
+	require.True(t, ack.Applied)
+	_, err = k.ReplicatedPosts.Get(ctx, collections.Join("channel-1", "3"))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// The post fetched over the channel is left alone
+	_, err = k.RemotePosts.Get(ctx, collections.Join("channel-1", "3"))
+	require.NoError(t, err)
+
+	replica, err := k.Replicas.Get(ctx, "channel-1")
+	require.NoError(t, err)
+	require.Equal(t, types.Replica{ChannelID: "channel-1", NextSequence: 8, AppliedHeight: 9}, replica)
+}
+
+func TestOnAcknowledgementReplicatePostPacket(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	subscription := types.ReplicationSubscription{ChannelID: "channel-0", NextSequence: 9, ResetSequence: 5, Synced: true}
+	gapAck := types.PacketAckError{Code: types.AckErrorSequenceGap}.Acknowledgement()
+
+	for _, tc := range []struct {
+		desc     string
+		sequence uint64
+		ack      *channeltypes.Acknowledgement
+		pending  bool
+		started  bool
+		synced   bool
+	}{
+		{
+			desc:     "applied",
+			sequence: 6,
+			ack:      &channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Result{Result: []byte(`{"applied":true}`)}},
+			synced:   true,
+		},
+		{
+			desc:     "gap",
+			sequence: 6,
+			ack:      &gapAck,
+		},
+		{
+			desc:     "timeout",
+			sequence: 5,
+		},
+		{
+			desc:     "before the snapshot",
+			sequence: 4,
+			ack:      &gapAck,
+			synced:   true,
+		},
+		{
+			desc:     "snapshot pending",
+			sequence: 6,
+			ack:      &gapAck,
+			pending:  true,
+			synced:   true,
+		},
+		{
+			desc:     "snapshot in progress",
+			sequence: 6,
+			ack:      &gapAck,
+			pending:  true,
+			started:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.BlogKeeper(t)
+			subscription := subscription
+			subscription.SnapshotPending = tc.pending
+			subscription.SnapshotStarted = tc.started
+			if tc.started {
+				subscription.Synced = false
+				subscription.SnapshotCursor = 4
+			}
+			require.NoError(t, k.ReplicationSubscriptions.Set(ctx, subscription.ChannelID, subscription))
+
+			data := types.ReplicatePostPacketData{Sequence: tc.sequence, Op: types.ReplicationOpSet}
+			if tc.ack != nil {
+				require.NoError(t, k.OnAcknowledgementReplicatePostPacket(ctx, packet, data, *tc.ack))
+			} else {
+				require.NoError(t, k.OnTimeoutReplicatePostPacket(ctx, packet, data))
+			}
+
+			got, err := k.ReplicationSubscriptions.Get(ctx, subscription.ChannelID)
+			require.NoError(t, err)
+			require.Equal(t, tc.synced, got.Synced)
+			if !tc.synced {
+				// The snapshot in progress is stopped
+				require.False(t, got.SnapshotPending)
+				require.False(t, got.SnapshotStarted)
+				require.Zero(t, got.SnapshotCursor)
+				events := ctx.EventManager().Events()
+				require.Len(t, events, 1)
+				require.Equal(t, types.EventTypeReplicationOutOfSync, events[0].Type)
+			}
+		})
+	}
+}
+
+func TestReplicatePosts(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	require.NoError(t, k.ReplicationSubscriptions.Set(ctx, "channel-9", types.ReplicationSubscription{
+		ChannelID: "channel-9", NextSequence: 3, ResetSequence: 1,
+	}))
+	require.NoError(t, k.ReplicationSubscriptions.Set(ctx, keepertest.TestChannelID, types.ReplicationSubscription{
+		ChannelID: keepertest.TestChannelID, NextSequence: 1, SnapshotPending: true,
+	}))
+	_, err := k.AppendPost(ctx, types.Post{Title: "title"})
+	require.NoError(t, err)
+
+	require.NoError(t, k.ReplicatePosts(ctx))
+
+	// The queue is emptied even though nothing could be sent
+	require.Empty(t, keepertest.AllPostChanges(t, ctx, k.PostChanges))
+
+	// channel-9 is out of sync, so its changes are dropped
+	subscription, err := k.ReplicationSubscriptions.Get(ctx, "channel-9")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), subscription.NextSequence)
+
+	// The channels of the test keeper speak blog-1, so the snapshot fails
+	subscription, err = k.ReplicationSubscriptions.Get(ctx, keepertest.TestChannelID)
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicationSubscription{ChannelID: keepertest.TestChannelID, NextSequence: 1}, subscription)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeReplicationOutOfSync, events[0].Type)
+}
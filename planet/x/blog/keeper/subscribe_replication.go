@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"errors"
+
+	"planet/x/blog/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// TransmitSubscribeReplicationPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version. Chains speaking only blog-1 can't replicate their posts.
+func (k Keeper) TransmitSubscribeReplicationPacket(
+	ctx sdk.Context,
+	packetData types.SubscribeReplicationPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if version := k.ChannelVersion(ctx, sourcePort, sourceChannel); version == types.Version1 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidVersion, "channel %s has no replication, it speaks %s", sourceChannel, version)
+	}
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_SubscribeReplicationPacket{SubscribeReplicationPacket: &packetData},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvSubscribeReplicationPacket processes packet reception. The posts are
+// replicated on the channel from a snapshot sent in pages from the end of the
+// block. Subscribing again re-syncs the replication, once the pending snapshot
+// is sent.
+func (k Keeper) OnRecvSubscribeReplicationPacket(ctx sdk.Context, packet channeltypes.Packet, data types.SubscribeReplicationPacketData) (packetAck types.SubscribeReplicationPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+	if err := k.validateInboundChannel(ctx, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+
+	subscription, err := k.ReplicationSubscriptions.Get(ctx, packet.DestinationChannel)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		if data.Resync {
+			return packetAck, sdkerrors.Wrapf(types.ErrReplicationNotFound, "channel %s", packet.DestinationChannel)
+		}
+		subscription = types.ReplicationSubscription{
+			ChannelID:        packet.DestinationChannel,
+			NextSequence:     1,
+			SubscribedHeight: ctx.BlockHeight(),
+		}
+	case err != nil:
+		return packetAck, err
+	case subscription.SnapshotPending:
+		return packetAck, sdkerrors.Wrapf(types.ErrRateLimited, "snapshot pending on channel %s", packet.DestinationChannel)
+	}
+
+	// The snapshot starts with a reset at the next sequence
+	subscription.SnapshotPending = true
+	packetAck.ResetSequence = subscription.NextSequence
+
+	return packetAck, k.ReplicationSubscriptions.Set(ctx, subscription.ChannelID, subscription)
+}
+
+// OnAcknowledgementSubscribeReplicationPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. A replica whose subscription
+// failed is dropped, unless it already received a snapshot.
+func (k Keeper) OnAcknowledgementSubscribeReplicationPacket(ctx sdk.Context, packet channeltypes.Packet, data types.SubscribeReplicationPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.removeEmptyReplica(ctx, packet.SourceChannel)
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.SubscribeReplicationPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		// The replica is reset by the first packet of the snapshot
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutSubscribeReplicationPacket responds to the case where a packet has not been transmitted because of a timeout.
+// A replica whose subscription timed out is dropped, unless it already received a snapshot.
+func (k Keeper) OnTimeoutSubscribeReplicationPacket(ctx sdk.Context, packet channeltypes.Packet, data types.SubscribeReplicationPacketData) error {
+	return k.removeEmptyReplica(ctx, packet.SourceChannel)
+}
+
+// removeEmptyReplica removes the replica of a channel if it hasn't received a
+// snapshot yet
+func (k Keeper) removeEmptyReplica(ctx sdk.Context, channelID string) error {
+	replica, err := k.Replicas.Get(ctx, channelID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if replica.NextSequence != 0 {
+		return nil
+	}
+	return k.Replicas.Remove(ctx, channelID)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvSubscribeReplicationPacket(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	ctx = ctx.WithBlockHeight(4)
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+
+	_, err := k.OnRecvSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{Resync: true})
+	require.ErrorIs(t, err, types.ErrReplicationNotFound)
+
+	ack, err := k.OnRecvSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), ack.ResetSequence)
+	subscription, err := k.ReplicationSubscriptions.Get(ctx, "channel-1")
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicationSubscription{
+		ChannelID:        "channel-1",
+		NextSequence:     1,
+		SnapshotPending:  true,
+		SubscribedHeight: 4,
+	}, subscription)
+
+	// A re-sync keeps the sequence going
+	subscription.NextSequence = 7
+	subscription.SnapshotPending = false
+	require.NoError(t, k.ReplicationSubscriptions.Set(ctx, "channel-1", subscription))
+	ack, err = k.OnRecvSubscribeReplicationPacket(ctx.WithBlockHeight(8), packet, types.SubscribeReplicationPacketData{Resync: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), ack.ResetSequence)
+	subscription, err = k.ReplicationSubscriptions.Get(ctx, "channel-1")
+	require.NoError(t, err)
+	require.True(t, subscription.SnapshotPending)
+	require.Equal(t, int64(4), subscription.SubscribedHeight)
+
+	// A re-sync waits for the pending snapshot to be sent
+	_, err = k.OnRecvSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{Resync: true})
+	require.ErrorIs(t, err, types.ErrRateLimited)
+	subscription.SnapshotStarted = true
+	subscription.SnapshotCursor = 3
+	require.NoError(t, k.ReplicationSubscriptions.Set(ctx, "channel-1", subscription))
+	_, err = k.OnRecvSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{})
+	require.ErrorIs(t, err, types.ErrRateLimited)
+
+	params := k.GetParams(ctx)
+	params.InboundEnabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.OnRecvSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{})
+	require.ErrorIs(t, err, types.ErrInboundDisabled)
+}
+
+func TestOnAcknowledgementSubscribeReplicationPacket(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	ack := types.PacketAckError{Code: types.AckErrorDisabled}.Acknowledgement()
+
+	// A replica that never got a snapshot is dropped
+	require.NoError(t, k.Replicas.Set(ctx, "channel-0", types.Replica{ChannelID: "channel-0"}))
+	require.NoError(t, k.OnAcknowledgementSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{}, ack))
+	has, err := k.Replicas.Has(ctx, "channel-0")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, k.Replicas.Set(ctx, "channel-0", types.Replica{ChannelID: "channel-0", NextSequence: 3}))
+	require.NoError(t, k.OnTimeoutSubscribeReplicationPacket(ctx, packet, types.SubscribeReplicationPacketData{Resync: true}))
+	has, err = k.Replicas.Has(ctx, "channel-0")
+	require.NoError(t, err)
+	require.True(t, has)
+}
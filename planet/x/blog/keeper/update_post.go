@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"planet/x/blog/types"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -13,7 +15,8 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
-// TransmitUpdatePostPacket transmits the packet over IBC with the specified source port and source channel
+// TransmitUpdatePostPacket transmits the packet over IBC with the specified source port and source channel,
+// in the format of the channel version
 func (k Keeper) TransmitUpdatePostPacket(
 	ctx sdk.Context,
 	packetData types.UpdatePostPacketData,
@@ -21,49 +24,112 @@ func (k Keeper) TransmitUpdatePostPacket(
 	sourceChannel string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) (uint64, error) {
 	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
 		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	packetBytes, err := packetData.GetBytes()
+	packetBytes, err := k.encodePacket(ctx, sourcePort, sourceChannel, memo, types.BlogPacketData{
+		Packet: &types.BlogPacketData_UpdatePostPacket{UpdatePostPacket: &packetData},
+	})
 	if err != nil {
-		return 0, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %w", err)
+		return 0, err
 	}
 
 	return k.channelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
 }
 
+// transmitTrackedUpdatePostPacket transmits the packet and tracks it with an outboundPost
+// until it is acknowledged or times out
+func (k Keeper) transmitTrackedUpdatePostPacket(
+	ctx sdk.Context,
+	packetData types.UpdatePostPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutTimestamp uint64,
+	memo string,
+) (sequence uint64, outboundPostID uint64, err error) {
+	sequence, err = k.TransmitUpdatePostPacket(
+		ctx,
+		packetData,
+		sourcePort,
+		sourceChannel,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		memo,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	outboundPostID, err = k.trackOutboundPost(ctx, sourcePort, sourceChannel, types.OutboundPost{
+		Sequence: sequence,
+		Creator:  packetData.Creator,
+		Title:    packetData.Title,
+		PostID:   packetData.PostID,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return sequence, outboundPostID, nil
+}
+
 // OnRecvUpdatePostPacket processes packet reception
 func (k Keeper) OnRecvUpdatePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.UpdatePostPacketData) (packetAck types.UpdatePostPacketAck, err error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return packetAck, err
 	}
+	if err := k.validateInboundPost(ctx, packet.DestinationChannel, data.Title, data.Content); err != nil {
+		return packetAck, err
+	}
 
-	// TODO: packet reception logic // Done
-	postID, perr := strconv.ParseUint(data.PostID, 10, 64);
-
+	postID, perr := strconv.ParseUint(data.PostID, 10, 64)
 	if perr != nil {
-		packetAck.IsSuccess = false;
-		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot parse postID: %w", perr)
+		packetAck.IsSuccess = false
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot parse postID: %s", perr)
 	}
 
-	post, is_find := k.GetPost(ctx, postID);
+	post, err := k.Posts.Get(ctx, postID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return packetAck, sdkerrors.Wrapf(types.ErrPostNotFound, "post %d", postID)
+	}
+	if err != nil {
+		return packetAck, err
+	}
 
-	if !is_find {
-		packetAck.IsSuccess = false;
-		return packetAck, nil
+	// Only the original author, writing from the chain the post came from, may update it
+	if !isPacketAuthor(post, packet, data.Creator) {
+		return packetAck, sdkerrors.Wrapf(types.ErrUnauthorized, "post %d", postID)
 	}
 
-	post.Content = data.Content;
-	post.Title = data.Title;
+	// Reject edits based on a stale revision, which may arrive out of order on an
+	// unordered channel, instead of letting them overwrite a newer edit
+	packetAck.Revision = post.Revision
+	if data.BaseRevision != post.Revision {
+		return packetAck, sdkerrors.Wrapf(
+			types.ErrRevisionConflict,
+			"edit based on revision %d, current revision is %d",
+			data.BaseRevision,
+			post.Revision,
+		)
+	}
 
-	k.SetPost(
-		ctx,
-		post,
-	)
+	if err := k.archivePostRevision(ctx, post, data.Creator); err != nil {
+		return packetAck, err
+	}
+
+	post.Content = data.Content
+	post.Title = data.Title
+	post.Revision++
+	packetAck.Revision = post.Revision
+
+	if err := k.SetPost(ctx, post); err != nil {
+		return packetAck, err
+	}
 
 	packetAck.IsSuccess = true
 
@@ -75,11 +141,14 @@ func (k Keeper) OnRecvUpdatePostPacket(ctx sdk.Context, packet channeltypes.Pack
 func (k Keeper) OnAcknowledgementUpdatePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.UpdatePostPacketData, ack channeltypes.Acknowledgement) error {
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-
-		// TODO: failed acknowledgement logic
-		_ = dispatchedAck.Error
-
-		return nil
+		ackErr := types.ParsePacketAckError(dispatchedAck.Error)
+		if ackErr.Code == types.AckErrorConflict {
+			if err := k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostConflict, data.PostID, dispatchedAck.Error); err != nil {
+				return err
+			}
+			return k.setOutboundPostRevision(ctx, packet.SourceChannel, packet.Sequence, ackErr.Revision)
+		}
+		return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostFailed, data.PostID, dispatchedAck.Error)
 	case *channeltypes.Acknowledgement_Result:
 		// Decode the packet acknowledgment
 		var packetAck types.UpdatePostPacketAck
@@ -89,27 +158,45 @@ func (k Keeper) OnAcknowledgementUpdatePostPacket(ctx sdk.Context, packet channe
 			return errors.New("cannot unmarshal acknowledgment")
 		}
 
-		// TODO: successful acknowledgement logic 	// Done
-		// Find the post by postID
-		postID, perr := strconv.ParseUint(data.PostID, 10, 64);
-
-		if perr != nil {
-			return nil
-		}
-
-		if packetAck.IsSuccess {
-			sentPost, is_find := k.GetSentPost(ctx, postID);
+		// Counterparties predating ack error codes acknowledge conflicts and
+		// missing posts with a result
+		switch {
+		case packetAck.Conflict:
+			if err := k.setOutboundPostStatus(
+				ctx,
+				packet.SourceChannel,
+				packet.Sequence,
+				types.OutboundPostConflict,
+				data.PostID,
+				fmt.Sprintf("edit based on revision %d, current revision is %d", data.BaseRevision, packetAck.Revision),
+			); err != nil {
+				return err
+			}
+			return k.setOutboundPostRevision(ctx, packet.SourceChannel, packet.Sequence, packetAck.Revision)
+		case !packetAck.IsSuccess:
+			return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostFailed, data.PostID, "post not found")
+		default:
+			if err := k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostDelivered, data.PostID, ""); err != nil {
+				return err
+			}
+			if err := k.setOutboundPostRevision(ctx, packet.SourceChannel, packet.Sequence, packetAck.Revision); err != nil {
+				return err
+			}
 
-			if !is_find {
+			// data.PostID is the post ID on the counterparty chain
+			sentPost, err := k.GetSentPostByRemote(ctx, packet.SourceChannel, data.PostID)
+			if errors.Is(err, collections.ErrNotFound) {
 				return nil
 			}
-			
+			if err != nil {
+				return err
+			}
+
 			// update SentPost and save
-			sentPost.Title = data.Title;
-			k.SetSentPost(ctx, sentPost);
+			sentPost.Title = data.Title
+			sentPost.Revision = packetAck.Revision
+			return k.SentPosts.Set(ctx, sentPost.Id, sentPost)
 		}
-
-		return nil
 	default:
 		// The counter-party module doesn't implement the correct acknowledgment format
 		return errors.New("invalid acknowledgment format")
@@ -118,8 +205,22 @@ func (k Keeper) OnAcknowledgementUpdatePostPacket(ctx sdk.Context, packet channe
 
 // OnTimeoutUpdatePostPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutUpdatePostPacket(ctx sdk.Context, packet channeltypes.Packet, data types.UpdatePostPacketData) error {
-
-	// TODO: packet timeout logic
-
-	return nil
+	// Record the update so that it can be retried
+	if _, err := k.AppendTimeoutPost(
+		ctx,
+		types.TimeoutPost{
+			Creator:      data.Creator,
+			Title:        data.Title,
+			Content:      data.Content,
+			Chain:        packet.DestinationPort + "-" + packet.DestinationChannel,
+			Port:         packet.SourcePort,
+			ChannelID:    packet.SourceChannel,
+			PostID:       data.PostID,
+			BaseRevision: data.BaseRevision,
+			Memo:         k.sentPacketMemo(ctx, packet),
+		},
+	); err != nil {
+		return err
+	}
+	return k.setOutboundPostStatus(ctx, packet.SourceChannel, packet.Sequence, types.OutboundPostTimedOut, data.PostID, "")
 }
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/types"
+)
+
+func TestOnRecvUpdatePostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	creator := sample.AccAddress()
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+
+	ack, err := keeper.OnRecvIbcPostPacket(ctx, packet, types.IbcPostPacketData{
+		Title:   "title",
+		Content: "content",
+		Creator: creator,
+	})
+	require.NoError(t, err)
+	postID, err := strconv.ParseUint(ack.PostID, 10, 64)
+	require.NoError(t, err)
+	post, err := keeper.Posts.Get(ctx, postID)
+	require.NoError(t, err)
+	require.Equal(t, creator, post.Creator)
+	require.Equal(t, packet.SourcePort, post.OriginPort)
+	require.Equal(t, packet.SourceChannel, post.OriginChannel)
+	require.Equal(t, packet.DestinationChannel, post.ChannelID)
+	require.Equal(t, "counterparty", post.OriginChainID)
+
+	for _, tc := range []struct {
+		desc    string
+		packet  channeltypes.Packet
+		creator string
+		err     error
+	}{
+		{
+			desc:    "other sender",
+			packet:  packet,
+			creator: sample.AccAddress(),
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc: "other channel",
+			packet: channeltypes.Packet{
+				SourcePort:         types.PortID,
+				SourceChannel:      "channel-1",
+				DestinationPort:    types.PortID,
+				DestinationChannel: "channel-1",
+			},
+			creator: creator,
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc: "other counterparty with the same channel",
+			packet: channeltypes.Packet{
+				SourcePort:         types.PortID,
+				SourceChannel:      "channel-0",
+				DestinationPort:    types.PortID,
+				DestinationChannel: "channel-2",
+			},
+			creator: creator,
+			err:     types.ErrUnauthorized,
+		},
+		{
+			desc:    "author",
+			packet:  packet,
+			creator: creator,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			data := types.UpdatePostPacketData{
+				PostID:  ack.PostID,
+				Title:   tc.desc,
+				Content: tc.desc,
+				Creator: tc.creator,
+			}
+			updateAck, recvErr := keeper.OnRecvUpdatePostPacket(ctx, tc.packet, data)
+			post, err := keeper.Posts.Get(ctx, postID)
+			require.NoError(t, err)
+			if tc.err != nil {
+				require.ErrorIs(t, recvErr, tc.err)
+				require.NotEqual(t, tc.desc, post.Title)
+				return
+			}
+			require.NoError(t, recvErr)
+			require.True(t, updateAck.IsSuccess)
+			require.Equal(t, tc.desc, post.Title)
+			require.Equal(t, tc.desc, post.Content)
+			require.Equal(t, uint64(1), post.Revision)
+			require.Equal(t, uint64(1), updateAck.Revision)
+		})
+	}
+
+	// An edit based on the revision before the last one is stale
+	staleAck, err := keeper.OnRecvUpdatePostPacket(ctx, packet, types.UpdatePostPacketData{
+		PostID:       ack.PostID,
+		Title:        "stale",
+		Creator:      creator,
+		BaseRevision: 0,
+	})
+	require.ErrorIs(t, err, types.ErrRevisionConflict)
+	require.False(t, staleAck.IsSuccess)
+	require.Equal(t, uint64(1), staleAck.Revision)
+	post, err = keeper.Posts.Get(ctx, postID)
+	require.NoError(t, err)
+	require.Equal(t, "author", post.Title)
+
+	nextAck, err := keeper.OnRecvUpdatePostPacket(ctx, packet, types.UpdatePostPacketData{
+		PostID:       ack.PostID,
+		Title:        "next",
+		Creator:      creator,
+		BaseRevision: 1,
+	})
+	require.NoError(t, err)
+	require.True(t, nextAck.IsSuccess)
+	require.Equal(t, uint64(2), nextAck.Revision)
+
+	_, err = keeper.OnRecvUpdatePostPacket(ctx, packet, types.UpdatePostPacketData{
+		PostID:  "10",
+		Title:   "missing",
+		Creator: creator,
+	})
+	require.ErrorIs(t, err, types.ErrPostNotFound)
+}
+
+func TestOnAcknowledgementUpdatePostPacket(t *testing.T) {
+	keeper, ctx := keepertest.BlogKeeper(t)
+	// Local IDs and remote post IDs overlap: remote post 0 is tracked by sentPost 1
+	other, err := keeper.AppendSentPost(ctx, types.SentPost{Title: "other", ChannelID: "channel-0", PostID: "5"})
+	require.NoError(t, err)
+	id, err := keeper.AppendSentPost(ctx, types.SentPost{Title: "title", ChannelID: "channel-0", PostID: "0"})
+	require.NoError(t, err)
+	// Another counterparty also names its channel channel-1 and has a post 0
+	sibling, err := keeper.AppendSentPost(ctx, types.SentPost{Title: "sibling", ChannelID: "channel-2", PostID: "0"})
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-1",
+	}
+	data := types.UpdatePostPacketData{PostID: "0", Title: "updated"}
+	require.NoError(t, keeper.SetOutboundPost(ctx, types.OutboundPost{ChannelID: packet.SourceChannel, Sequence: packet.Sequence, Status: types.OutboundPostPending}))
+	ack := channeltypes.NewResultAcknowledgement([]byte(`{"isSuccess":true,"revision":"1"}`))
+	require.NoError(t, keeper.OnAcknowledgementUpdatePostPacket(ctx, packet, data, ack))
+
+	sentPost, err := keeper.SentPosts.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "updated", sentPost.Title)
+	require.Equal(t, uint64(1), sentPost.Revision)
+	sentPost, err = keeper.SentPosts.Get(ctx, other)
+	require.NoError(t, err)
+	require.Equal(t, "other", sentPost.Title)
+	sentPost, err = keeper.SentPosts.Get(ctx, sibling)
+	require.NoError(t, err)
+	require.Equal(t, "sibling", sentPost.Title)
+	outboundPost, err := keeper.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostDelivered, outboundPost.Status)
+	require.Equal(t, uint64(1), outboundPost.Revision)
+
+	// A conflicting edit leaves the sent post untouched
+	data = types.UpdatePostPacketData{PostID: "0", Title: "stale", BaseRevision: 0}
+	ack = types.PacketAckError{Code: types.AckErrorConflict, Revision: 2}.Acknowledgement()
+	require.NoError(t, keeper.OnAcknowledgementUpdatePostPacket(ctx, packet, data, ack))
+
+	sentPost, err = keeper.SentPosts.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "updated", sentPost.Title)
+	outboundPost, err = keeper.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostConflict, outboundPost.Status)
+	require.Equal(t, types.AckErrorConflict, outboundPost.ErrorCode)
+	require.Equal(t, uint64(2), outboundPost.Revision)
+
+	// Counterparties predating ack error codes acknowledge conflicts with a result
+	ack = channeltypes.NewResultAcknowledgement([]byte(`{"conflict":true,"revision":"1"}`))
+	require.NoError(t, keeper.OnAcknowledgementUpdatePostPacket(ctx, packet, data, ack))
+
+	outboundPost, err = keeper.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostConflict, outboundPost.Status)
+	require.Equal(t, uint64(1), outboundPost.Revision)
+	require.Contains(t, outboundPost.Error, "current revision is 1")
+
+	// Other errors fail the update with their code
+	ack = types.PacketAckError{Code: types.AckErrorNotFound}.Acknowledgement()
+	require.NoError(t, keeper.OnAcknowledgementUpdatePostPacket(ctx, packet, data, ack))
+
+	outboundPost, err = keeper.OutboundPosts.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostFailed, outboundPost.Status)
+	require.Equal(t, types.AckErrorNotFound, outboundPost.ErrorCode)
+}
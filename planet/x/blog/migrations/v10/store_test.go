@@ -0,0 +1,34 @@
+package v10_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+
+	// v9 params have no maxInboundPacketsPerBlock
+	params := types.DefaultParams()
+	params.MaxBatchPosts = 5
+	params.MaxInboundPacketsPerBlock = 0
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate9to10(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxInboundPacketsPerBlock, migrated.MaxInboundPacketsPerBlock)
+	require.Equal(t, uint64(5), migrated.MaxBatchPosts)
+
+	// A limit set before the migration is kept
+	migrated.MaxInboundPacketsPerBlock = 3
+	require.NoError(t, k.SetParams(ctx, migrated))
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate9to10(ctx))
+	require.Equal(t, uint64(3), k.GetParams(ctx).MaxInboundPacketsPerBlock)
+}
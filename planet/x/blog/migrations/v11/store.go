@@ -0,0 +1,102 @@
+package v11
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v10 to v11. The params
+// stored before the snapshots were paged have no snapshotPageSize, which takes
+// its default value. The posts replicated over a channel were stored with the
+// posts fetched over it, the remotePosts of the channels with a replica are
+// moved to the replicatedPosts.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	if err := migrateParams(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+	return migrateReplicatedPosts(ctx, storeKey, cdc)
+}
+
+func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	} else {
+		params = types.DefaultParams()
+	}
+	if params.SnapshotPageSize == 0 {
+		params.SnapshotPageSize = types.DefaultSnapshotPageSize
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	if err := validate(bz); err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+func migrateReplicatedPosts(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	replicaStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.ReplicaKey))
+	remotePostStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RemotePostKey))
+	replicatedPostStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.ReplicatedPostKey))
+
+	replicated := make(map[string]bool)
+	iterator := sdk.KVStorePrefixIterator(replicaStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var replica types.Replica
+		if err := cdc.Unmarshal(iterator.Value(), &replica); err != nil {
+			iterator.Close()
+			return err
+		}
+		replicated[replica.ChannelID] = true
+	}
+	iterator.Close()
+	if len(replicated) == 0 {
+		return nil
+	}
+
+	var keys, values [][]byte
+	iterator = sdk.KVStorePrefixIterator(remotePostStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var remotePost types.RemotePost
+		if err := cdc.Unmarshal(iterator.Value(), &remotePost); err != nil {
+			iterator.Close()
+			return err
+		}
+		if replicated[remotePost.ChannelID] {
+			keys = append(keys, iterator.Key())
+			values = append(values, iterator.Value())
+		}
+	}
+	iterator.Close()
+
+	// The key of a post, by channel and post ID, is the same in both stores
+	for i, key := range keys {
+		replicatedPostStore.Set(key, values[i])
+		remotePostStore.Delete(key)
+	}
+
+	return nil
+}
+
+// validate decodes the params over the default params, so that the fields
+// missing from the encoding are set, and validates them
+func validate(bz []byte) error {
+	params := types.DefaultParams()
+	if err := params.Unmarshal(bz); err != nil {
+		return err
+	}
+	return params.Validate()
+}
@@ -0,0 +1,55 @@
+package v11_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+
+	// v10 params have no snapshotPageSize
+	params := types.DefaultParams()
+	params.MaxBatchPosts = 5
+	params.SnapshotPageSize = 0
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	// v10 replicas store their posts with the fetched ones
+	require.NoError(t, k.Replicas.Set(ctx, "channel-0", types.Replica{ChannelID: "channel-0", NextSequence: 3}))
+	replicated := types.RemotePost{ChannelID: "channel-0", PostID: "3", Post: types.Post{Id: 3, Title: "title"}}
+	fetched := types.RemotePost{ChannelID: "channel-1", PostID: "3", Post: types.Post{Id: 3, Title: "title"}}
+	require.NoError(t, k.RemotePosts.Set(ctx, collections.Join(replicated.ChannelID, replicated.PostID), replicated))
+	require.NoError(t, k.RemotePosts.Set(ctx, collections.Join(fetched.ChannelID, fetched.PostID), fetched))
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate10to11(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.DefaultSnapshotPageSize, migrated.SnapshotPageSize)
+	require.Equal(t, uint64(5), migrated.MaxBatchPosts)
+
+	got, err := k.ReplicatedPosts.Get(ctx, collections.Join("channel-0", "3"))
+	require.NoError(t, err)
+	require.Equal(t, replicated, got)
+	_, err = k.RemotePosts.Get(ctx, collections.Join("channel-0", "3"))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// The posts fetched over a channel without a replica stay remotePosts
+	got, err = k.RemotePosts.Get(ctx, collections.Join("channel-1", "3"))
+	require.NoError(t, err)
+	require.Equal(t, fetched, got)
+	_, err = k.ReplicatedPosts.Get(ctx, collections.Join("channel-1", "3"))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// A page size set before the migration is kept
+	migrated.SnapshotPageSize = 3
+	require.NoError(t, k.SetParams(ctx, migrated))
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate10to11(ctx))
+	require.Equal(t, uint64(3), k.GetParams(ctx).SnapshotPageSize)
+}
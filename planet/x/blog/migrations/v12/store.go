@@ -0,0 +1,117 @@
+package v12
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "planet/x/blog/migrations/v2"
+	v3 "planet/x/blog/migrations/v3"
+	v9 "planet/x/blog/migrations/v9"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v11 to v12. Received
+// posts without a channel get the local channel they arrived on, resolved from
+// their origin, and sentPosts without a chain ID the chain ID of their
+// destination, resolved from their "port-channel" chain. The index of posts by
+// channel held the origin channel and the index of sentPosts by destination
+// chain the "port-channel" chain: both are rebuilt from the records, with the
+// index of posts by origin chain.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, localChannel v9.ChannelResolver, chainID v2.ChainIDResolver) error {
+	if err := migratePosts(ctx, storeKey, cdc, localChannel); err != nil {
+		return err
+	}
+	return migrateSentPosts(ctx, storeKey, cdc, chainID)
+}
+
+func migratePosts(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, localChannel v9.ChannelResolver) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostKey))
+	channelStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostChannelKey))
+	chainStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostChainKey))
+
+	var posts []types.Post
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			iterator.Close()
+			return err
+		}
+		posts = append(posts, post)
+	}
+	iterator.Close()
+	clearIndex(channelStore)
+	clearIndex(chainStore)
+
+	for _, post := range posts {
+		id := sdk.Uint64ToBigEndian(post.Id)
+		if post.ChannelID == "" && post.OriginChannel != "" {
+			post.ChannelID = localChannel(ctx, post.OriginPort, post.OriginChannel)
+			bz, err := cdc.Marshal(&post)
+			if err != nil {
+				return err
+			}
+			store.Set(id, bz)
+		}
+		// Index entries hold no value since v6
+		if post.ChannelID != "" {
+			channelStore.Set(types.PostChannelIndex(post.ChannelID, post.Id), []byte{})
+		}
+		if post.OriginChainID != "" {
+			chainStore.Set(types.PostChainIndex(post.OriginChainID, post.Id), []byte{})
+		}
+	}
+
+	return nil
+}
+
+func migrateSentPosts(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, chainID v2.ChainIDResolver) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostKey))
+	chainStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostChainKey))
+
+	var sentPosts []types.SentPost
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var sentPost types.SentPost
+		if err := cdc.Unmarshal(iterator.Value(), &sentPost); err != nil {
+			iterator.Close()
+			return err
+		}
+		sentPosts = append(sentPosts, sentPost)
+	}
+	iterator.Close()
+	clearIndex(chainStore)
+
+	for _, sentPost := range sentPosts {
+		id := sdk.Uint64ToBigEndian(sentPost.Id)
+		if channel := v3.ParseChainChannel(sentPost.Chain); sentPost.ChainID == "" && channel != "" {
+			sentPost.ChainID = chainID(ctx, strings.TrimSuffix(sentPost.Chain, "-"+channel), channel)
+			bz, err := cdc.Marshal(&sentPost)
+			if err != nil {
+				return err
+			}
+			store.Set(id, bz)
+		}
+		if sentPost.ChainID != "" {
+			chainStore.Set(types.SentPostChainIndex(sentPost.ChainID, sentPost.Id), []byte{})
+		}
+	}
+
+	return nil
+}
+
+// clearIndex deletes all the entries of an index store
+func clearIndex(indexStore prefix.Store) {
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		indexStore.Delete(key)
+	}
+}
@@ -0,0 +1,75 @@
+package v12_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// v11 records have no local channel and no destination chain ID
+	received := types.Post{Id: 0, Title: "received", Creator: "bob", OriginPort: types.PortID, OriginChannel: keepertest.TestCounterpartyChannelID, OriginChainID: "counterparty"}
+	unknown := types.Post{Id: 1, Title: "unknown channel", Creator: "carol", OriginPort: types.PortID, OriginChannel: "channel-5"}
+	resolved := types.Post{Id: 2, Title: "resolved", Creator: "dave", OriginPort: types.PortID, OriginChannel: "channel-7", ChannelID: "channel-2", OriginChainID: "venus"}
+	for _, post := range []types.Post{received, unknown, resolved} {
+		require.NoError(t, k.Posts.Set(ctx, post.Id, post))
+	}
+	sentPost := types.SentPost{Id: 0, Title: "sent", Chain: "blog-" + keepertest.TestCounterpartyChannelID, ChannelID: keepertest.TestChannelID}
+	require.NoError(t, k.SentPosts.Set(ctx, sentPost.Id, sentPost))
+
+	// v11 indexes posts by origin channel and sentPosts by "port-channel" chain
+	store := ctx.KVStore(storeKey)
+	channelStore := prefix.NewStore(store, types.KeyPrefix(types.PostChannelKey))
+	channelStore.Set(types.PostChannelIndex(received.OriginChannel, received.Id), []byte{})
+	channelStore.Set(types.PostChannelIndex(unknown.OriginChannel, unknown.Id), []byte{})
+	chainStore := prefix.NewStore(store, types.KeyPrefix(types.PostChainKey))
+	chainStore.Delete(types.PostChainIndex(received.OriginChainID, received.Id))
+	sentChainStore := prefix.NewStore(store, types.KeyPrefix(types.SentPostChainKey))
+	sentChainStore.Set(types.SentPostChainIndex(sentPost.Chain, sentPost.Id), []byte{})
+	_, broken := keeper.IndexInvariant(*k)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate11to12(ctx))
+
+	msg, broken := keeper.IndexInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+
+	byChannel, err := k.PostByChannel(wctx, &types.QueryPostByChannelRequest{ChannelID: keepertest.TestChannelID})
+	require.NoError(t, err)
+	require.Len(t, byChannel.Post, 1)
+	require.Equal(t, "received", byChannel.Post[0].Title)
+	require.Equal(t, keepertest.TestChannelID, byChannel.Post[0].ChannelID)
+	byChannel, err = k.PostByChannel(wctx, &types.QueryPostByChannelRequest{ChannelID: keepertest.TestCounterpartyChannelID})
+	require.NoError(t, err)
+	require.Empty(t, byChannel.Post)
+
+	// No owned channel is connected to channel-5
+	got, err := k.Posts.Get(ctx, unknown.Id)
+	require.NoError(t, err)
+	require.Empty(t, got.ChannelID)
+
+	// A channel set before the migration is kept
+	got, err = k.Posts.Get(ctx, resolved.Id)
+	require.NoError(t, err)
+	require.Equal(t, "channel-2", got.ChannelID)
+
+	byOrigin, err := k.PostByChain(wctx, &types.QueryPostByChainRequest{ChainID: "counterparty"})
+	require.NoError(t, err)
+	require.Len(t, byOrigin.Post, 1)
+	require.Equal(t, "received", byOrigin.Post[0].Title)
+
+	// the test channel keeper connects every channel to the counterparty chain
+	byChain, err := k.SentPostByChain(wctx, &types.QuerySentPostByChainRequest{ChainID: "counterparty"})
+	require.NoError(t, err)
+	require.Len(t, byChain.SentPost, 1)
+	require.Equal(t, "sent", byChain.SentPost[0].Title)
+	require.Equal(t, "counterparty", byChain.SentPost[0].ChainID)
+}
@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"planet/x/blog/types"
+)
+
+// ChainIDResolver returns the chain ID of the counterparty owning the given
+// counterparty port and channel
+type ChainIDResolver func(ctx sdk.Context, port, channel string) string
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// "port-channel-creator" creator of every post received over IBC is split
+// into the post origin fields.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, chainID ChainIDResolver) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var posts []types.Post
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			return err
+		}
+		posts = append(posts, post)
+	}
+
+	for _, post := range posts {
+		port, channel, creator, ok := ParseCreator(post.Creator)
+		if !ok {
+			// local post, nothing to split
+			continue
+		}
+		post.Creator = creator
+		post.OriginPort = port
+		post.OriginChannel = channel
+		post.OriginChainID = chainID(ctx, port, channel)
+
+		bz, err := cdc.Marshal(&post)
+		if err != nil {
+			return err
+		}
+		store.Set(sdk.Uint64ToBigEndian(post.Id), bz)
+	}
+
+	return nil
+}
+
+// ParseCreator splits a v1 "port-channel-creator" creator string. Channel
+// identifiers have the "channel-{N}" form and addresses contain no dash, which
+// leaves the remainder to the port.
+func ParseCreator(s string) (port, channel, creator string, ok bool) {
+	parts := strings.Split(s, "-")
+	if len(parts) < 4 {
+		return "", "", "", false
+	}
+	n := len(parts)
+	channel = parts[n-3] + "-" + parts[n-2]
+	if !channeltypes.IsValidChannelID(channel) {
+		return "", "", "", false
+	}
+	port = strings.Join(parts[:n-3], "-")
+	creator = parts[n-1]
+	if port == "" || creator == "" {
+		return "", "", "", false
+	}
+	return port, channel, creator, true
+}
@@ -0,0 +1,63 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/testutil/sample"
+	"planet/x/blog/keeper"
+	v2 "planet/x/blog/migrations/v2"
+	"planet/x/blog/types"
+)
+
+func TestParseCreator(t *testing.T) {
+	creator := sample.AccAddress()
+	for _, tc := range []struct {
+		desc    string
+		s       string
+		port    string
+		channel string
+		ok      bool
+	}{
+		{desc: "valid", s: "blog-channel-0-" + creator, port: "blog", channel: "channel-0", ok: true},
+		{desc: "port with dash", s: "my-blog-channel-12-" + creator, port: "my-blog", channel: "channel-12", ok: true},
+		{desc: "local address", s: creator},
+		{desc: "no channel", s: "blog-chan-0-" + creator},
+		{desc: "no port", s: "-channel-0-" + creator},
+		{desc: "no creator", s: "blog-channel-0-"},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			port, channel, got, ok := v2.ParseCreator(tc.s)
+			require.Equal(t, tc.ok, ok)
+			if !tc.ok {
+				return
+			}
+			require.Equal(t, tc.port, port)
+			require.Equal(t, tc.channel, channel)
+			require.Equal(t, creator, got)
+		})
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	creator := sample.AccAddress()
+	remote, err := k.AppendPost(ctx, types.Post{Title: "remote", Creator: "blog-channel-3-" + creator})
+	require.NoError(t, err)
+	local, err := k.AppendPost(ctx, types.Post{Title: "local", Creator: creator})
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate1to2(ctx))
+
+	post, err := k.Posts.Get(ctx, remote)
+	require.NoError(t, err)
+	require.Equal(t, creator, post.Creator)
+	require.Equal(t, "blog", post.OriginPort)
+	require.Equal(t, "channel-3", post.OriginChannel)
+
+	post, err = k.Posts.Get(ctx, local)
+	require.NoError(t, err)
+	require.Equal(t, creator, post.Creator)
+	require.Empty(t, post.OriginChannel)
+}
@@ -0,0 +1,64 @@
+package v3
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// destination channel of every sentPost is recovered from its "port-channel"
+// chain and the index by destination channel and remote post ID is rebuilt.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostKey))
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostRemoteKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var sentPosts []types.SentPost
+	for ; iterator.Valid(); iterator.Next() {
+		var sentPost types.SentPost
+		if err := cdc.Unmarshal(iterator.Value(), &sentPost); err != nil {
+			return err
+		}
+		sentPosts = append(sentPosts, sentPost)
+	}
+
+	for _, sentPost := range sentPosts {
+		if sentPost.ChannelID == "" {
+			sentPost.ChannelID = ParseChainChannel(sentPost.Chain)
+		}
+		if sentPost.ChannelID == "" || sentPost.PostID == "" {
+			continue
+		}
+
+		bz, err := cdc.Marshal(&sentPost)
+		if err != nil {
+			return err
+		}
+		id := sdk.Uint64ToBigEndian(sentPost.Id)
+		store.Set(id, bz)
+		indexStore.Set(types.SentPostRemoteIndex(sentPost.ChannelID, sentPost.PostID), id)
+	}
+
+	return nil
+}
+
+// ParseChainChannel returns the channel of a "port-channel" chain, channel
+// identifiers having the "channel-{N}" form. It is empty if there is none.
+func ParseChainChannel(chain string) string {
+	parts := strings.Split(chain, "-")
+	if len(parts) < 3 {
+		return ""
+	}
+	channel := strings.Join(parts[len(parts)-2:], "-")
+	if !channeltypes.IsValidChannelID(channel) {
+		return ""
+	}
+	return channel
+}
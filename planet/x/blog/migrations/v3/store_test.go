@@ -0,0 +1,44 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	v3 "planet/x/blog/migrations/v3"
+	"planet/x/blog/types"
+)
+
+func TestParseChainChannel(t *testing.T) {
+	require.Equal(t, "channel-0", v3.ParseChainChannel("blog-channel-0"))
+	require.Equal(t, "channel-12", v3.ParseChainChannel("my-blog-channel-12"))
+	require.Empty(t, v3.ParseChainChannel("blog"))
+	require.Empty(t, v3.ParseChainChannel("blog-chan-0"))
+}
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	sentPosts := []types.SentPost{
+		{Id: 0, PostID: "3", Chain: "blog-channel-0"},
+		{Id: 1, PostID: "0", Chain: "blog-channel-1"},
+		{Id: 2, Chain: "blog-channel-1"},
+	}
+	// v2 records have no channel ID and therefore no index entry
+	for _, sentPost := range sentPosts {
+		require.NoError(t, k.SentPosts.Set(ctx, sentPost.Id, sentPost))
+	}
+	_, err := k.GetSentPostByRemote(ctx, "channel-0", "3")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate2to3(ctx))
+
+	got, err := k.GetSentPostByRemote(ctx, "channel-0", "3")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), got.Id)
+	require.Equal(t, "channel-0", got.ChannelID)
+	got, err = k.GetSentPostByRemote(ctx, "channel-1", "0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), got.Id)
+}
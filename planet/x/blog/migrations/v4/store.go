@@ -0,0 +1,29 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/exported"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. The params
+// are moved out of the legacy x/params subspace into the module store. Params
+// missing from the subspace take their default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	params := types.DefaultParams()
+	legacySubspace.GetParamSetIfExists(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
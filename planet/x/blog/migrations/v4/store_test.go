@@ -0,0 +1,33 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/exported"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+// mockSubspace holds the params of a legacy x/params subspace
+type mockSubspace struct {
+	ps types.Params
+}
+
+func (ms mockSubspace) GetParamSetIfExists(ctx sdk.Context, ps exported.ParamSet) {
+	*ps.(*types.Params) = ms.ps
+}
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	legacyParams := types.NewParams(64, 128, []string{"channel-0"}, time.Hour, false, types.DefaultMaxBatchPosts, types.DefaultUploadTimeout, types.DefaultMaxInboundPacketsPerBlock, "", types.DefaultSnapshotPageSize)
+
+	require.NoError(t, keeper.NewMigrator(*k, mockSubspace{ps: legacyParams}).Migrate3to4(ctx))
+	require.Equal(t, legacyParams, k.GetParams(ctx))
+
+	require.Error(t, keeper.NewMigrator(*k, mockSubspace{}).Migrate3to4(ctx))
+	require.Equal(t, legacyParams, k.GetParams(ctx))
+}
@@ -0,0 +1,81 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The indexes of
+// posts by creator and by source channel and of sentPosts by destination chain
+// are built from the stored records.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	posts, err := collectPosts(store, cdc)
+	if err != nil {
+		return err
+	}
+	sentPosts, err := collectSentPosts(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	creatorStore := prefix.NewStore(store, types.KeyPrefix(types.PostCreatorKey))
+	channelStore := prefix.NewStore(store, types.KeyPrefix(types.PostChannelKey))
+	for _, post := range posts {
+		id := sdk.Uint64ToBigEndian(post.Id)
+		if post.Creator != "" {
+			creatorStore.Set(types.PostCreatorIndex(post.Creator, post.Id), id)
+		}
+		if post.OriginChannel != "" {
+			channelStore.Set(types.PostChannelIndex(post.OriginChannel, post.Id), id)
+		}
+	}
+
+	chainStore := prefix.NewStore(store, types.KeyPrefix(types.SentPostChainKey))
+	for _, sentPost := range sentPosts {
+		if sentPost.Chain != "" {
+			chainStore.Set(types.SentPostChainIndex(sentPost.Chain, sentPost.Id), sdk.Uint64ToBigEndian(sentPost.Id))
+		}
+	}
+
+	return nil
+}
+
+// collectPosts reads all the stored posts. Records are collected before any
+// index is written because the store must not be written while iterated.
+func collectPosts(store sdk.KVStore, cdc codec.BinaryCodec) ([]types.Post, error) {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(store, types.KeyPrefix(types.PostKey)), []byte{})
+	defer iterator.Close()
+
+	var posts []types.Post
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// collectSentPosts reads all the stored sentPosts
+func collectSentPosts(store sdk.KVStore, cdc codec.BinaryCodec) ([]types.SentPost, error) {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(store, types.KeyPrefix(types.SentPostKey)), []byte{})
+	defer iterator.Close()
+
+	var sentPosts []types.SentPost
+	for ; iterator.Valid(); iterator.Next() {
+		var sentPost types.SentPost
+		if err := cdc.Unmarshal(iterator.Value(), &sentPost); err != nil {
+			return nil, err
+		}
+		sentPosts = append(sentPosts, sentPost)
+	}
+
+	return sentPosts, nil
+}
@@ -0,0 +1,57 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	_, err := k.AppendPost(ctx, types.Post{Title: "local", Creator: "alice"})
+	require.NoError(t, err)
+	_, err = k.AppendPost(ctx, types.Post{Title: "received", Creator: "blog-channel-0-bob", OriginChannel: "channel-0"})
+	require.NoError(t, err)
+	_, err = k.AppendSentPost(ctx, types.SentPost{Title: "sent", Chain: "blog-channel-0"})
+	require.NoError(t, err)
+
+	// v4 stores have no secondary indexes
+	for _, key := range []string{types.PostCreatorKey, types.PostChannelKey, types.SentPostChainKey} {
+		store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(key))
+		iterator := store.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		require.NoError(t, iterator.Close())
+		for _, k := range keys {
+			store.Delete(k)
+		}
+	}
+	byCreator, err := k.PostByCreator(wctx, &types.QueryPostByCreatorRequest{Creator: "alice"})
+	require.NoError(t, err)
+	require.Empty(t, byCreator.Post)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate4to5(ctx))
+
+	byCreator, err = k.PostByCreator(wctx, &types.QueryPostByCreatorRequest{Creator: "alice"})
+	require.NoError(t, err)
+	require.Len(t, byCreator.Post, 1)
+	require.Equal(t, "local", byCreator.Post[0].Title)
+
+	byChannel, err := k.PostByChannel(wctx, &types.QueryPostByChannelRequest{ChannelID: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, byChannel.Post, 1)
+	require.Equal(t, "received", byChannel.Post[0].Title)
+
+	byChain, err := k.SentPostByChain(wctx, &types.QuerySentPostByChainRequest{ChainID: "blog-channel-0"})
+	require.NoError(t, err)
+	require.Len(t, byChain.SentPost, 1)
+	require.Equal(t, "sent", byChain.SentPost[0].Title)
+}
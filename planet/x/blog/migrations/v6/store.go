@@ -0,0 +1,33 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The keys of
+// the store are unchanged by the move to collections, but the entries of the
+// indexes of posts by creator and by source channel and of sentPosts by
+// destination chain no longer hold the record ID, which is part of their key.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	for _, key := range []string{types.PostCreatorKey, types.PostChannelKey, types.SentPostChainKey} {
+		indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(key))
+
+		var entries [][]byte
+		iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+		for ; iterator.Valid(); iterator.Next() {
+			entries = append(entries, iterator.Key())
+		}
+		if err := iterator.Close(); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			indexStore.Set(entry, []byte{})
+		}
+	}
+
+	return nil
+}
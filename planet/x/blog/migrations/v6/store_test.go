@@ -0,0 +1,44 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	id, err := k.AppendPost(ctx, types.Post{Title: "received", Creator: "alice", OriginChannel: "channel-1", ChannelID: "channel-0", OriginChainID: "earth"})
+	require.NoError(t, err)
+	sentID, err := k.AppendSentPost(ctx, types.SentPost{Title: "sent", Chain: "blog-channel-1", ChainID: "earth"})
+	require.NoError(t, err)
+
+	// v5 index entries hold the record ID
+	store := ctx.KVStore(storeKey)
+	prefix.NewStore(store, types.KeyPrefix(types.PostCreatorKey)).Set(types.PostCreatorIndex("alice", id), sdk.Uint64ToBigEndian(id))
+	prefix.NewStore(store, types.KeyPrefix(types.PostChannelKey)).Set(types.PostChannelIndex("channel-0", id), sdk.Uint64ToBigEndian(id))
+	prefix.NewStore(store, types.KeyPrefix(types.SentPostChainKey)).Set(types.SentPostChainIndex("earth", sentID), sdk.Uint64ToBigEndian(sentID))
+	_, broken := keeper.IndexInvariant(*k)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate5to6(ctx))
+
+	msg, broken := keeper.IndexInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+
+	byCreator, err := k.PostByCreator(wctx, &types.QueryPostByCreatorRequest{Creator: "alice"})
+	require.NoError(t, err)
+	require.Len(t, byCreator.Post, 1)
+	require.Equal(t, "received", byCreator.Post[0].Title)
+
+	// Updating a migrated record moves its index entries
+	require.NoError(t, k.Posts.Set(ctx, id, types.Post{Id: id, Title: "received", Creator: "bob"}))
+	msg, broken = keeper.IndexInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,34 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+
+	// v6 params have no maxBatchPosts
+	params := types.DefaultParams()
+	params.MaxTitleLength = 64
+	params.MaxBatchPosts = 0
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate6to7(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxBatchPosts, migrated.MaxBatchPosts)
+	require.Equal(t, uint64(64), migrated.MaxTitleLength)
+
+	// A limit set before the migration is kept
+	migrated.MaxBatchPosts = 5
+	require.NoError(t, k.SetParams(ctx, migrated))
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate6to7(ctx))
+	require.Equal(t, uint64(5), k.GetParams(ctx).MaxBatchPosts)
+}
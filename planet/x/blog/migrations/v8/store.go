@@ -0,0 +1,49 @@
+package v8
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"planet/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8. The params
+// stored before chunked posts have no uploadTimeout, which takes its default
+// value. As in v7, the fields of later migrations take their default value
+// when the params are validated.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	} else {
+		params = types.DefaultParams()
+	}
+	if params.UploadTimeout == 0 {
+		params.UploadTimeout = types.DefaultUploadTimeout
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	if err := validate(bz); err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// validate decodes the params over the default params, so that the fields
+// missing from the encoding are set, and validates them
+func validate(bz []byte) error {
+	params := types.DefaultParams()
+	if err := params.Unmarshal(bz); err != nil {
+		return err
+	}
+	return params.Validate()
+}
@@ -0,0 +1,51 @@
+package v8_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+
+	// v7 params have no uploadTimeout
+	params := types.DefaultParams()
+	params.MaxBatchPosts = 5
+	params.UploadTimeout = 0
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate7to8(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.DefaultUploadTimeout, migrated.UploadTimeout)
+	require.Equal(t, uint64(5), migrated.MaxBatchPosts)
+
+	// A timeout set before the migration is kept
+	migrated.UploadTimeout = time.Minute
+	require.NoError(t, k.SetParams(ctx, migrated))
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate7to8(ctx))
+	require.Equal(t, time.Minute, k.GetParams(ctx).UploadTimeout)
+}
+
+func TestMigrateStoreInvalidParams(t *testing.T) {
+	k, ctx, storeKey := keepertest.BlogKeeperWithStoreKey(t)
+
+	// v7 leaves the params to be validated by v8
+	params := types.DefaultParams()
+	params.AllowedChannels = []string{"channel-0", "channel-0"}
+	params.MaxBatchPosts = 0
+	params.UploadTimeout = 0
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate6to7(ctx))
+	require.Error(t, keeper.NewMigrator(*k, nil).Migrate7to8(ctx))
+}
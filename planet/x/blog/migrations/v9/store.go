@@ -0,0 +1,68 @@
+package v9
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v3 "planet/x/blog/migrations/v3"
+	"planet/x/blog/types"
+)
+
+// ChannelResolver returns the local channel connected to the given counterparty
+// port and channel. It is empty when no single owned channel matches.
+type ChannelResolver func(ctx sdk.Context, port, channel string) string
+
+// MigrateStore performs in-place store migrations from v8 to v9. The channel of
+// every sentPost was the destination channel on the counterparty chain, which
+// two counterparties may share. It is replaced by the local channel resolved
+// from the "port-channel" chain, and the index by channel and remote post ID is
+// rebuilt. SentPosts whose local channel can't be resolved lose their channel.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, localChannel ChannelResolver) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostKey))
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SentPostRemoteKey))
+
+	var sentPosts []types.SentPost
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var sentPost types.SentPost
+		if err := cdc.Unmarshal(iterator.Value(), &sentPost); err != nil {
+			iterator.Close()
+			return err
+		}
+		sentPosts = append(sentPosts, sentPost)
+	}
+	iterator.Close()
+
+	var indexKeys [][]byte
+	iterator = sdk.KVStorePrefixIterator(indexStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		indexKeys = append(indexKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range indexKeys {
+		indexStore.Delete(key)
+	}
+
+	for _, sentPost := range sentPosts {
+		sentPost.ChannelID = ""
+		if channel := v3.ParseChainChannel(sentPost.Chain); channel != "" {
+			port := strings.TrimSuffix(sentPost.Chain, "-"+channel)
+			sentPost.ChannelID = localChannel(ctx, port, channel)
+		}
+
+		bz, err := cdc.Marshal(&sentPost)
+		if err != nil {
+			return err
+		}
+		id := sdk.Uint64ToBigEndian(sentPost.Id)
+		store.Set(id, bz)
+		if sentPost.ChannelID != "" && sentPost.PostID != "" {
+			indexStore.Set(types.SentPostRemoteIndex(sentPost.ChannelID, sentPost.PostID), id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v9_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.BlogKeeper(t)
+	// v8 records reference the channel on the counterparty chain
+	sentPosts := []types.SentPost{
+		{Id: 0, PostID: "3", Chain: "blog-" + keepertest.TestCounterpartyChannelID, ChannelID: keepertest.TestCounterpartyChannelID},
+		{Id: 1, PostID: "4", Chain: "blog-channel-5", ChannelID: "channel-5"},
+		{Id: 2, Chain: "blog-" + keepertest.TestCounterpartyChannelID, ChannelID: keepertest.TestCounterpartyChannelID},
+	}
+	for _, sentPost := range sentPosts {
+		require.NoError(t, k.SentPosts.Set(ctx, sentPost.Id, sentPost))
+	}
+
+	require.NoError(t, keeper.NewMigrator(*k, nil).Migrate8to9(ctx))
+
+	got, err := k.GetSentPostByRemote(ctx, keepertest.TestChannelID, "3")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), got.Id)
+	require.Equal(t, keepertest.TestChannelID, got.ChannelID)
+	_, err = k.GetSentPostByRemote(ctx, keepertest.TestCounterpartyChannelID, "3")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// No owned channel is connected to channel-5
+	_, err = k.GetSentPostByRemote(ctx, "channel-5", "4")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	got, err = k.SentPosts.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, got.ChannelID)
+
+	got, err = k.SentPosts.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, keepertest.TestChannelID, got.ChannelID)
+}
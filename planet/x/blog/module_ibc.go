@@ -42,8 +42,18 @@ func (im IBCModule) OnChanOpenInit(
 		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	if version != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	// Relayers may leave the version to the module, which proposes the current
+	// one, or the highest allowed by the params. Channels to chains predating
+	// blog-2 must propose blog-1.
+	maxVersion := im.keeper.GetParams(ctx).MaxChannelVersion
+	if version == "" {
+		version = types.Version
+		if !types.IsAllowedVersion(version, maxVersion) {
+			version = maxVersion
+		}
+	}
+	if !types.IsAllowedVersion(version, maxVersion) {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected one of %v up to %s", version, types.SupportedVersions, maxVersion)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -72,8 +82,11 @@ func (im IBCModule) OnChanOpenTry(
 		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	// The channel speaks the version proposed by the counterparty if the params
+	// allow it, blog-1 otherwise
+	version, err := types.NegotiateVersion(counterpartyVersion, im.keeper.GetParams(ctx).MaxChannelVersion)
+	if err != nil {
+		return "", sdkerrors.Wrap(err, "invalid counterparty version")
 	}
 
 	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
@@ -87,7 +100,7 @@ func (im IBCModule) OnChanOpenTry(
 		}
 	}
 
-	return types.Version, nil
+	return version, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -98,8 +111,13 @@ func (im IBCModule) OnChanOpenAck(
 	_,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected one of %v", counterpartyVersion, types.SupportedVersions)
+	}
+	// The counterparty either agrees on the proposed version or negotiates down to blog-1
+	proposed := im.keeper.ChannelVersion(ctx, portID, channelID)
+	if counterpartyVersion != proposed && counterpartyVersion != types.Version1 {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, proposed %s", counterpartyVersion, proposed)
 	}
 	return nil
 }
@@ -142,9 +160,15 @@ func (im IBCModule) OnRecvPacket(
 
 	// this line is used by starport scaffolding # oracle/packet/module/recv
 
-	var modulePacketData types.BlogPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	header, modulePacketData, err := im.keeper.DecodeRecvPacket(ctx, modulePacket)
+	if err != nil {
+		return types.NewPacketAckError(err).Acknowledgement()
+	}
+	// Replicated posts are not counted against the rate limit of the channel
+	if _, ok := modulePacketData.Packet.(*types.BlogPacketData_ReplicatePostPacket); !ok {
+		if err := im.keeper.CountInboundPacket(ctx, modulePacket.DestinationChannel); err != nil {
+			return types.NewPacketAckError(err).Acknowledgement()
+		}
 	}
 
 	// Dispatch packet
@@ -152,7 +176,7 @@ func (im IBCModule) OnRecvPacket(
 	case *types.BlogPacketData_IbcPostPacket:
 		packetAck, err := im.keeper.OnRecvIbcPostPacket(ctx, modulePacket, *packet.IbcPostPacket)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ack = types.NewPacketAckError(err).Acknowledgement()
 		} else {
 			// Encode packet acknowledgment
 			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
@@ -161,17 +185,14 @@ func (im IBCModule) OnRecvPacket(
 			}
 			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
 		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeIbcPostPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeIbcPostPacket, header, err))
 	case *types.BlogPacketData_UpdatePostPacket:
 		packetAck, err := im.keeper.OnRecvUpdatePostPacket(ctx, modulePacket, *packet.UpdatePostPacket)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ackErr := types.NewPacketAckError(err)
+			// On conflict the sender learns the current revision of the post
+			ackErr.Revision = packetAck.Revision
+			ack = ackErr.Acknowledgement()
 		} else {
 			// Encode packet acknowledgment
 			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
@@ -180,23 +201,112 @@ func (im IBCModule) OnRecvPacket(
 			}
 			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
 		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeUpdatePostPacket,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
-			),
-		)
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeUpdatePostPacket, header, err))
+	case *types.BlogPacketData_DeletePostPacket:
+		packetAck, err := im.keeper.OnRecvDeletePostPacket(ctx, modulePacket, *packet.DeletePostPacket)
+		if err != nil {
+			ack = types.NewPacketAckError(err).Acknowledgement()
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeDeletePostPacket, header, err))
+	case *types.BlogPacketData_BatchPostPacket:
+		packetAck, err := im.keeper.OnRecvBatchPostPacket(ctx, modulePacket, *packet.BatchPostPacket)
+		if err != nil {
+			ack = types.NewPacketAckError(err).Acknowledgement()
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeBatchPostPacket, header, err))
+	case *types.BlogPacketData_PostChunkPacket:
+		packetAck, err := im.keeper.OnRecvPostChunkPacket(ctx, modulePacket, *packet.PostChunkPacket)
+		if err != nil {
+			ack = types.NewPacketAckError(err).Acknowledgement()
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypePostChunkPacket, header, err))
+	case *types.BlogPacketData_FetchPostPacket:
+		packetAck, err := im.keeper.OnRecvFetchPostPacket(ctx, modulePacket, *packet.FetchPostPacket)
+		if err != nil {
+			ack = types.NewPacketAckError(err).Acknowledgement()
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeFetchPostPacket, header, err))
+	case *types.BlogPacketData_SubscribeReplicationPacket:
+		packetAck, err := im.keeper.OnRecvSubscribeReplicationPacket(ctx, modulePacket, *packet.SubscribeReplicationPacket)
+		if err != nil {
+			ack = types.NewPacketAckError(err).Acknowledgement()
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeSubscribeReplicationPacket, header, err))
+	case *types.BlogPacketData_ReplicatePostPacket:
+		packetAck, err := im.keeper.OnRecvReplicatePostPacket(ctx, modulePacket, *packet.ReplicatePostPacket)
+		if err != nil {
+			ack = types.NewPacketAckError(err).Acknowledgement()
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(newRecvPacketEvent(types.EventTypeReplicatePostPacket, header, err))
 		// this line is used by starport scaffolding # ibc/packet/module/recv
 	default:
-		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
-		return channeltypes.NewErrorAcknowledgement(err)
+		err := sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packet)
+		return types.NewPacketAckError(err).Acknowledgement()
 	}
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
 
+// newRecvPacketEvent returns the event of a received packet, with the memo of
+// its header and the error code of its acknowledgement when the packet failed
+func newRecvPacketEvent(eventType string, header types.PacketHeader, err error) sdk.Event {
+	event := sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+	)
+	if header.Memo != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyPacketMemo, header.Memo))
+	}
+	if err != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAckErrorCode, types.AckErrorCodeOf(err).String()))
+	}
+	return event
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
@@ -211,9 +321,9 @@ func (im IBCModule) OnAcknowledgementPacket(
 
 	// this line is used by starport scaffolding # oracle/packet/module/ack
 
-	var modulePacketData types.BlogPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	_, modulePacketData, err := im.keeper.DecodeSentPacket(ctx, modulePacket)
+	if err != nil {
+		return err
 	}
 
 	var eventType string
@@ -232,6 +342,42 @@ func (im IBCModule) OnAcknowledgementPacket(
 			return err
 		}
 		eventType = types.EventTypeUpdatePostPacket
+	case *types.BlogPacketData_DeletePostPacket:
+		err := im.keeper.OnAcknowledgementDeletePostPacket(ctx, modulePacket, *packet.DeletePostPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeDeletePostPacket
+	case *types.BlogPacketData_BatchPostPacket:
+		err := im.keeper.OnAcknowledgementBatchPostPacket(ctx, modulePacket, *packet.BatchPostPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeBatchPostPacket
+	case *types.BlogPacketData_PostChunkPacket:
+		err := im.keeper.OnAcknowledgementPostChunkPacket(ctx, modulePacket, *packet.PostChunkPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypePostChunkPacket
+	case *types.BlogPacketData_FetchPostPacket:
+		err := im.keeper.OnAcknowledgementFetchPostPacket(ctx, modulePacket, *packet.FetchPostPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeFetchPostPacket
+	case *types.BlogPacketData_SubscribeReplicationPacket:
+		err := im.keeper.OnAcknowledgementSubscribeReplicationPacket(ctx, modulePacket, *packet.SubscribeReplicationPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeSubscribeReplicationPacket
+	case *types.BlogPacketData_ReplicatePostPacket:
+		err := im.keeper.OnAcknowledgementReplicatePostPacket(ctx, modulePacket, *packet.ReplicatePostPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeReplicatePostPacket
 		// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -259,6 +405,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 			sdk.NewEvent(
 				eventType,
 				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+				sdk.NewAttribute(types.AttributeKeyAckErrorCode, types.ParsePacketAckError(resp.Error).Code.String()),
 			),
 		)
 	}
@@ -272,9 +419,9 @@ func (im IBCModule) OnTimeoutPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var modulePacketData types.BlogPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	_, modulePacketData, err := im.keeper.DecodeSentPacket(ctx, modulePacket)
+	if err != nil {
+		return err
 	}
 
 	// Dispatch packet
@@ -289,6 +436,36 @@ func (im IBCModule) OnTimeoutPacket(
 		if err != nil {
 			return err
 		}
+	case *types.BlogPacketData_DeletePostPacket:
+		err := im.keeper.OnTimeoutDeletePostPacket(ctx, modulePacket, *packet.DeletePostPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_BatchPostPacket:
+		err := im.keeper.OnTimeoutBatchPostPacket(ctx, modulePacket, *packet.BatchPostPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_PostChunkPacket:
+		err := im.keeper.OnTimeoutPostChunkPacket(ctx, modulePacket, *packet.PostChunkPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_FetchPostPacket:
+		err := im.keeper.OnTimeoutFetchPostPacket(ctx, modulePacket, *packet.FetchPostPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_SubscribeReplicationPacket:
+		err := im.keeper.OnTimeoutSubscribeReplicationPacket(ctx, modulePacket, *packet.SubscribeReplicationPacket)
+		if err != nil {
+			return err
+		}
+	case *types.BlogPacketData_ReplicatePostPacket:
+		err := im.keeper.OnTimeoutReplicatePostPacket(ctx, modulePacket, *packet.ReplicatePostPacket)
+		if err != nil {
+			return err
+		}
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -0,0 +1,770 @@
+package blog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"planet/testutil/ibctest"
+	keepertest "planet/testutil/keeper"
+	"planet/x/blog"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+// setupBlogPath starts the mars and earth chains and opens a blog channel between them
+func setupBlogPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	coord := ibctest.NewCoordinator(t)
+	path := ibctest.NewBlogPath(coord.GetChain(ibctest.MarsChainID), coord.GetChain(ibctest.EarthChainID))
+	coord.Setup(path)
+	return coord, path
+}
+
+// sendPacket delivers msg on the endpoint chain and returns the packet it sent
+func sendPacket(t *testing.T, endpoint *ibctesting.Endpoint, msg sdk.Msg) channeltypes.Packet {
+	res, err := endpoint.Chain.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, endpoint.Counterparty.UpdateClient())
+	return packet
+}
+
+// timeoutPacket lets the counterparty chain pass the packet timeout and times the packet out
+func timeoutPacket(t *testing.T, coord *ibctesting.Coordinator, endpoint *ibctesting.Endpoint, packet channeltypes.Packet) {
+	coord.IncrementTimeBy(time.Hour)
+	coord.CommitBlock(endpoint.Counterparty.Chain)
+	require.NoError(t, endpoint.UpdateClient())
+	require.NoError(t, endpoint.TimeoutPacket(packet))
+}
+
+func TestIbcPostRelay(t *testing.T) {
+	_, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	packet := sendPacket(t, mars, types.NewMsgSendIbcPost(creator, types.PortID, mars.ChannelID, 0, "title", "content"))
+	require.NoError(t, path.RelayPacket(packet))
+
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+	posts := keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts)
+	require.Len(t, posts, 1)
+	require.Equal(t, "title", posts[0].Title)
+	require.Equal(t, "content", posts[0].Content)
+	require.Equal(t, creator, posts[0].Creator)
+	require.Equal(t, types.PortID, posts[0].OriginPort)
+	require.Equal(t, mars.ChannelID, posts[0].OriginChannel)
+	require.Equal(t, earth.ChannelID, posts[0].ChannelID)
+	require.Equal(t, ibctest.MarsChainID, posts[0].OriginChainID)
+
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	sentPosts := keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts)
+	require.Len(t, sentPosts, 1)
+	require.Equal(t, strconv.FormatUint(posts[0].Id, 10), sentPosts[0].PostID)
+	require.Equal(t, mars.ChannelID, sentPosts[0].ChannelID)
+	require.Equal(t, earth.Chain.ChainID, sentPosts[0].ChainID)
+	require.Empty(t, keepertest.AllTimeoutPosts(t, mars.Chain.GetContext(), marsKeeper.TimeoutPosts))
+	outboundPost, err := marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostDelivered, outboundPost.Status)
+
+	for _, endpoint := range []*ibctesting.Endpoint{mars, earth} {
+		msg, broken := keeper.AllInvariants(ibctest.App(endpoint.Chain).BlogKeeper)(endpoint.Chain.GetContext())
+		require.False(t, broken, msg)
+	}
+}
+
+func TestIbcPostTimeout(t *testing.T) {
+	coord, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	msg := types.NewMsgSendIbcPost(creator, types.PortID, mars.ChannelID, 0, "title", "content")
+	msg.Memo = "hello earth"
+	packet := sendPacket(t, mars, msg)
+	timeoutPacket(t, coord, mars, packet)
+
+	require.Empty(t, keepertest.AllPosts(t, earth.Chain.GetContext(), ibctest.App(earth.Chain).BlogKeeper.Posts))
+
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	require.Empty(t, keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts))
+	timeoutPosts := keepertest.AllTimeoutPosts(t, mars.Chain.GetContext(), marsKeeper.TimeoutPosts)
+	require.Len(t, timeoutPosts, 1)
+	require.Equal(t, "title", timeoutPosts[0].Title)
+	require.Equal(t, creator, timeoutPosts[0].Creator)
+	require.Equal(t, mars.ChannelID, timeoutPosts[0].ChannelID)
+	require.Equal(t, "hello earth", timeoutPosts[0].Memo)
+	outboundPost, err := marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostTimedOut, outboundPost.Status)
+
+	// The retried packet carries the memo again
+	packet = sendPacket(t, mars, &types.MsgRetryTimeoutPost{Creator: creator, Id: timeoutPosts[0].Id})
+	header, _, err := types.DecodePacket(types.Version2, packet.GetData())
+	require.NoError(t, err)
+	require.Equal(t, "hello earth", header.Memo)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Len(t, keepertest.AllPosts(t, earth.Chain.GetContext(), ibctest.App(earth.Chain).BlogKeeper.Posts), 1)
+}
+
+func TestUpdatePostRelay(t *testing.T) {
+	coord, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	packet := sendPacket(t, mars, types.NewMsgSendIbcPost(creator, types.PortID, mars.ChannelID, 0, "title", "content"))
+	require.NoError(t, path.RelayPacket(packet))
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	postID := keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts)[0].PostID
+
+	packet = sendPacket(t, mars, types.NewMsgSendUpdatePost(creator, types.PortID, mars.ChannelID, 0, postID, "updated", "new content", 0))
+	require.NoError(t, path.RelayPacket(packet))
+
+	posts := keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts)
+	require.Len(t, posts, 1)
+	require.Equal(t, "updated", posts[0].Title)
+	require.Equal(t, "new content", posts[0].Content)
+	require.Equal(t, uint64(1), posts[0].Revision)
+	sentPosts := keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts)
+	require.Len(t, sentPosts, 1)
+	require.Equal(t, "updated", sentPosts[0].Title)
+	require.Equal(t, uint64(1), sentPosts[0].Revision)
+
+	// An edit based on the original revision conflicts with the update above
+	packet = sendPacket(t, mars, types.NewMsgSendUpdatePost(creator, types.PortID, mars.ChannelID, 0, postID, "stale", "stale content", 0))
+	require.NoError(t, path.RelayPacket(packet))
+
+	posts = keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts)
+	require.Equal(t, "updated", posts[0].Title)
+	require.Equal(t, "updated", keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts)[0].Title)
+	outboundPost, err := marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostConflict, outboundPost.Status)
+	require.Equal(t, types.AckErrorConflict, outboundPost.ErrorCode)
+	require.Equal(t, uint64(1), outboundPost.Revision)
+
+	// An update that times out is kept for retry with its base revision
+	packet = sendPacket(t, mars, types.NewMsgSendUpdatePost(creator, types.PortID, mars.ChannelID, 0, postID, "late", "late content", 1))
+	timeoutPacket(t, coord, mars, packet)
+
+	posts = keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts)
+	require.Equal(t, "updated", posts[0].Title)
+	timeoutPosts := keepertest.AllTimeoutPosts(t, mars.Chain.GetContext(), marsKeeper.TimeoutPosts)
+	require.Len(t, timeoutPosts, 1)
+	require.Equal(t, "late", timeoutPosts[0].Title)
+	require.Equal(t, postID, timeoutPosts[0].PostID)
+	require.Equal(t, uint64(1), timeoutPosts[0].BaseRevision)
+	outboundPost, err = marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostTimedOut, outboundPost.Status)
+}
+
+func TestPacketErrorAcknowledgement(t *testing.T) {
+	_, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	packet := sendPacket(t, mars, types.NewMsgSendUpdatePost(creator, types.PortID, mars.ChannelID, 0, "7", "title", "content", 0))
+
+	// The receiving chain reports the failure and its code
+	ctx := earth.Chain.GetContext()
+	recvAck := blog.NewIBCModule(ibctest.App(earth.Chain).BlogKeeper).OnRecvPacket(ctx, packet, nil)
+	require.False(t, recvAck.Success())
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventTypeUpdatePostPacket, event.Type)
+	attributes := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	require.Equal(t, "false", attributes[types.AttributeKeyAckSuccess])
+	require.Equal(t, types.AckErrorNotFound.String(), attributes[types.AttributeKeyAckErrorCode])
+
+	// The acknowledgement holds the code, never the error message
+	res, err := earth.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.Equal(t, `{"error":"{\"code\":\"ACK_ERROR_CODE_NOT_FOUND\",\"revision\":\"0\"}"}`, string(ack))
+
+	require.NoError(t, mars.AcknowledgePacket(packet, ack))
+	outboundPost, err := ibctest.App(mars.Chain).BlogKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostFailed, outboundPost.Status)
+	require.Equal(t, types.AckErrorNotFound, outboundPost.ErrorCode)
+}
+
+func TestChannelVersionNegotiation(t *testing.T) {
+	coord := ibctest.NewCoordinator(t)
+	mars, earth := coord.GetChain(ibctest.MarsChainID), coord.GetChain(ibctest.EarthChainID)
+
+	// A chain predating blog-2 proposes blog-1, which the other chain agrees on
+	path := ibctest.NewBlogPathWithVersion(mars, earth, types.Version1)
+	path.EndpointB.ChannelConfig.Version = types.Version2
+	coord.Setup(path)
+	require.Equal(t, types.Version1, path.EndpointA.GetChannel().Version)
+	require.Equal(t, types.Version1, path.EndpointB.GetChannel().Version)
+
+	// blog-1 packets are the bare packet data and have no memo
+	creator := mars.SenderAccount.GetAddress().String()
+	packet := sendPacket(t, path.EndpointA, types.NewMsgSendIbcPost(creator, types.PortID, path.EndpointA.ChannelID, 0, "title", "content"))
+	var data types.BlogPacketData
+	require.NoError(t, data.Unmarshal(packet.GetData()))
+	require.Equal(t, "title", data.GetIbcPostPacket().Title)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Len(t, keepertest.AllPosts(t, earth.GetContext(), ibctest.App(earth).BlogKeeper.Posts), 1)
+
+	msg := types.NewMsgSendIbcPost(creator, types.PortID, path.EndpointA.ChannelID, 0, "title", "content")
+	msg.Memo = "memo"
+	_, err := keeper.NewMsgServerImpl(ibctest.App(mars).BlogKeeper).SendIbcPost(sdk.WrapSDKContext(mars.GetContext()), msg)
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+
+	// Chains speaking blog-2 agree on it
+	path = ibctest.NewBlogPath(mars, earth)
+	coord.Setup(path)
+	require.Equal(t, types.Version2, path.EndpointA.GetChannel().Version)
+	require.Equal(t, types.Version2, path.EndpointB.GetChannel().Version)
+
+	// A chain whose params stop at blog-1 negotiates down a blog-2 proposal,
+	// which the proposing chain accepts
+	earthKeeper := ibctest.App(earth).BlogKeeper
+	params := earthKeeper.GetParams(earth.GetContext())
+	params.MaxChannelVersion = types.Version1
+	require.NoError(t, earthKeeper.SetParams(earth.GetContext(), params))
+	path = ibctest.NewBlogPath(mars, earth)
+	coord.Setup(path)
+	require.Equal(t, types.Version1, path.EndpointA.GetChannel().Version)
+	require.Equal(t, types.Version1, path.EndpointB.GetChannel().Version)
+	packet = sendPacket(t, path.EndpointA, types.NewMsgSendIbcPost(creator, types.PortID, path.EndpointA.ChannelID, 0, "title", "content"))
+	require.NoError(t, path.RelayPacket(packet))
+	require.Len(t, keepertest.AllPosts(t, earth.GetContext(), earthKeeper.Posts), 2)
+
+	// and proposes blog-1 itself
+	path = ibctest.NewBlogPathWithVersion(earth, mars, "")
+	coord.Setup(path)
+	require.Equal(t, types.Version1, path.EndpointA.GetChannel().Version)
+	require.Equal(t, types.Version1, path.EndpointB.GetChannel().Version)
+	module := blog.NewIBCModule(earthKeeper)
+	_, err = module.OnChanOpenInit(earth.GetContext(), channeltypes.UNORDERED, nil, types.PortID, "channel-9", nil, channeltypes.Counterparty{}, types.Version2)
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+
+	// Unknown versions are rejected
+	module = blog.NewIBCModule(ibctest.App(mars).BlogKeeper)
+	_, err = module.OnChanOpenInit(mars.GetContext(), channeltypes.UNORDERED, nil, types.PortID, "channel-9", nil, channeltypes.Counterparty{}, "blog-3")
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+	_, err = module.OnChanOpenTry(mars.GetContext(), channeltypes.UNORDERED, nil, types.PortID, "channel-9", nil, channeltypes.Counterparty{}, "blog-3")
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+	// The counterparty can't negotiate up from blog-1
+	require.ErrorIs(t, module.OnChanOpenAck(mars.GetContext(), types.PortID, "channel-9", "", types.Version2), types.ErrInvalidVersion)
+	require.NoError(t, module.OnChanOpenAck(mars.GetContext(), types.PortID, "channel-9", "", types.Version1))
+}
+
+func TestPacketHeader(t *testing.T) {
+	_, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	msg := types.NewMsgSendIbcPost(creator, types.PortID, mars.ChannelID, 0, "title", "content")
+	msg.Memo = "hello earth"
+	packet := sendPacket(t, mars, msg)
+	header, data, err := types.DecodePacket(types.Version2, packet.GetData())
+	require.NoError(t, err)
+	require.Equal(t, ibctest.MarsChainID, header.ChainID)
+	require.Equal(t, "hello earth", header.Memo)
+	require.Equal(t, uint64(0), header.Revision)
+	require.Equal(t, "title", data.GetIbcPostPacket().Title)
+
+	// The receiving chain emits the memo
+	module := blog.NewIBCModule(ibctest.App(earth.Chain).BlogKeeper)
+	ctx := earth.Chain.GetContext()
+	require.True(t, module.OnRecvPacket(ctx, packet, nil).Success())
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventTypeIbcPostPacket, event.Type)
+	attributes := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	require.Equal(t, "hello earth", attributes[types.AttributeKeyPacketMemo])
+
+	// A header claiming another sending chain is rejected
+	header.ChainID = "venus"
+	packet.Data, err = types.EncodePacket(types.Version2, header, data)
+	require.NoError(t, err)
+	ack, ok := module.OnRecvPacket(earth.Chain.GetContext(), packet, nil).(channeltypes.Acknowledgement)
+	require.True(t, ok)
+	require.False(t, ack.Success())
+	require.Equal(t, types.AckErrorInvalidPayload, types.ParsePacketAckError(ack.GetError()).Code)
+
+	// A counterparty chain ID the indexes by chain can't store is rejected,
+	// on reception and before sending
+	ctx, _ = earth.Chain.GetContext().CacheContext()
+	earthApp := ibctest.App(earth.Chain)
+	clientState := earth.GetClientState().(*ibctm.ClientState)
+	clientState.ChainId = "mars/1"
+	earthApp.IBCKeeper.ClientKeeper.SetClientState(ctx, earth.ClientID, clientState)
+	header.ChainID = clientState.ChainId
+	packet.Data, err = types.EncodePacket(types.Version2, header, data)
+	require.NoError(t, err)
+	ack, ok = module.OnRecvPacket(ctx, packet, nil).(channeltypes.Acknowledgement)
+	require.True(t, ok)
+	require.False(t, ack.Success())
+	require.Equal(t, types.AckErrorInvalidPayload, types.ParsePacketAckError(ack.GetError()).Code)
+	earthCreator := earth.Chain.SenderAccount.GetAddress().String()
+	_, err = keeper.NewMsgServerImpl(earthApp.BlogKeeper).SendIbcPost(sdk.WrapSDKContext(ctx), types.NewMsgSendIbcPost(earthCreator, types.PortID, earth.ChannelID, 0, "title", "content"))
+	require.ErrorIs(t, err, types.ErrInvalidChainID)
+}
+
+func TestJSONChannelRelay(t *testing.T) {
+	coord := ibctest.NewCoordinator(t)
+	path := ibctest.NewBlogPathWithVersion(coord.GetChain(ibctest.MarsChainID), coord.GetChain(ibctest.EarthChainID), types.Version2JSON)
+	coord.Setup(path)
+	mars, earth := path.EndpointA, path.EndpointB
+	require.Equal(t, types.Version2JSON, earth.GetChannel().Version)
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	packet := sendPacket(t, mars, types.NewMsgSendIbcPost(creator, types.PortID, mars.ChannelID, 0, "title", "content"))
+	require.True(t, json.Valid(packet.GetData()))
+	require.NoError(t, path.RelayPacket(packet))
+
+	posts := keepertest.AllPosts(t, earth.Chain.GetContext(), ibctest.App(earth.Chain).BlogKeeper.Posts)
+	require.Len(t, posts, 1)
+	require.Equal(t, "title", posts[0].Title)
+	require.Equal(t, ibctest.MarsChainID, posts[0].OriginChainID)
+	sentPosts := keepertest.AllSentPosts(t, mars.Chain.GetContext(), ibctest.App(mars.Chain).BlogKeeper.SentPosts)
+	require.Len(t, sentPosts, 1)
+	require.Equal(t, strconv.FormatUint(posts[0].Id, 10), sentPosts[0].PostID)
+}
+
+func TestBroadcastPostRelay(t *testing.T) {
+	coord := ibctest.NewCoordinator(t)
+	paths := []*ibctesting.Path{
+		ibctest.NewBlogPath(coord.GetChain(ibctest.MarsChainID), coord.GetChain(ibctest.EarthChainID)),
+		ibctest.NewBlogPath(coord.GetChain(ibctest.MarsChainID), coord.GetChain(ibctest.EarthChainID)),
+	}
+	for _, path := range paths {
+		coord.Setup(path)
+	}
+	mars := paths[0].EndpointA
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	res, err := mars.Chain.SendMsgs(types.NewMsgBroadcastPost(creator, nil, true, 0, "title", "content"))
+	require.NoError(t, err)
+	var packets []channeltypes.Packet
+	for _, event := range res.GetEvents() {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := ibctesting.ParsePacketFromEvents(sdk.Events{event})
+		require.NoError(t, err)
+		packets = append(packets, packet)
+	}
+	require.Len(t, packets, 2)
+	for i, path := range paths {
+		require.Equal(t, path.EndpointA.ChannelID, packets[i].SourceChannel)
+		require.NoError(t, path.EndpointB.UpdateClient())
+	}
+
+	// Only the packet of the first channel is delivered yet
+	require.NoError(t, paths[0].RelayPacket(packets[0]))
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	broadcast, err := marsKeeper.Broadcast(sdk.WrapSDKContext(mars.Chain.GetContext()), &types.QueryGetBroadcastRequest{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, "title", broadcast.Broadcast.Title)
+	require.Len(t, broadcast.OutboundPost, 2)
+	require.Equal(t, types.OutboundPostDelivered, broadcast.OutboundPost[0].Status)
+	require.Equal(t, types.OutboundPostPending, broadcast.OutboundPost[1].Status)
+
+	require.NoError(t, paths[1].RelayPacket(packets[1]))
+	broadcast, err = marsKeeper.Broadcast(sdk.WrapSDKContext(mars.Chain.GetContext()), &types.QueryGetBroadcastRequest{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostDelivered, broadcast.OutboundPost[1].Status)
+
+	earth := paths[0].EndpointB
+	posts := keepertest.AllPosts(t, earth.Chain.GetContext(), ibctest.App(earth.Chain).BlogKeeper.Posts)
+	require.Len(t, posts, 2)
+	require.Equal(t, paths[0].EndpointA.ChannelID, posts[0].OriginChannel)
+	require.Equal(t, paths[1].EndpointA.ChannelID, posts[1].OriginChannel)
+
+	msg, broken := keeper.AllInvariants(marsKeeper)(mars.Chain.GetContext())
+	require.False(t, broken, msg)
+}
+
+func TestBatchPostRelay(t *testing.T) {
+	coord, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	// Earth accepts shorter titles than mars, and a batch counts as one
+	// packet against its rate limit
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+	params := earthKeeper.GetParams(earth.Chain.GetContext())
+	params.MaxTitleLength = 8
+	params.MaxInboundPacketsPerBlock = 1
+	require.NoError(t, earthKeeper.SetParams(earth.Chain.GetContext(), params))
+	coord.CommitBlock(earth.Chain)
+	require.NoError(t, mars.UpdateClient())
+
+	posts := []types.BatchPost{{Title: "first"}, {Title: "too long title"}, {Title: "third"}}
+	packet := sendPacket(t, mars, types.NewMsgSendBatchPost(creator, types.PortID, mars.ChannelID, 0, posts, false))
+	require.NoError(t, path.RelayPacket(packet))
+
+	received := keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts)
+	require.Len(t, received, 2)
+	require.Equal(t, "first", received[0].Title)
+	require.Equal(t, "third", received[1].Title)
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	outboundPost, err := marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostPartial, outboundPost.Status)
+	require.Equal(t, "0,1", outboundPost.PostID)
+	require.Equal(t, types.AckErrorInvalidPayload, outboundPost.ErrorCode)
+	require.Len(t, keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts), 2)
+
+	// An atomic batch with an invalid post stores none
+	packet = sendPacket(t, mars, types.NewMsgSendBatchPost(creator, types.PortID, mars.ChannelID, 0, posts, true))
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.Len(t, keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts), 2)
+	outboundPost, err = marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packet.Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostFailed, outboundPost.Status)
+	require.Empty(t, outboundPost.PostID)
+	require.Len(t, keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts), 2)
+
+	for _, endpoint := range []*ibctesting.Endpoint{mars, earth} {
+		msg, broken := keeper.AllInvariants(ibctest.App(endpoint.Chain).BlogKeeper)(endpoint.Chain.GetContext())
+		require.False(t, broken, msg)
+	}
+}
+
+func TestChunkedPostRelay(t *testing.T) {
+	coord, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	res, err := mars.Chain.SendMsgs(types.NewMsgSendChunkedPost(creator, types.PortID, mars.ChannelID, 0, "title", "hello chunked world", 8))
+	require.NoError(t, err)
+	var packets []channeltypes.Packet
+	for _, event := range res.GetEvents() {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := ibctesting.ParsePacketFromEvents(sdk.Events{event})
+		require.NoError(t, err)
+		packets = append(packets, packet)
+	}
+	require.Len(t, packets, 3)
+	require.NoError(t, earth.UpdateClient())
+
+	// The post is stored once its last chunk arrives, whatever the order
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+	for _, i := range []int{2, 0} {
+		require.NoError(t, path.RelayPacket(packets[i]))
+	}
+	require.Empty(t, keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts))
+	require.Len(t, keepertest.AllStagedUploads(t, earth.Chain.GetContext(), earthKeeper.StagedUploads), 1)
+
+	require.NoError(t, path.RelayPacket(packets[1]))
+	posts := keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts)
+	require.Len(t, posts, 1)
+	require.Equal(t, "hello chunked world", posts[0].Content)
+	require.Empty(t, keepertest.AllStagedUploads(t, earth.Chain.GetContext(), earthKeeper.StagedUploads))
+
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	outboundPost, err := marsKeeper.OutboundPosts.Get(mars.Chain.GetContext(), collections.Join(mars.ChannelID, packets[1].Sequence))
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundPostDelivered, outboundPost.Status)
+	require.Equal(t, "0", outboundPost.PostID)
+	require.Len(t, keepertest.AllSentPosts(t, mars.Chain.GetContext(), marsKeeper.SentPosts), 1)
+
+	// The chunks of an upload that never completes expire
+	res, err = mars.Chain.SendMsgs(types.NewMsgSendChunkedPost(creator, types.PortID, mars.ChannelID, 0, "title", "hello chunked world", 8))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, earth.UpdateClient())
+	require.NoError(t, path.RelayPacket(packet))
+	require.Len(t, keepertest.AllStagedUploads(t, earth.Chain.GetContext(), earthKeeper.StagedUploads), 1)
+
+	coord.IncrementTimeBy(earthKeeper.GetParams(earth.Chain.GetContext()).UploadTimeout)
+	coord.CommitNBlocks(earth.Chain, 2)
+	require.Empty(t, keepertest.AllStagedUploads(t, earth.Chain.GetContext(), earthKeeper.StagedUploads))
+
+	for _, endpoint := range []*ibctesting.Endpoint{mars, earth} {
+		msg, broken := keeper.AllInvariants(ibctest.App(endpoint.Chain).BlogKeeper)(endpoint.Chain.GetContext())
+		require.False(t, broken, msg)
+	}
+}
+
+func TestFetchPostRelay(t *testing.T) {
+	_, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	creator := mars.Chain.SenderAccount.GetAddress().String()
+
+	// Earth has a local post
+	earthCreator := earth.Chain.SenderAccount.GetAddress().String()
+	_, err := earth.Chain.SendMsgs(types.NewMsgCreatePost(earthCreator, "title", "content"))
+	require.NoError(t, err)
+	require.NoError(t, mars.UpdateClient())
+
+	packet := sendPacket(t, mars, types.NewMsgFetchPost(creator, types.PortID, mars.ChannelID, 0, "0"))
+	require.NoError(t, path.RelayPacket(packet))
+
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	ctx := mars.Chain.GetContext()
+	res, err := marsKeeper.RemotePost(sdk.WrapSDKContext(ctx), &types.QueryGetRemotePostRequest{ChannelID: mars.ChannelID, PostID: "0"})
+	require.NoError(t, err)
+	require.Equal(t, "title", res.RemotePost.Post.Title)
+	require.Equal(t, "content", res.RemotePost.Post.Content)
+	require.Equal(t, earthCreator, res.RemotePost.Post.Creator)
+	require.Positive(t, res.RemotePost.FetchHeight)
+	require.Less(t, res.RemotePost.FetchHeight, ctx.BlockHeight()+1)
+
+	// A post the counterparty doesn't have isn't cached
+	packet = sendPacket(t, mars, types.NewMsgFetchPost(creator, types.PortID, mars.ChannelID, 0, "1"))
+	require.NoError(t, path.RelayPacket(packet))
+	_, err = marsKeeper.RemotePost(sdk.WrapSDKContext(mars.Chain.GetContext()), &types.QueryGetRemotePostRequest{ChannelID: mars.ChannelID, PostID: "1"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Nothing is written on the answering chain
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+	require.Len(t, keepertest.AllPosts(t, earth.Chain.GetContext(), earthKeeper.Posts), 1)
+}
+
+// endBlockPackets rebuilds the replication packets the endpoint chain sent at
+// the end of its last blocks, whose events ibctesting doesn't return. The
+// packets are the last ones sent on the channel, the timeout of each depends on
+// the time of its block, found from the packet commitments.
+func endBlockPackets(t *testing.T, endpoint *ibctesting.Endpoint, data ...types.ReplicatePostPacketData) []channeltypes.Packet {
+	app := ibctest.App(endpoint.Chain)
+	ctx := endpoint.Chain.GetContext()
+	channelKeeper := app.GetIBCKeeper().ChannelKeeper
+	nextSequence, found := channelKeeper.GetNextSequenceSend(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(t, found)
+	timeout := app.BlogKeeper.GetParams(ctx).DefaultPacketTimeout
+
+	packets := make([]channeltypes.Packet, len(data))
+	for i, d := range data {
+		d := d
+		bz, err := types.EncodePacket(endpoint.GetChannel().Version, types.PacketHeader{ChainID: endpoint.Chain.ChainID}, types.BlogPacketData{
+			Packet: &types.BlogPacketData_ReplicatePostPacket{ReplicatePostPacket: &d},
+		})
+		require.NoError(t, err)
+		packets[i] = channeltypes.NewPacket(
+			bz,
+			nextSequence-uint64(len(data)-i),
+			endpoint.ChannelConfig.PortID,
+			endpoint.ChannelID,
+			endpoint.Counterparty.ChannelConfig.PortID,
+			endpoint.Counterparty.ChannelID,
+			clienttypes.ZeroHeight(),
+			0,
+		)
+
+		commitment := channelKeeper.GetPacketCommitment(ctx, packets[i].SourcePort, packets[i].SourceChannel, packets[i].Sequence)
+		sent := false
+		for height := ctx.BlockHeight(); !sent && height > ctx.BlockHeight()-20; height-- {
+			block, found := app.StakingKeeper.GetHistoricalInfo(ctx, height)
+			require.True(t, found)
+			packets[i].TimeoutTimestamp = uint64(block.Header.Time.Add(timeout).UnixNano())
+			sent = bytes.Equal(commitment, channeltypes.CommitPacket(app.AppCodec(), packets[i]))
+		}
+		require.True(t, sent, "replication packet %d not sent in the last blocks", packets[i].Sequence)
+	}
+
+	require.NoError(t, endpoint.Counterparty.UpdateClient())
+	return packets
+}
+
+func TestReplicationRelay(t *testing.T) {
+	_, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	marsCreator := mars.Chain.SenderAccount.GetAddress().String()
+	earthCreator := earth.Chain.SenderAccount.GetAddress().String()
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+
+	// Mars has a post before earth subscribes
+	_, err := mars.Chain.SendMsgs(types.NewMsgCreatePost(marsCreator, "first", "content"))
+	require.NoError(t, err)
+	require.NoError(t, earth.UpdateClient())
+
+	packet := sendPacket(t, earth, types.NewMsgSubscribeReplication(earthCreator, types.PortID, earth.ChannelID, 0))
+	require.NoError(t, path.RelayPacket(packet))
+
+	// The snapshot is sent at the end of the block receiving the subscription
+	first, err := marsKeeper.Posts.Get(mars.Chain.GetContext(), 0)
+	require.NoError(t, err)
+	for _, packet := range endBlockPackets(t, mars,
+		types.ReplicatePostPacketData{Sequence: 1, Op: types.ReplicationOpReset},
+		types.ReplicatePostPacketData{Sequence: 2, Op: types.ReplicationOpSet, Post: first},
+	) {
+		require.NoError(t, path.RelayPacket(packet))
+	}
+	res, err := earthKeeper.ReplicatedPost(sdk.WrapSDKContext(earth.Chain.GetContext()), &types.QueryGetReplicatedPostRequest{ChannelID: earth.ChannelID, PostID: "0"})
+	require.NoError(t, err)
+	require.Equal(t, first, res.ReplicatedPost.Post)
+
+	// Local changes follow in sequence
+	_, err = mars.Chain.SendMsgs(types.NewMsgCreatePost(marsCreator, "second", "content"))
+	require.NoError(t, err)
+	second, err := marsKeeper.Posts.Get(mars.Chain.GetContext(), 1)
+	require.NoError(t, err)
+	for _, packet := range endBlockPackets(t, mars,
+		types.ReplicatePostPacketData{Sequence: 3, Op: types.ReplicationOpSet, Post: second},
+	) {
+		require.NoError(t, path.RelayPacket(packet))
+	}
+	_, err = earthKeeper.ReplicatedPost(sdk.WrapSDKContext(earth.Chain.GetContext()), &types.QueryGetReplicatedPostRequest{ChannelID: earth.ChannelID, PostID: "1"})
+	require.NoError(t, err)
+
+	// A change relayed after a missing one is a gap, which puts the
+	// replication out of sync
+	_, err = mars.Chain.SendMsgs(
+		types.NewMsgDeletePost(marsCreator, 0),
+		types.NewMsgDeletePost(marsCreator, 1),
+	)
+	require.NoError(t, err)
+	packets := endBlockPackets(t, mars,
+		types.ReplicatePostPacketData{Sequence: 4, Op: types.ReplicationOpRemove, Post: types.Post{Id: 0}},
+		types.ReplicatePostPacketData{Sequence: 5, Op: types.ReplicationOpRemove, Post: types.Post{Id: 1}},
+	)
+	require.NoError(t, path.RelayPacket(packets[1]))
+	subscription, err := marsKeeper.ReplicationSubscriptions.Get(mars.Chain.GetContext(), mars.ChannelID)
+	require.NoError(t, err)
+	require.False(t, subscription.Synced)
+	replica, err := earthKeeper.Replicas.Get(earth.Chain.GetContext(), earth.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), replica.NextSequence)
+
+	// Changes aren't sent until the replica re-syncs from a new snapshot
+	_, err = mars.Chain.SendMsgs(types.NewMsgCreatePost(marsCreator, "third", "content"))
+	require.NoError(t, err)
+	require.NoError(t, earth.UpdateClient())
+	packet = sendPacket(t, earth, types.NewMsgResyncReplica(earthCreator, types.PortID, earth.ChannelID, 0))
+	require.NoError(t, path.RelayPacket(packet))
+	third, err := marsKeeper.Posts.Get(mars.Chain.GetContext(), 2)
+	require.NoError(t, err)
+	for _, packet := range endBlockPackets(t, mars,
+		types.ReplicatePostPacketData{Sequence: 6, Op: types.ReplicationOpReset},
+		types.ReplicatePostPacketData{Sequence: 7, Op: types.ReplicationOpSet, Post: third},
+	) {
+		require.NoError(t, path.RelayPacket(packet))
+	}
+
+	// The change missed before the snapshot is skipped
+	require.NoError(t, path.RelayPacket(packets[0]))
+
+	earthCtx := earth.Chain.GetContext()
+	replica, err = earthKeeper.Replicas.Get(earthCtx, earth.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), replica.NextSequence)
+	var replicated []types.Post
+	require.NoError(t, keeper.Walk(earthCtx, earthKeeper.ReplicatedPosts, func(_ collections.Pair[string, string], replicatedPost types.RemotePost) bool {
+		replicated = append(replicated, replicatedPost.Post)
+		return false
+	}))
+	require.Equal(t, []types.Post{third}, replicated)
+	subscription, err = marsKeeper.ReplicationSubscriptions.Get(mars.Chain.GetContext(), mars.ChannelID)
+	require.NoError(t, err)
+	require.True(t, subscription.Synced)
+}
+
+func TestReplicationSnapshotPages(t *testing.T) {
+	coord, path := setupBlogPath(t)
+	mars, earth := path.EndpointA, path.EndpointB
+	marsCreator := mars.Chain.SenderAccount.GetAddress().String()
+	earthCreator := earth.Chain.SenderAccount.GetAddress().String()
+	marsKeeper := ibctest.App(mars.Chain).BlogKeeper
+	earthKeeper := ibctest.App(earth.Chain).BlogKeeper
+
+	// Each block of mars sends a page of one post
+	params := marsKeeper.GetParams(mars.Chain.GetContext())
+	params.SnapshotPageSize = 1
+	require.NoError(t, marsKeeper.SetParams(mars.Chain.GetContext(), params))
+	var msgs []sdk.Msg
+	for i := 0; i < 8; i++ {
+		msgs = append(msgs, types.NewMsgCreatePost(marsCreator, "post "+strconv.Itoa(i), "content"))
+	}
+	_, err := mars.Chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+	require.NoError(t, earth.UpdateClient())
+	posts := keepertest.AllPosts(t, mars.Chain.GetContext(), marsKeeper.Posts)
+
+	packet := sendPacket(t, earth, types.NewMsgSubscribeReplication(earthCreator, types.PortID, earth.ChannelID, 0))
+	require.NoError(t, path.RelayPacket(packet))
+
+	// A re-sync is rejected while the snapshot is sent
+	packet = sendPacket(t, earth, types.NewMsgResyncReplica(earthCreator, types.PortID, earth.ChannelID, 0))
+	res, err := mars.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.Equal(t, types.PacketAckError{Code: types.AckErrorRateLimited}.Acknowledgement().Acknowledgement(), ack)
+
+	// The changes of the posts already sent come before the next page, the
+	// posts from the cursor on are sent in their latest state by the pages
+	subscription, err := marsKeeper.ReplicationSubscriptions.Get(mars.Chain.GetContext(), mars.ChannelID)
+	require.NoError(t, err)
+	require.True(t, subscription.SnapshotPending)
+	require.False(t, subscription.Synced)
+	cursor := subscription.SnapshotCursor
+	require.Less(t, cursor, uint64(len(posts)))
+	_, err = mars.Chain.SendMsgs(
+		types.NewMsgDeletePost(marsCreator, 0),
+		types.NewMsgCreatePost(marsCreator, "created", "content"),
+	)
+	require.NoError(t, err)
+	created, err := marsKeeper.Posts.Get(mars.Chain.GetContext(), uint64(len(posts)))
+	require.NoError(t, err)
+	for subscription.SnapshotPending {
+		coord.CommitBlock(mars.Chain)
+		subscription, err = marsKeeper.ReplicationSubscriptions.Get(mars.Chain.GetContext(), mars.ChannelID)
+		require.NoError(t, err)
+	}
+	require.True(t, subscription.Synced)
+
+	data := []types.ReplicatePostPacketData{{Op: types.ReplicationOpReset}}
+	for _, post := range posts[:cursor] {
+		data = append(data, types.ReplicatePostPacketData{Op: types.ReplicationOpSet, Post: post})
+	}
+	data = append(data, types.ReplicatePostPacketData{Op: types.ReplicationOpRemove, Post: types.Post{Id: 0}})
+	for _, post := range append(posts[cursor:], created) {
+		data = append(data, types.ReplicatePostPacketData{Op: types.ReplicationOpSet, Post: post})
+	}
+	for i := range data {
+		data[i].Sequence = uint64(i + 1)
+	}
+	for _, packet := range endBlockPackets(t, mars, data...) {
+		require.NoError(t, path.RelayPacket(packet))
+	}
+
+	earthCtx := earth.Chain.GetContext()
+	var replicated []types.Post
+	require.NoError(t, keeper.Walk(earthCtx, earthKeeper.ReplicatedPosts, func(_ collections.Pair[string, string], replicatedPost types.RemotePost) bool {
+		replicated = append(replicated, replicatedPost.Post)
+		return false
+	}))
+	require.ElementsMatch(t, append(posts[1:], created), replicated)
+	replica, err := earthKeeper.Replicas.Get(earthCtx, earth.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(data)+1), replica.NextSequence)
+
+	// The replicated posts aren't fetched posts
+	_, err = earthKeeper.RemotePost(sdk.WrapSDKContext(earthCtx), &types.QueryGetRemotePostRequest{ChannelID: earth.ChannelID, PostID: "1"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
@@ -23,25 +23,88 @@ var (
 )
 
 const (
-// this line is used by starport scaffolding # simapp/module/const
+	opWeightMsgCreatePost = "op_weight_msg_create_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreatePost int = 100
+
+	opWeightMsgUpdatePost = "op_weight_msg_update_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdatePost int = 100
+
+	opWeightMsgDeletePost = "op_weight_msg_delete_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeletePost int = 100
+
+	opWeightMsgSendIbcPost = "op_weight_msg_send_ibc_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSendIbcPost int = 100
+
+	opWeightMsgSendBatchPost = "op_weight_msg_send_batch_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSendBatchPost int = 100
+
+	opWeightMsgSendChunkedPost = "op_weight_msg_send_chunked_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSendChunkedPost int = 100
+
+	opWeightMsgFetchPost = "op_weight_msg_fetch_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgFetchPost int = 100
+
+	opWeightMsgSubscribeReplication = "op_weight_msg_subscribe_replication"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubscribeReplication int = 100
+
+	opWeightMsgResyncReplica = "op_weight_msg_resync_replica"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResyncReplica int = 100
+
+	opWeightMsgSendUpdatePost = "op_weight_msg_send_update_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSendUpdatePost int = 100
+
+	opWeightMsgSendDeletePost = "op_weight_msg_send_delete_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSendDeletePost int = 100
+
+	opWeightMsgRetryTimeoutPost = "op_weight_msg_retry_timeout_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRetryTimeoutPost int = 100
+
+	opWeightMsgBroadcastPost = "op_weight_msg_broadcast_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgBroadcastPost int = 100
+
+	opWeightMsgUpdateParams = "op_weight_msg_update_params"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateParams int = 100
+
+	// this line is used by starport scaffolding # simapp/module/const
 )
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
+	posts := blogsimulation.RandomPosts(simState.Rand, simState.Accounts)
+	sentPosts := blogsimulation.RandomSentPosts(simState.Rand, simState.Accounts)
+	timeoutPosts := blogsimulation.RandomTimeoutPosts(simState.Rand, simState.Accounts)
 	blogGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		PortId: types.PortID,
+		Params:           blogsimulation.RandomParams(simState.Rand),
+		PortId:           types.PortID,
+		PostList:         posts,
+		PostCount:        uint64(len(posts)),
+		SentPostList:     sentPosts,
+		SentPostCount:    uint64(len(sentPosts)),
+		TimeoutPostList:  timeoutPosts,
+		TimeoutPostCount: uint64(len(timeoutPosts)),
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&blogGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = blogsimulation.NewDecodeStore(am.cdc)
+}
 
 // ProposalContents doesn't return any content functions for governance proposals.
 func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
@@ -52,6 +115,149 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 
+	var weightMsgCreatePost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCreatePost, &weightMsgCreatePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePost = defaultWeightMsgCreatePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreatePost,
+		blogsimulation.SimulateMsgCreatePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdatePost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdatePost, &weightMsgUpdatePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdatePost = defaultWeightMsgUpdatePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdatePost,
+		blogsimulation.SimulateMsgUpdatePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeletePost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDeletePost, &weightMsgDeletePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeletePost = defaultWeightMsgDeletePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeletePost,
+		blogsimulation.SimulateMsgDeletePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSendIbcPost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSendIbcPost, &weightMsgSendIbcPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendIbcPost = defaultWeightMsgSendIbcPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendIbcPost,
+		blogsimulation.SimulateMsgSendIbcPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSendBatchPost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSendBatchPost, &weightMsgSendBatchPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendBatchPost = defaultWeightMsgSendBatchPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendBatchPost,
+		blogsimulation.SimulateMsgSendBatchPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSendChunkedPost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSendChunkedPost, &weightMsgSendChunkedPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendChunkedPost = defaultWeightMsgSendChunkedPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendChunkedPost,
+		blogsimulation.SimulateMsgSendChunkedPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgFetchPost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgFetchPost, &weightMsgFetchPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgFetchPost = defaultWeightMsgFetchPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFetchPost,
+		blogsimulation.SimulateMsgFetchPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubscribeReplication int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubscribeReplication, &weightMsgSubscribeReplication, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubscribeReplication = defaultWeightMsgSubscribeReplication
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubscribeReplication,
+		blogsimulation.SimulateMsgSubscribeReplication(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgResyncReplica int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResyncReplica, &weightMsgResyncReplica, nil,
+		func(_ *rand.Rand) {
+			weightMsgResyncReplica = defaultWeightMsgResyncReplica
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResyncReplica,
+		blogsimulation.SimulateMsgResyncReplica(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSendUpdatePost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSendUpdatePost, &weightMsgSendUpdatePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendUpdatePost = defaultWeightMsgSendUpdatePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendUpdatePost,
+		blogsimulation.SimulateMsgSendUpdatePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSendDeletePost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSendDeletePost, &weightMsgSendDeletePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendDeletePost = defaultWeightMsgSendDeletePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendDeletePost,
+		blogsimulation.SimulateMsgSendDeletePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRetryTimeoutPost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRetryTimeoutPost, &weightMsgRetryTimeoutPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgRetryTimeoutPost = defaultWeightMsgRetryTimeoutPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRetryTimeoutPost,
+		blogsimulation.SimulateMsgRetryTimeoutPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgBroadcastPost int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgBroadcastPost, &weightMsgBroadcastPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgBroadcastPost = defaultWeightMsgBroadcastPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBroadcastPost,
+		blogsimulation.SimulateMsgBroadcastPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -60,6 +266,11 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
 	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateParams,
+			defaultWeightMsgUpdateParams,
+			blogsimulation.SimulateMsgUpdateParams,
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
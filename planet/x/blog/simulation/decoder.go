@@ -0,0 +1,128 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"planet/x/blog/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding blog type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostKey)):
+			var postA, postB types.Post
+			cdc.MustUnmarshal(kvA.Value, &postA)
+			cdc.MustUnmarshal(kvB.Value, &postB)
+			return fmt.Sprintf("%v\n%v", postA, postB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SentPostKey)):
+			var sentPostA, sentPostB types.SentPost
+			cdc.MustUnmarshal(kvA.Value, &sentPostA)
+			cdc.MustUnmarshal(kvB.Value, &sentPostB)
+			return fmt.Sprintf("%v\n%v", sentPostA, sentPostB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TimeoutPostKey)):
+			var timeoutPostA, timeoutPostB types.TimeoutPost
+			cdc.MustUnmarshal(kvA.Value, &timeoutPostA)
+			cdc.MustUnmarshal(kvB.Value, &timeoutPostB)
+			return fmt.Sprintf("%v\n%v", timeoutPostA, timeoutPostB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.OutboundPostKey)):
+			var outboundPostA, outboundPostB types.OutboundPost
+			cdc.MustUnmarshal(kvA.Value, &outboundPostA)
+			cdc.MustUnmarshal(kvB.Value, &outboundPostB)
+			return fmt.Sprintf("%v\n%v", outboundPostA, outboundPostB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostRevisionKey)):
+			var postRevisionA, postRevisionB types.PostRevision
+			cdc.MustUnmarshal(kvA.Value, &postRevisionA)
+			cdc.MustUnmarshal(kvB.Value, &postRevisionB)
+			return fmt.Sprintf("%v\n%v", postRevisionA, postRevisionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BroadcastKey)):
+			var broadcastA, broadcastB types.Broadcast
+			cdc.MustUnmarshal(kvA.Value, &broadcastA)
+			cdc.MustUnmarshal(kvB.Value, &broadcastB)
+			return fmt.Sprintf("%v\n%v", broadcastA, broadcastB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.StagedUploadKey)):
+			var stagedUploadA, stagedUploadB types.StagedUpload
+			cdc.MustUnmarshal(kvA.Value, &stagedUploadA)
+			cdc.MustUnmarshal(kvB.Value, &stagedUploadB)
+			return fmt.Sprintf("%v\n%v", stagedUploadA, stagedUploadB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.UploadChunkKey)):
+			var uploadChunkA, uploadChunkB types.UploadChunk
+			cdc.MustUnmarshal(kvA.Value, &uploadChunkA)
+			cdc.MustUnmarshal(kvB.Value, &uploadChunkB)
+			return fmt.Sprintf("%v\n%v", uploadChunkA, uploadChunkB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.RemotePostKey)):
+			var remotePostA, remotePostB types.RemotePost
+			cdc.MustUnmarshal(kvA.Value, &remotePostA)
+			cdc.MustUnmarshal(kvB.Value, &remotePostB)
+			return fmt.Sprintf("%v\n%v", remotePostA, remotePostB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ReplicationSubscriptionKey)):
+			var replicationSubscriptionA, replicationSubscriptionB types.ReplicationSubscription
+			cdc.MustUnmarshal(kvA.Value, &replicationSubscriptionA)
+			cdc.MustUnmarshal(kvB.Value, &replicationSubscriptionB)
+			return fmt.Sprintf("%v\n%v", replicationSubscriptionA, replicationSubscriptionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ReplicaKey)):
+			var replicaA, replicaB types.Replica
+			cdc.MustUnmarshal(kvA.Value, &replicaA)
+			cdc.MustUnmarshal(kvB.Value, &replicaB)
+			return fmt.Sprintf("%v\n%v", replicaA, replicaB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.ReplicatedPostKey)):
+			var replicatedPostA, replicatedPostB types.RemotePost
+			cdc.MustUnmarshal(kvA.Value, &replicatedPostA)
+			cdc.MustUnmarshal(kvB.Value, &replicatedPostB)
+			return fmt.Sprintf("%v\n%v", replicatedPostA, replicatedPostB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostChangeKey)):
+			var postChangeA, postChangeB types.PostChange
+			cdc.MustUnmarshal(kvA.Value, &postChangeA)
+			cdc.MustUnmarshal(kvB.Value, &postChangeB)
+			return fmt.Sprintf("%v\n%v", postChangeA, postChangeB)
+
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key, types.PortKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SentPostCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TimeoutPostCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.OutboundPostCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BroadcastCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.StagedUploadCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostChangeCountKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SentPostRemoteKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.StagedUploadRemoteKey)):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostCreatorKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostChannelKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PostChainKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SentPostChainKey)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.StagedUploadExpiryKey)):
+			// the record ID is the end of the index key
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
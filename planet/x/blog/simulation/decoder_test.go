@@ -0,0 +1,68 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+	"planet/app"
+	"planet/x/blog/simulation"
+	"planet/x/blog/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	post := types.Post{Id: 1, Title: "title", Content: "content"}
+	sentPost := types.SentPost{Id: 1, PostID: "2", Title: "title", ChannelID: "channel-0"}
+	timeoutPost := types.TimeoutPost{Id: 1, Title: "title", ChannelID: "channel-0"}
+	broadcast := types.Broadcast{Id: 1, Title: "title", Destinations: []types.BroadcastDestination{{ChannelID: "channel-0", Sequence: 1}}}
+	stagedUpload := types.StagedUpload{Id: 1, ChannelID: "channel-0", UploadID: "4", Title: "title", ChunkCount: 2}
+	creatorKey := append(types.KeyPrefix(types.PostCreatorKey), types.PostCreatorIndex("alice", 3)...)
+	chainKey := append(types.KeyPrefix(types.PostChainKey), types.PostChainIndex("earth", 3)...)
+	count := make([]byte, 8)
+	binary.BigEndian.PutUint64(count, 3)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefix(types.PostKey), 1), Value: cdc.MustMarshal(&post)},
+			{Key: append(types.KeyPrefix(types.SentPostKey), 1), Value: cdc.MustMarshal(&sentPost)},
+			{Key: append(types.KeyPrefix(types.TimeoutPostKey), 1), Value: cdc.MustMarshal(&timeoutPost)},
+			{Key: append(types.KeyPrefix(types.BroadcastKey), 1), Value: cdc.MustMarshal(&broadcast)},
+			{Key: append(types.KeyPrefix(types.StagedUploadKey), 1), Value: cdc.MustMarshal(&stagedUpload)},
+			{Key: types.KeyPrefix(types.PostCountKey), Value: count},
+			{Key: creatorKey, Value: []byte{}},
+			{Key: chainKey, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Post", fmt.Sprintf("%v\n%v", post, post)},
+		{"SentPost", fmt.Sprintf("%v\n%v", sentPost, sentPost)},
+		{"TimeoutPost", fmt.Sprintf("%v\n%v", timeoutPost, timeoutPost)},
+		{"Broadcast", fmt.Sprintf("%v\n%v", broadcast, broadcast)},
+		{"StagedUpload", fmt.Sprintf("%v\n%v", stagedUpload, stagedUpload)},
+		{"PostCount", "3\n3"},
+		{"PostCreator", fmt.Sprintf("%X\n%X", creatorKey, creatorKey)},
+		{"PostChain", fmt.Sprintf("%X\n%X", chainKey, chainKey)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
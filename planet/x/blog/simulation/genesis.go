@@ -0,0 +1,78 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"planet/x/blog/types"
+)
+
+// RandomParams returns random blog params that accept the posts of the simulation operations
+func RandomParams(r *rand.Rand) types.Params {
+	return types.NewParams(
+		uint64(simtypes.RandIntBetween(r, 32, int(types.DefaultMaxTitleLength)+1)),
+		uint64(simtypes.RandIntBetween(r, 256, int(types.DefaultMaxContentLength)+1)),
+		nil,
+		time.Duration(simtypes.RandIntBetween(r, 1, 60))*time.Minute,
+		r.Intn(10) != 0,
+		uint64(simtypes.RandIntBetween(r, 1, int(types.DefaultMaxBatchPosts)+1)),
+		time.Duration(simtypes.RandIntBetween(r, 1, 120))*time.Minute,
+		uint64(simtypes.RandIntBetween(r, 1, int(types.DefaultMaxInboundPacketsPerBlock)+1)),
+		"",
+		uint64(simtypes.RandIntBetween(r, 1, int(types.DefaultSnapshotPageSize)+1)),
+	)
+}
+
+// RandomPosts returns posts written by random simulation accounts
+func RandomPosts(r *rand.Rand, accs []simtypes.Account) []types.Post {
+	posts := make([]types.Post, r.Intn(10))
+	for i := range posts {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		posts[i] = types.Post{
+			Id:      uint64(i),
+			Creator: simAccount.Address.String(),
+			Title:   simtypes.RandStringOfLength(r, 1+r.Intn(32)),
+			Content: simtypes.RandStringOfLength(r, r.Intn(256)),
+		}
+	}
+	return posts
+}
+
+// RandomSentPosts returns posts sent by random simulation accounts to counterparty chains.
+// Simulations run without IBC channels, so sent posts don't reference one.
+func RandomSentPosts(r *rand.Rand, accs []simtypes.Account) []types.SentPost {
+	sentPosts := make([]types.SentPost, r.Intn(10))
+	for i := range sentPosts {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		sentPosts[i] = types.SentPost{
+			Id:      uint64(i),
+			PostID:  strconv.Itoa(i),
+			Title:   simtypes.RandStringOfLength(r, 1+r.Intn(32)),
+			Chain:   fmt.Sprintf("%s-channel-%d", types.PortID, r.Intn(3)),
+			Creator: simAccount.Address.String(),
+		}
+	}
+	return sentPosts
+}
+
+// RandomTimeoutPosts returns posts of random simulation accounts whose packet timed out
+func RandomTimeoutPosts(r *rand.Rand, accs []simtypes.Account) []types.TimeoutPost {
+	timeoutPosts := make([]types.TimeoutPost, r.Intn(10))
+	for i := range timeoutPosts {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		channelID := fmt.Sprintf("channel-%d", r.Intn(3))
+		timeoutPosts[i] = types.TimeoutPost{
+			Id:        uint64(i),
+			Title:     simtypes.RandStringOfLength(r, 1+r.Intn(32)),
+			Chain:     types.PortID + "-" + channelID,
+			Creator:   simAccount.Address.String(),
+			Content:   simtypes.RandStringOfLength(r, r.Intn(256)),
+			Port:      types.PortID,
+			ChannelID: channelID,
+		}
+	}
+	return timeoutPosts
+}
@@ -0,0 +1,341 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func SimulateMsgSendIbcPost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSendIbcPost{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+		msg.Title = simtypes.RandStringOfLength(r, 1+r.Intn(32))
+		msg.Content = simtypes.RandStringOfLength(r, r.Intn(256))
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgSendBatchPost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSendBatchPost{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		if k.ChannelVersion(ctx, channel.PortId, channel.ChannelId) == types.Version1 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "blog-1 channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+		msg.Posts = make([]types.BatchPost, 1+r.Intn(int(k.GetParams(ctx).MaxBatchPosts)))
+		for i := range msg.Posts {
+			msg.Posts[i].Title = simtypes.RandStringOfLength(r, 1+r.Intn(32))
+			msg.Posts[i].Content = simtypes.RandStringOfLength(r, r.Intn(256))
+		}
+		msg.Atomic = r.Intn(2) == 0
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgSendChunkedPost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSendChunkedPost{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		if k.ChannelVersion(ctx, channel.PortId, channel.ChannelId) == types.Version1 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "blog-1 channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+		msg.Title = simtypes.RandStringOfLength(r, 1+r.Intn(32))
+		msg.Content = simtypes.RandStringOfLength(r, r.Intn(256))
+		msg.ChunkSize = uint64(1 + r.Intn(64))
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgFetchPost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgFetchPost{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		if k.ChannelVersion(ctx, channel.PortId, channel.ChannelId) == types.Version1 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "blog-1 channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+		msg.PostID = strconv.Itoa(r.Intn(10))
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgSubscribeReplication(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSubscribeReplication{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		if k.ChannelVersion(ctx, channel.PortId, channel.ChannelId) == types.Version1 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "blog-1 channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgResyncReplica(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgResyncReplica{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		has, err := k.Replicas.Has(ctx, channel.ChannelId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to read replicas"), nil, err
+		}
+		if !has {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no replica on the channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgSendUpdatePost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSendUpdatePost{}
+		simAccount, sentPost, channel, found, err := findSentPost(ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to read sent posts"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "sent post on an open channel not found"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+		msg.PostID = sentPost.PostID
+		msg.Title = simtypes.RandStringOfLength(r, 1+r.Intn(32))
+		msg.Content = simtypes.RandStringOfLength(r, r.Intn(256))
+		msg.BaseRevision = sentPost.Revision
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgSendDeletePost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSendDeletePost{}
+		simAccount, sentPost, channel, found, err := findSentPost(ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to read sent posts"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "sent post on an open channel not found"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+		msg.PostID = sentPost.PostID
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgRetryTimeoutPost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRetryTimeoutPost{}
+		channel, found := randomOpenChannel(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		var simAccount simtypes.Account
+		err := keeper.Walk(ctx, k.TimeoutPosts, func(id uint64, timeoutPost types.TimeoutPost) bool {
+			simAccount, found = FindAccount(accs, timeoutPost.Creator)
+			msg.Id = id
+			return found
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to read timeout posts"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "timeout post creator not found"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Port = channel.PortId
+		msg.ChannelID = channel.ChannelId
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+func SimulateMsgBroadcastPost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgBroadcastPost{}
+		if len(k.GetOpenChannels(ctx)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no open blog channel"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.AllChannels = true
+		msg.Title = simtypes.RandStringOfLength(r, 1+r.Intn(32))
+		msg.Content = simtypes.RandStringOfLength(r, r.Intn(256))
+
+		return deliverIbcPostTx(r, app, ctx, ak, bk, simAccount, msg, msg.Type())
+	}
+}
+
+// deliverIbcPostTx delivers a blog message sending a packet with random fees
+func deliverIbcPostTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomOpenChannel picks an open channel of the blog port.
+// Simulations run a single chain, so there is usually none.
+func randomOpenChannel(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (channeltypes.IdentifiedChannel, bool) {
+	channels := k.GetOpenChannels(ctx)
+	if len(channels) == 0 {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+	return channels[r.Intn(len(channels))], true
+}
+
+// findSentPost finds a post sent by one of the simulation accounts that hasn't been
+// deleted on the counterparty chain, with the open channel it was sent through.
+// Sent posts record the channel ID on the counterparty chain.
+func findSentPost(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (
+	simAccount simtypes.Account,
+	sentPost types.SentPost,
+	channel channeltypes.IdentifiedChannel,
+	found bool,
+	err error,
+) {
+	channels := make(map[string]channeltypes.IdentifiedChannel)
+	for _, channel := range k.GetOpenChannels(ctx) {
+		channels[channel.Counterparty.ChannelId] = channel
+	}
+	err = keeper.Walk(ctx, k.SentPosts, func(_ uint64, value types.SentPost) bool {
+		open := false
+		channel, open = channels[value.ChannelID]
+		if value.Deleted || !open {
+			return false
+		}
+		simAccount, found = FindAccount(accs, value.Creator)
+		sentPost = value
+		return found
+	})
+	return simAccount, sentPost, channel, found, err
+}
@@ -0,0 +1,134 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+func SimulateMsgCreatePost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgCreatePost{
+			Creator: simAccount.Address.String(),
+			Title:   simtypes.RandStringOfLength(r, 1+r.Intn(32)),
+			Content: simtypes.RandStringOfLength(r, r.Intn(256)),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgUpdatePost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUpdatePost{}
+		simAccount, post, found, err := findLocalPost(ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to read posts"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "post creator not found"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Id = post.Id
+		msg.Title = simtypes.RandStringOfLength(r, 1+r.Intn(32))
+		msg.Content = simtypes.RandStringOfLength(r, r.Intn(256))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgDeletePost(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgDeletePost{}
+		simAccount, post, found, err := findLocalPost(ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to read posts"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "post creator not found"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Id = post.Id
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// findLocalPost finds a post written on this chain by one of the simulation accounts.
+// Posts received over IBC have creators from another chain.
+func findLocalPost(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simAccount simtypes.Account, post types.Post, found bool, err error) {
+	err = keeper.Walk(ctx, k.Posts, func(_ uint64, value types.Post) bool {
+		if value.OriginChannel != "" {
+			return false
+		}
+		simAccount, found = FindAccount(accs, value.Creator)
+		post = value
+		return found
+	})
+	return simAccount, post, found, err
+}
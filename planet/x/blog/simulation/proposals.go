@@ -0,0 +1,18 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"planet/x/blog/types"
+)
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams signed by the gov module
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	var authority sdk.AccAddress = address.Module(govtypes.ModuleName)
+
+	return types.NewMsgUpdateParams(authority.String(), RandomParams(r))
+}
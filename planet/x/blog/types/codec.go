@@ -10,6 +10,18 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSendIbcPost{}, "blog/SendIbcPost", nil)
 	cdc.RegisterConcrete(&MsgSendUpdatePost{}, "blog/SendUpdatePost", nil)
+	cdc.RegisterConcrete(&MsgSendDeletePost{}, "blog/SendDeletePost", nil)
+	cdc.RegisterConcrete(&MsgRetryTimeoutPost{}, "blog/RetryTimeoutPost", nil)
+	cdc.RegisterConcrete(&MsgBroadcastPost{}, "blog/BroadcastPost", nil)
+	cdc.RegisterConcrete(&MsgSendBatchPost{}, "blog/SendBatchPost", nil)
+	cdc.RegisterConcrete(&MsgSendChunkedPost{}, "blog/SendChunkedPost", nil)
+	cdc.RegisterConcrete(&MsgFetchPost{}, "blog/FetchPost", nil)
+	cdc.RegisterConcrete(&MsgSubscribeReplication{}, "blog/SubscribeReplication", nil)
+	cdc.RegisterConcrete(&MsgResyncReplica{}, "blog/ResyncReplica", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "blog/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgCreatePost{}, "blog/CreatePost", nil)
+	cdc.RegisterConcrete(&MsgUpdatePost{}, "blog/UpdatePost", nil)
+	cdc.RegisterConcrete(&MsgDeletePost{}, "blog/DeletePost", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -20,6 +32,36 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendUpdatePost{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendDeletePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRetryTimeoutPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBroadcastPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendBatchPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendChunkedPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFetchPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubscribeReplication{},
+		&MsgResyncReplica{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePost{},
+		&MsgUpdatePost{},
+		&MsgDeletePost{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
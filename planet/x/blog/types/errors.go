@@ -9,6 +9,29 @@ import (
 // x/blog module sentinel errors
 var (
 	ErrSample               = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrUnauthorized         = sdkerrors.Register(ModuleName, 1101, "sender is not the post creator")
+	ErrTitleTooLong         = sdkerrors.Register(ModuleName, 1102, "post title too long")
+	ErrContentTooLong       = sdkerrors.Register(ModuleName, 1103, "post content too long")
+	ErrChannelNotAllowed    = sdkerrors.Register(ModuleName, 1104, "channel not allowed")
+	ErrInboundDisabled      = sdkerrors.Register(ModuleName, 1105, "inbound posts are disabled")
+	ErrEmptyTitle           = sdkerrors.Register(ModuleName, 1106, "post title is empty")
+	ErrInvalidUTF8          = sdkerrors.Register(ModuleName, 1107, "invalid UTF-8")
+	ErrControlCharacter     = sdkerrors.Register(ModuleName, 1108, "control character not allowed")
+	ErrInvalidCreator       = sdkerrors.Register(ModuleName, 1109, "invalid creator address")
+	ErrInvalidPostID        = sdkerrors.Register(ModuleName, 1110, "invalid post ID")
+	ErrPostNotFound         = sdkerrors.Register(ModuleName, 1111, "post not found")
+	ErrRevisionConflict     = sdkerrors.Register(ModuleName, 1112, "edit based on a stale revision")
+	ErrRateLimited          = sdkerrors.Register(ModuleName, 1113, "rate limit exceeded")
+	ErrInvalidPacketHeader  = sdkerrors.Register(ModuleName, 1114, "invalid packet header")
+	ErrMemoTooLong          = sdkerrors.Register(ModuleName, 1115, "packet memo too long")
+	ErrBatchTooLarge        = sdkerrors.Register(ModuleName, 1116, "too many posts in batch")
+	ErrEmptyBatch           = sdkerrors.Register(ModuleName, 1117, "batch has no post")
+	ErrInvalidChunk         = sdkerrors.Register(ModuleName, 1118, "invalid post chunk")
+	ErrContentHashMismatch  = sdkerrors.Register(ModuleName, 1119, "content hash mismatch")
+	ErrSequenceGap          = sdkerrors.Register(ModuleName, 1120, "replication sequence gap")
+	ErrReplicationNotFound  = sdkerrors.Register(ModuleName, 1121, "replication not subscribed")
+	ErrInvalidReplication   = sdkerrors.Register(ModuleName, 1122, "invalid replicated change")
+	ErrInvalidChainID       = sdkerrors.Register(ModuleName, 1123, "invalid chain ID")
 	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1501, "invalid version")
 )
@@ -5,9 +5,33 @@ const (
 	EventTypeTimeout          = "timeout"
 	EventTypeIbcPostPacket    = "ibcPost_packet"
 	EventTypeUpdatePostPacket = "updatePost_packet"
+	EventTypeDeletePostPacket = "deletePost_packet"
+	EventTypeBatchPostPacket  = "batchPost_packet"
+	EventTypePostChunkPacket  = "postChunk_packet"
+	EventTypeFetchPostPacket  = "fetchPost_packet"
+
+	EventTypeSubscribeReplicationPacket = "subscribeReplication_packet"
+	EventTypeReplicatePostPacket        = "replicatePost_packet"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
+	// AttributeKeyAckErrorCode is the AckErrorCode of an error acknowledgement
+	AttributeKeyAckErrorCode = "error_code"
+	// AttributeKeyPacketMemo is the memo of the header of a received blog-2 packet
+	AttributeKeyPacketMemo = "memo"
+
+	// EventTypeUploadExpired is emitted when the chunks of an incomplete upload are dropped
+	EventTypeUploadExpired = "upload_expired"
+	AttributeKeyChannel    = "channel"
+	AttributeKeyUploadID   = "upload_id"
+	// EventTypeUploadFailed is emitted on the sending chain when a chunk of an
+	// upload fails or times out, so that the post is never stored
+	EventTypeUploadFailed = "upload_failed"
+
+	// EventTypeReplicationOutOfSync is emitted when the replica of a channel
+	// misses a change, until it re-syncs
+	EventTypeReplicationOutOfSync = "replication_out_of_sync"
+	AttributeKeySequence          = "sequence"
 )
@@ -11,10 +11,19 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		PortId:          PortID,
-		PostList:        []Post{},
-		SentPostList:    []SentPost{},
-		TimeoutPostList: []TimeoutPost{},
+		PortId:                      PortID,
+		PostList:                    []Post{},
+		SentPostList:                []SentPost{},
+		TimeoutPostList:             []TimeoutPost{},
+		OutboundPostList:            []OutboundPost{},
+		PostRevisionList:            []PostRevision{},
+		BroadcastList:               []Broadcast{},
+		StagedUploadList:            []StagedUpload{},
+		UploadChunkList:             []UploadChunk{},
+		RemotePostList:              []RemotePost{},
+		ReplicationSubscriptionList: []ReplicationSubscription{},
+		ReplicaList:                 []Replica{},
+		ReplicatedPostList:          []RemotePost{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -62,6 +71,119 @@ func (gs GenesisState) Validate() error {
 		}
 		timeoutPostIdMap[elem.Id] = true
 	}
+	// Check for duplicated ID and index in outboundPost
+	outboundPostIdMap := make(map[uint64]bool)
+	outboundPostIndexMap := make(map[string]bool)
+	outboundPostCount := gs.GetOutboundPostCount()
+	for _, elem := range gs.OutboundPostList {
+		if _, ok := outboundPostIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for outboundPost")
+		}
+		if elem.Id >= outboundPostCount {
+			return fmt.Errorf("outboundPost id should be lower or equal than the last id")
+		}
+		index := string(OutboundPostIndex(elem.ChannelID, elem.Sequence))
+		if _, ok := outboundPostIndexMap[index]; ok {
+			return fmt.Errorf("duplicated channel and sequence for outboundPost")
+		}
+		outboundPostIdMap[elem.Id] = true
+		outboundPostIndexMap[index] = true
+	}
+	// Check for duplicated index in postRevision and that its post exists
+	postRevisionIndexMap := make(map[string]bool)
+	for _, elem := range gs.PostRevisionList {
+		index := string(PostRevisionIndex(elem.PostID, elem.Revision))
+		if _, ok := postRevisionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated post ID and revision for postRevision")
+		}
+		if !postIdMap[elem.PostID] {
+			return fmt.Errorf("postRevision references post %d which doesn't exist", elem.PostID)
+		}
+		postRevisionIndexMap[index] = true
+	}
+	// Check for duplicated ID in broadcast
+	broadcastIdMap := make(map[uint64]bool)
+	broadcastCount := gs.GetBroadcastCount()
+	for _, elem := range gs.BroadcastList {
+		if _, ok := broadcastIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for broadcast")
+		}
+		if elem.Id >= broadcastCount {
+			return fmt.Errorf("broadcast id should be lower or equal than the last id")
+		}
+		broadcastIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID and upload in stagedUpload
+	stagedUploads := make(map[uint64]StagedUpload)
+	stagedUploadRemoteMap := make(map[string]bool)
+	stagedUploadCount := gs.GetStagedUploadCount()
+	for _, elem := range gs.StagedUploadList {
+		if _, ok := stagedUploads[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for stagedUpload")
+		}
+		if elem.Id >= stagedUploadCount {
+			return fmt.Errorf("stagedUpload id should be lower or equal than the last id")
+		}
+		remote := elem.ChannelID + "/" + elem.UploadID
+		if _, ok := stagedUploadRemoteMap[remote]; ok {
+			return fmt.Errorf("duplicated channel and upload ID for stagedUpload")
+		}
+		if elem.ChunkCount == 0 || elem.ChunkCount > MaxUploadChunks {
+			return fmt.Errorf("stagedUpload %d has %d chunks, max %d", elem.Id, elem.ChunkCount, MaxUploadChunks)
+		}
+		stagedUploads[elem.Id] = elem
+		stagedUploadRemoteMap[remote] = true
+	}
+	// Check that every uploadChunk belongs to a stagedUpload, once
+	uploadChunkIndexMap := make(map[string]bool)
+	for _, elem := range gs.UploadChunkList {
+		stagedUpload, ok := stagedUploads[elem.StagedUploadID]
+		if !ok {
+			return fmt.Errorf("uploadChunk of unknown stagedUpload %d", elem.StagedUploadID)
+		}
+		if elem.Index >= stagedUpload.ChunkCount {
+			return fmt.Errorf("uploadChunk %d of stagedUpload %d with %d chunks", elem.Index, elem.StagedUploadID, stagedUpload.ChunkCount)
+		}
+		index := fmt.Sprintf("%d/%d", elem.StagedUploadID, elem.Index)
+		if _, ok := uploadChunkIndexMap[index]; ok {
+			return fmt.Errorf("duplicated staged upload ID and index for uploadChunk")
+		}
+		uploadChunkIndexMap[index] = true
+	}
+	// Check for duplicated index in remotePost
+	remotePostIndexMap := make(map[string]bool)
+	for _, elem := range gs.RemotePostList {
+		index := elem.ChannelID + "/" + elem.PostID
+		if _, ok := remotePostIndexMap[index]; ok {
+			return fmt.Errorf("duplicated channel and post ID for remotePost")
+		}
+		remotePostIndexMap[index] = true
+	}
+	// Check for duplicated index in replicationSubscription
+	replicationSubscriptionIndexMap := make(map[string]bool)
+	for _, elem := range gs.ReplicationSubscriptionList {
+		if _, ok := replicationSubscriptionIndexMap[elem.ChannelID]; ok {
+			return fmt.Errorf("duplicated channel for replicationSubscription")
+		}
+		replicationSubscriptionIndexMap[elem.ChannelID] = true
+	}
+	// Check for duplicated index in replica
+	replicaIndexMap := make(map[string]bool)
+	for _, elem := range gs.ReplicaList {
+		if _, ok := replicaIndexMap[elem.ChannelID]; ok {
+			return fmt.Errorf("duplicated channel for replica")
+		}
+		replicaIndexMap[elem.ChannelID] = true
+	}
+	// Check for duplicated index in replicatedPost
+	replicatedPostIndexMap := make(map[string]bool)
+	for _, elem := range gs.ReplicatedPostList {
+		index := elem.ChannelID + "/" + elem.PostID
+		if _, ok := replicatedPostIndexMap[index]; ok {
+			return fmt.Errorf("duplicated channel and post ID for replicatedPost")
+		}
+		replicatedPostIndexMap[index] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
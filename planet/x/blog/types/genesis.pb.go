@@ -25,14 +25,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the blog module's genesis state.
 type GenesisState struct {
-	Params           Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId           string        `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	PostList         []Post        `protobuf:"bytes,3,rep,name=postList,proto3" json:"postList"`
-	PostCount        uint64        `protobuf:"varint,4,opt,name=postCount,proto3" json:"postCount,omitempty"`
-	SentPostList     []SentPost    `protobuf:"bytes,5,rep,name=sentPostList,proto3" json:"sentPostList"`
-	SentPostCount    uint64        `protobuf:"varint,6,opt,name=sentPostCount,proto3" json:"sentPostCount,omitempty"`
-	TimeoutPostList  []TimeoutPost `protobuf:"bytes,7,rep,name=timeoutPostList,proto3" json:"timeoutPostList"`
-	TimeoutPostCount uint64        `protobuf:"varint,8,opt,name=timeoutPostCount,proto3" json:"timeoutPostCount,omitempty"`
+	Params                      Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                      string                    `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PostList                    []Post                    `protobuf:"bytes,3,rep,name=postList,proto3" json:"postList"`
+	PostCount                   uint64                    `protobuf:"varint,4,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	SentPostList                []SentPost                `protobuf:"bytes,5,rep,name=sentPostList,proto3" json:"sentPostList"`
+	SentPostCount               uint64                    `protobuf:"varint,6,opt,name=sentPostCount,proto3" json:"sentPostCount,omitempty"`
+	TimeoutPostList             []TimeoutPost             `protobuf:"bytes,7,rep,name=timeoutPostList,proto3" json:"timeoutPostList"`
+	TimeoutPostCount            uint64                    `protobuf:"varint,8,opt,name=timeoutPostCount,proto3" json:"timeoutPostCount,omitempty"`
+	OutboundPostList            []OutboundPost            `protobuf:"bytes,9,rep,name=outboundPostList,proto3" json:"outboundPostList"`
+	OutboundPostCount           uint64                    `protobuf:"varint,10,opt,name=outboundPostCount,proto3" json:"outboundPostCount,omitempty"`
+	PostRevisionList            []PostRevision            `protobuf:"bytes,11,rep,name=postRevisionList,proto3" json:"postRevisionList"`
+	BroadcastList               []Broadcast               `protobuf:"bytes,12,rep,name=broadcastList,proto3" json:"broadcastList"`
+	BroadcastCount              uint64                    `protobuf:"varint,13,opt,name=broadcastCount,proto3" json:"broadcastCount,omitempty"`
+	StagedUploadList            []StagedUpload            `protobuf:"bytes,14,rep,name=stagedUploadList,proto3" json:"stagedUploadList"`
+	StagedUploadCount           uint64                    `protobuf:"varint,15,opt,name=stagedUploadCount,proto3" json:"stagedUploadCount,omitempty"`
+	UploadChunkList             []UploadChunk             `protobuf:"bytes,16,rep,name=uploadChunkList,proto3" json:"uploadChunkList"`
+	RemotePostList              []RemotePost              `protobuf:"bytes,17,rep,name=remotePostList,proto3" json:"remotePostList"`
+	ReplicationSubscriptionList []ReplicationSubscription `protobuf:"bytes,18,rep,name=replicationSubscriptionList,proto3" json:"replicationSubscriptionList"`
+	ReplicaList                 []Replica                 `protobuf:"bytes,19,rep,name=replicaList,proto3" json:"replicaList"`
+	ReplicatedPostList          []RemotePost              `protobuf:"bytes,20,rep,name=replicatedPostList,proto3" json:"replicatedPostList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,6 +136,90 @@ func (m *GenesisState) GetTimeoutPostCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetOutboundPostList() []OutboundPost {
+	if m != nil {
+		return m.OutboundPostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutboundPostCount() uint64 {
+	if m != nil {
+		return m.OutboundPostCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPostRevisionList() []PostRevision {
+	if m != nil {
+		return m.PostRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBroadcastList() []Broadcast {
+	if m != nil {
+		return m.BroadcastList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBroadcastCount() uint64 {
+	if m != nil {
+		return m.BroadcastCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStagedUploadList() []StagedUpload {
+	if m != nil {
+		return m.StagedUploadList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStagedUploadCount() uint64 {
+	if m != nil {
+		return m.StagedUploadCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetUploadChunkList() []UploadChunk {
+	if m != nil {
+		return m.UploadChunkList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemotePostList() []RemotePost {
+	if m != nil {
+		return m.RemotePostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReplicationSubscriptionList() []ReplicationSubscription {
+	if m != nil {
+		return m.ReplicationSubscriptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReplicaList() []Replica {
+	if m != nil {
+		return m.ReplicaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReplicatedPostList() []RemotePost {
+	if m != nil {
+		return m.ReplicatedPostList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "planet.blog.GenesisState")
 }
@@ -131,29 +227,46 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/genesis.proto", fileDescriptor_c6c02ff89d106757) }
 
 var fileDescriptor_c6c02ff89d106757 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcd, 0x4e, 0x32, 0x31,
-	0x18, 0x85, 0xa7, 0x1f, 0x7c, 0x03, 0x14, 0x8c, 0x5a, 0xfc, 0xa9, 0x68, 0xea, 0xc4, 0xb8, 0x98,
-	0x98, 0x38, 0x44, 0xb8, 0x00, 0x13, 0x5c, 0xa8, 0x89, 0x0b, 0x02, 0xae, 0xdc, 0x90, 0x21, 0x34,
-	0x93, 0x49, 0x60, 0x3a, 0xa1, 0x25, 0xd1, 0x5b, 0x70, 0xe5, 0x65, 0xb1, 0x64, 0xe9, 0xca, 0x18,
-	0xb8, 0x11, 0xd3, 0x1f, 0xa0, 0xd5, 0x5d, 0x7b, 0xce, 0x79, 0xcf, 0xd3, 0x99, 0x17, 0x9e, 0xe4,
-	0xe3, 0x38, 0xa3, 0xa2, 0x39, 0x1c, 0xb3, 0xa4, 0x99, 0xd0, 0x8c, 0xf2, 0x94, 0x47, 0xf9, 0x94,
-	0x09, 0x86, 0xaa, 0xda, 0x8a, 0xa4, 0xd5, 0x38, 0x48, 0x58, 0xc2, 0x94, 0xde, 0x94, 0x27, 0x1d,
-	0x69, 0x60, 0x7b, 0x3a, 0x8f, 0xa7, 0xf1, 0xc4, 0x0c, 0x37, 0x8e, 0x1c, 0x87, 0x71, 0x61, 0xf4,
-	0x53, 0x5b, 0xe7, 0x34, 0x13, 0x03, 0xcb, 0x24, 0xb6, 0x29, 0xd2, 0x09, 0x65, 0x33, 0xdb, 0xbf,
-	0x78, 0x2f, 0xc0, 0xda, 0xbd, 0x7e, 0x63, 0x5f, 0xc4, 0x82, 0xa2, 0x1b, 0xe8, 0x6b, 0x2a, 0x06,
-	0x01, 0x08, 0xab, 0xad, 0x7a, 0x64, 0xbd, 0x39, 0xea, 0x2a, 0xab, 0x53, 0x9c, 0x7f, 0x9d, 0x7b,
-	0x3d, 0x13, 0x44, 0xc7, 0xb0, 0x94, 0xb3, 0xa9, 0x18, 0xa4, 0x23, 0xfc, 0x2f, 0x00, 0x61, 0xa5,
-	0xe7, 0xcb, 0xeb, 0xe3, 0x08, 0xb5, 0x61, 0x59, 0xa2, 0x9e, 0x52, 0x2e, 0x70, 0x21, 0x28, 0x84,
-	0xd5, 0xd6, 0xbe, 0xdb, 0xc6, 0xb8, 0x30, 0x5d, 0x9b, 0x20, 0x3a, 0x83, 0x15, 0x79, 0xbe, 0x63,
-	0xb3, 0x4c, 0xe0, 0x62, 0x00, 0xc2, 0x62, 0x6f, 0x2b, 0xa0, 0x5b, 0x58, 0x93, 0x9f, 0xd8, 0x5d,
-	0xd7, 0xfe, 0x57, 0xb5, 0x87, 0x4e, 0x6d, 0xdf, 0x04, 0x4c, 0xb5, 0x33, 0x80, 0x2e, 0xe1, 0xce,
-	0xfa, 0xae, 0x11, 0xbe, 0x42, 0xb8, 0x22, 0x7a, 0x80, 0xbb, 0xe6, 0x67, 0x6d, 0x48, 0x25, 0x45,
-	0xc2, 0x0e, 0xe9, 0x79, 0x9b, 0x31, 0xb0, 0xdf, 0x63, 0xe8, 0x0a, 0xee, 0x59, 0x92, 0x46, 0x96,
-	0x15, 0xf2, 0x8f, 0xde, 0xb9, 0x9e, 0x2f, 0x09, 0x58, 0x2c, 0x09, 0xf8, 0x5e, 0x12, 0xf0, 0xb1,
-	0x22, 0xde, 0x62, 0x45, 0xbc, 0xcf, 0x15, 0xf1, 0x5e, 0xea, 0x66, 0x8d, 0xaf, 0x66, 0x91, 0x6f,
-	0x39, 0xe5, 0x43, 0x5f, 0xad, 0xb0, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x7c, 0xf9, 0x97,
-	0x71, 0x02, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc6, 0x9b, 0x77, 0x7d, 0xbb, 0xd5, 0xfd, 0xb3, 0xd6, 0x2d, 0x5b, 0xd6, 0x41, 0x56, 0xa1,
+	0x09, 0x55, 0x08, 0x5a, 0xb1, 0xdd, 0x22, 0x21, 0x75, 0x42, 0x80, 0x00, 0x51, 0xb5, 0xec, 0x86,
+	0x9b, 0x2a, 0x6d, 0xac, 0x12, 0xd1, 0xc6, 0x51, 0xec, 0x20, 0xf8, 0x16, 0x7c, 0x29, 0xa4, 0x5d,
+	0xee, 0x92, 0x2b, 0x84, 0xda, 0x2f, 0x82, 0x7c, 0xec, 0xa4, 0x76, 0x1a, 0x10, 0x77, 0xcd, 0x39,
+	0xcf, 0xf3, 0xfc, 0x5c, 0xfb, 0xd8, 0xe8, 0x24, 0x5c, 0xba, 0x01, 0xe1, 0x83, 0xd9, 0x92, 0x2e,
+	0x06, 0x0b, 0x12, 0x10, 0xe6, 0xb3, 0x7e, 0x18, 0x51, 0x4e, 0x71, 0x45, 0xb6, 0xfa, 0xa2, 0xd5,
+	0x69, 0x2f, 0xe8, 0x82, 0x42, 0x7d, 0x20, 0x7e, 0x49, 0x49, 0xc7, 0xd6, 0xdd, 0xa1, 0x1b, 0xb9,
+	0x2b, 0x65, 0xee, 0x1c, 0x19, 0x1d, 0xca, 0xb8, 0xaa, 0x9f, 0xea, 0x75, 0x46, 0x02, 0x3e, 0xd5,
+	0x9a, 0x8e, 0xde, 0xe4, 0xfe, 0x8a, 0xd0, 0xd8, 0xe8, 0x9f, 0xe9, 0x7d, 0x1a, 0xf3, 0x19, 0x8d,
+	0x03, 0xef, 0x8f, 0x02, 0x51, 0x9f, 0x46, 0xe4, 0xb3, 0xcf, 0x7c, 0x1a, 0xe4, 0xe1, 0x67, 0x11,
+	0x75, 0xbd, 0xb9, 0x9b, 0xef, 0x66, 0xdc, 0x5d, 0x10, 0x6f, 0x1a, 0x87, 0x4b, 0xea, 0x7a, 0x4a,
+	0x70, 0x4f, 0x17, 0x44, 0x64, 0x45, 0x39, 0xd1, 0xe9, 0x99, 0x76, 0xb8, 0xf4, 0xe7, 0x2e, 0x4f,
+	0xd9, 0xf7, 0xbf, 0x97, 0x51, 0xf5, 0x85, 0xdc, 0xe1, 0x09, 0x77, 0x39, 0xc1, 0x4f, 0x50, 0x49,
+	0xee, 0x99, 0x6d, 0x75, 0xad, 0x5e, 0xe5, 0xa2, 0xd5, 0xd7, 0x76, 0xbc, 0x3f, 0x82, 0xd6, 0xb0,
+	0x78, 0xf3, 0xf3, 0xac, 0x30, 0x56, 0x42, 0x7c, 0x8c, 0xf6, 0x43, 0x1a, 0xf1, 0xa9, 0xef, 0xd9,
+	0xff, 0x75, 0xad, 0x5e, 0x79, 0x5c, 0x12, 0x9f, 0xaf, 0x3c, 0x7c, 0x89, 0x0e, 0xc4, 0x4a, 0xde,
+	0xf8, 0x8c, 0xdb, 0x7b, 0xdd, 0xbd, 0x5e, 0xe5, 0xa2, 0x69, 0xa6, 0x51, 0xc6, 0x55, 0x56, 0x2a,
+	0xc4, 0x77, 0x51, 0x59, 0xfc, 0xbe, 0xa2, 0x71, 0xc0, 0xed, 0x62, 0xd7, 0xea, 0x15, 0xc7, 0xdb,
+	0x02, 0x7e, 0x86, 0xaa, 0xe2, 0x80, 0x46, 0x49, 0xec, 0xff, 0x10, 0x7b, 0xc7, 0x88, 0x9d, 0x28,
+	0x81, 0x8a, 0x36, 0x0c, 0xf8, 0x1c, 0xd5, 0x92, 0x6f, 0x89, 0x28, 0x01, 0xc2, 0x2c, 0xe2, 0x97,
+	0xe8, 0x50, 0x1d, 0x75, 0x4a, 0xda, 0x07, 0x92, 0x6d, 0x90, 0xde, 0x6f, 0x35, 0x0a, 0x96, 0xb5,
+	0xe1, 0x87, 0xa8, 0xa1, 0x95, 0x24, 0xf2, 0x00, 0x90, 0x3b, 0x75, 0xfc, 0x1a, 0x35, 0x92, 0x01,
+	0x4a, 0xb1, 0x65, 0xc0, 0x9e, 0x18, 0xd8, 0x77, 0x9a, 0x48, 0x71, 0x77, 0x8c, 0xf8, 0x11, 0x6a,
+	0xea, 0x35, 0x49, 0x46, 0x40, 0xde, 0x6d, 0x08, 0x74, 0x48, 0x19, 0x1f, 0xab, 0xc9, 0x04, 0x74,
+	0x25, 0x07, 0x3d, 0xd2, 0x44, 0x09, 0x3a, 0x6b, 0xc4, 0x43, 0x54, 0x4b, 0xc7, 0x18, 0x92, 0xaa,
+	0x90, 0x74, 0x64, 0x24, 0x0d, 0x13, 0x85, 0x8a, 0x31, 0x2d, 0xf8, 0x01, 0xaa, 0xa7, 0x05, 0xb9,
+	0xf6, 0x1a, 0xac, 0x3d, 0x53, 0x15, 0x0b, 0x97, 0xb7, 0xe2, 0x1a, 0x2e, 0x05, 0xe0, 0xea, 0x39,
+	0x0b, 0x9f, 0x68, 0xa2, 0x64, 0xe1, 0x59, 0xa3, 0xd8, 0x33, 0xbd, 0x26, 0xb9, 0x87, 0x72, 0xcf,
+	0x76, 0x1a, 0x62, 0x48, 0xe4, 0x4d, 0xbc, 0xfa, 0x18, 0x07, 0x9f, 0x80, 0xdc, 0xc8, 0x19, 0x92,
+	0xeb, 0xad, 0x26, 0x19, 0x92, 0x8c, 0x0d, 0x3f, 0x47, 0x75, 0x79, 0x73, 0xd3, 0x63, 0x6f, 0x42,
+	0xd0, 0xb1, 0x11, 0x34, 0x4e, 0x25, 0x2a, 0x27, 0x63, 0xc2, 0x4b, 0x74, 0xaa, 0xdd, 0xf0, 0x49,
+	0x3c, 0x63, 0xf3, 0xc8, 0x0f, 0x79, 0x72, 0x9e, 0x18, 0x32, 0xcf, 0x33, 0x99, 0xb9, 0x7a, 0x05,
+	0xf8, 0x5b, 0x1c, 0x7e, 0x8a, 0x2a, 0xaa, 0x0d, 0xe9, 0x2d, 0x48, 0x6f, 0xe7, 0xa5, 0xab, 0x34,
+	0x5d, 0x8e, 0xdf, 0x22, 0xac, 0x3e, 0x39, 0xd9, 0x4e, 0x7b, 0xfb, 0x5f, 0xfe, 0x76, 0x8e, 0x71,
+	0xf8, 0xf8, 0x66, 0xed, 0x58, 0xb7, 0x6b, 0xc7, 0xfa, 0xb5, 0x76, 0xac, 0x6f, 0x1b, 0xa7, 0x70,
+	0xbb, 0x71, 0x0a, 0x3f, 0x36, 0x4e, 0xe1, 0x43, 0x4b, 0x3d, 0x80, 0x5f, 0xd4, 0x0b, 0xfe, 0x35,
+	0x24, 0x6c, 0x56, 0x82, 0xd7, 0xef, 0xf2, 0xf7, 0x00, 0x6d, 0x88, 0x75, 0x51, 0x6a, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +289,157 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReplicatedPostList) > 0 {
+		for iNdEx := len(m.ReplicatedPostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicatedPostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ReplicaList) > 0 {
+		for iNdEx := len(m.ReplicaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ReplicationSubscriptionList) > 0 {
+		for iNdEx := len(m.ReplicationSubscriptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationSubscriptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RemotePostList) > 0 {
+		for iNdEx := len(m.RemotePostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemotePostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.UploadChunkList) > 0 {
+		for iNdEx := len(m.UploadChunkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadChunkList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.StagedUploadCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StagedUploadCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.StagedUploadList) > 0 {
+		for iNdEx := len(m.StagedUploadList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StagedUploadList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.BroadcastCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BroadcastCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.BroadcastList) > 0 {
+		for iNdEx := len(m.BroadcastList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BroadcastList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PostRevisionList) > 0 {
+		for iNdEx := len(m.PostRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.OutboundPostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutboundPostCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.OutboundPostList) > 0 {
+		for iNdEx := len(m.OutboundPostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundPostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.TimeoutPostCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPostCount))
 		i--
@@ -303,6 +567,69 @@ func (m *GenesisState) Size() (n int) {
 	if m.TimeoutPostCount != 0 {
 		n += 1 + sovGenesis(uint64(m.TimeoutPostCount))
 	}
+	if len(m.OutboundPostList) > 0 {
+		for _, e := range m.OutboundPostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OutboundPostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.OutboundPostCount))
+	}
+	if len(m.PostRevisionList) > 0 {
+		for _, e := range m.PostRevisionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BroadcastList) > 0 {
+		for _, e := range m.BroadcastList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BroadcastCount != 0 {
+		n += 1 + sovGenesis(uint64(m.BroadcastCount))
+	}
+	if len(m.StagedUploadList) > 0 {
+		for _, e := range m.StagedUploadList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StagedUploadCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StagedUploadCount))
+	}
+	if len(m.UploadChunkList) > 0 {
+		for _, e := range m.UploadChunkList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemotePostList) > 0 {
+		for _, e := range m.RemotePostList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReplicationSubscriptionList) > 0 {
+		for _, e := range m.ReplicationSubscriptionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReplicaList) > 0 {
+		for _, e := range m.ReplicaList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReplicatedPostList) > 0 {
+		for _, e := range m.ReplicatedPostList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -565,6 +892,369 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPostList = append(m.OutboundPostList, OutboundPost{})
+			if err := m.OutboundPostList[len(m.OutboundPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPostCount", wireType)
+			}
+			m.OutboundPostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundPostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostRevisionList = append(m.PostRevisionList, PostRevision{})
+			if err := m.PostRevisionList[len(m.PostRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BroadcastList = append(m.BroadcastList, Broadcast{})
+			if err := m.BroadcastList[len(m.BroadcastList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastCount", wireType)
+			}
+			m.BroadcastCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BroadcastCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StagedUploadList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StagedUploadList = append(m.StagedUploadList, StagedUpload{})
+			if err := m.StagedUploadList[len(m.StagedUploadList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StagedUploadCount", wireType)
+			}
+			m.StagedUploadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StagedUploadCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadChunkList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadChunkList = append(m.UploadChunkList, UploadChunk{})
+			if err := m.UploadChunkList[len(m.UploadChunkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemotePostList = append(m.RemotePostList, RemotePost{})
+			if err := m.RemotePostList[len(m.RemotePostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationSubscriptionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationSubscriptionList = append(m.ReplicationSubscriptionList, ReplicationSubscription{})
+			if err := m.ReplicationSubscriptionList[len(m.ReplicationSubscriptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaList = append(m.ReplicaList, Replica{})
+			if err := m.ReplicaList[len(m.ReplicaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatedPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicatedPostList = append(m.ReplicatedPostList, RemotePost{})
+			if err := m.ReplicatedPostList[len(m.ReplicatedPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -21,6 +21,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				PostList: []types.Post{
 					{
@@ -49,6 +50,95 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				TimeoutPostCount: 2,
+				OutboundPostList: []types.OutboundPost{
+					{
+						Id:        0,
+						ChannelID: "channel-0",
+						Sequence:  1,
+					},
+					{
+						Id:        1,
+						ChannelID: "channel-0",
+						Sequence:  2,
+					},
+				},
+				OutboundPostCount: 2,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostID:   0,
+						Revision: 0,
+					},
+					{
+						PostID:   0,
+						Revision: 1,
+					},
+				},
+				BroadcastList: []types.Broadcast{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				BroadcastCount: 2,
+				StagedUploadList: []types.StagedUpload{
+					{
+						Id:         0,
+						ChannelID:  "channel-0",
+						UploadID:   "3",
+						ChunkCount: 2,
+					},
+					{
+						Id:         1,
+						ChannelID:  "channel-1",
+						UploadID:   "3",
+						ChunkCount: 2,
+					},
+				},
+				StagedUploadCount: 2,
+				UploadChunkList: []types.UploadChunk{
+					{
+						StagedUploadID: 0,
+						Index:          1,
+					},
+				},
+				RemotePostList: []types.RemotePost{
+					{
+						ChannelID: "channel-0",
+						PostID:    "3",
+					},
+					{
+						ChannelID: "channel-1",
+						PostID:    "3",
+					},
+				},
+				ReplicationSubscriptionList: []types.ReplicationSubscription{
+					{
+						ChannelID: "channel-0",
+					},
+					{
+						ChannelID: "channel-1",
+					},
+				},
+				ReplicaList: []types.Replica{
+					{
+						ChannelID: "channel-0",
+					},
+					{
+						ChannelID: "channel-1",
+					},
+				},
+				ReplicatedPostList: []types.RemotePost{
+					{
+						ChannelID: "channel-0",
+						PostID:    "3",
+					},
+					{
+						ChannelID: "channel-1",
+						PostID:    "3",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -131,6 +221,299 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated outboundPost",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				OutboundPostList: []types.OutboundPost{
+					{
+						Id:        0,
+						ChannelID: "channel-0",
+						Sequence:  1,
+					},
+					{
+						Id:        0,
+						ChannelID: "channel-0",
+						Sequence:  2,
+					},
+				},
+				OutboundPostCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated outboundPost channel and sequence",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				OutboundPostList: []types.OutboundPost{
+					{
+						Id:        0,
+						ChannelID: "channel-0",
+						Sequence:  1,
+					},
+					{
+						Id:        1,
+						ChannelID: "channel-0",
+						Sequence:  1,
+					},
+				},
+				OutboundPostCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid outboundPost count",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				OutboundPostList: []types.OutboundPost{
+					{
+						Id: 1,
+					},
+				},
+				OutboundPostCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated postRevision",
+			genState: &types.GenesisState{
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 1,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostID:   0,
+						Revision: 1,
+					},
+					{
+						PostID:   0,
+						Revision: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "postRevision of a missing post",
+			genState: &types.GenesisState{
+				PortId:    types.PortID,
+				PostList:  []types.Post{{Id: 0}},
+				PostCount: 2,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostID:   1,
+						Revision: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated broadcast",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				BroadcastList: []types.Broadcast{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				BroadcastCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid broadcast count",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				BroadcastList: []types.Broadcast{
+					{
+						Id: 1,
+					},
+				},
+				BroadcastCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated stagedUpload",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				StagedUploadList: []types.StagedUpload{
+					{
+						Id:         0,
+						UploadID:   "1",
+						ChunkCount: 2,
+					},
+					{
+						Id:         0,
+						UploadID:   "2",
+						ChunkCount: 2,
+					},
+				},
+				StagedUploadCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated stagedUpload upload ID",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				StagedUploadList: []types.StagedUpload{
+					{
+						Id:         0,
+						ChannelID:  "channel-0",
+						UploadID:   "1",
+						ChunkCount: 2,
+					},
+					{
+						Id:         1,
+						ChannelID:  "channel-0",
+						UploadID:   "1",
+						ChunkCount: 2,
+					},
+				},
+				StagedUploadCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid stagedUpload count",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				StagedUploadList: []types.StagedUpload{
+					{
+						Id:         1,
+						ChunkCount: 2,
+					},
+				},
+				StagedUploadCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "uploadChunk of unknown stagedUpload",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				UploadChunkList: []types.UploadChunk{
+					{
+						StagedUploadID: 0,
+						Index:          0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "uploadChunk index out of range",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				StagedUploadList: []types.StagedUpload{
+					{
+						Id:         0,
+						ChunkCount: 2,
+					},
+				},
+				StagedUploadCount: 1,
+				UploadChunkList: []types.UploadChunk{
+					{
+						StagedUploadID: 0,
+						Index:          2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated uploadChunk",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				StagedUploadList: []types.StagedUpload{
+					{
+						Id:         0,
+						ChunkCount: 2,
+					},
+				},
+				StagedUploadCount: 1,
+				UploadChunkList: []types.UploadChunk{
+					{
+						StagedUploadID: 0,
+						Index:          1,
+					},
+					{
+						StagedUploadID: 0,
+						Index:          1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated remotePost",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RemotePostList: []types.RemotePost{
+					{
+						ChannelID: "channel-0",
+						PostID:    "3",
+					},
+					{
+						ChannelID: "channel-0",
+						PostID:    "3",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated replicationSubscription",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				ReplicationSubscriptionList: []types.ReplicationSubscription{
+					{
+						ChannelID: "channel-0",
+					},
+					{
+						ChannelID: "channel-0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated replica",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				ReplicaList: []types.Replica{
+					{
+						ChannelID: "channel-0",
+					},
+					{
+						ChannelID: "channel-0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated replicatedPost",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				ReplicatedPostList: []types.RemotePost{
+					{
+						ChannelID: "channel-0",
+						PostID:    "3",
+					},
+					{
+						ChannelID: "channel-0",
+						PostID:    "3",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
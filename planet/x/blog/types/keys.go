@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "blog"
@@ -13,8 +17,17 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_blog"
 
+	// Version1 is the channel version of the blog packets without header
+	Version1 = "blog-1"
+
+	// Version2 is the channel version of the blog packets with a protocol header
+	Version2 = "blog-2"
+
+	// Version2JSON is the channel version of the blog-2 packets encoded in JSON
+	Version2JSON = "blog-2-json"
+
 	// Version defines the current version the IBC module supports
-	Version = "blog-1"
+	Version = Version2
 
 	// PortID is the default port id that module binds to
 	PortID = "blog"
@@ -23,6 +36,9 @@ const (
 var (
 	// PortKey defines the key to store the port ID in store
 	PortKey = KeyPrefix("blog-port-")
+
+	// ParamsKey defines the key to store the module params in store
+	ParamsKey = KeyPrefix("p_blog")
 )
 
 func KeyPrefix(p string) []byte {
@@ -30,16 +46,143 @@ func KeyPrefix(p string) []byte {
 }
 
 const (
-	PostKey      = "Post/value/"
-	PostCountKey = "Post/count/"
+	PostKey        = "Post/value/"
+	PostCountKey   = "Post/count/"
+	PostCreatorKey = "Post/creator/"
+	PostChannelKey = "Post/channel/"
+	PostChainKey   = "Post/chain/"
 )
 
+// PostCreatorPrefix returns the store prefix of the posts of a creator
+func PostCreatorPrefix(creator string) []byte {
+	return append([]byte(creator), '/')
+}
+
+// PostCreatorIndex returns the store index of a post by creator: the creator
+// followed by the post ID
+func PostCreatorIndex(creator string, id uint64) []byte {
+	return append(PostCreatorPrefix(creator), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PostChannelPrefix returns the store prefix of the posts received on a local channel
+func PostChannelPrefix(channelID string) []byte {
+	return append([]byte(channelID), '/')
+}
+
+// PostChannelIndex returns the store index of a post by channel: the local
+// channel it was received on followed by the post ID
+func PostChannelIndex(channelID string, id uint64) []byte {
+	return append(PostChannelPrefix(channelID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PostChainPrefix returns the store prefix of the posts received from an origin chain
+func PostChainPrefix(chainID string) []byte {
+	return append([]byte(chainID), '/')
+}
+
+// PostChainIndex returns the store index of a post by origin chain: the origin
+// chain ID followed by the post ID
+func PostChainIndex(chainID string, id uint64) []byte {
+	return append(PostChainPrefix(chainID), sdk.Uint64ToBigEndian(id)...)
+}
+
 const (
-	SentPostKey      = "SentPost/value/"
-	SentPostCountKey = "SentPost/count/"
+	SentPostKey       = "SentPost/value/"
+	SentPostCountKey  = "SentPost/count/"
+	SentPostRemoteKey = "SentPost/remote/"
+	SentPostChainKey  = "SentPost/chain/"
 )
 
+// SentPostChainPrefix returns the store prefix of the sentPosts to a destination chain
+func SentPostChainPrefix(chainID string) []byte {
+	return append([]byte(chainID), '/')
+}
+
+// SentPostChainIndex returns the store index of a sentPost by destination chain:
+// the chain ID followed by the sentPost ID
+func SentPostChainIndex(chainID string, id uint64) []byte {
+	return append(SentPostChainPrefix(chainID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SentPostRemoteIndex returns the store index of a sentPost by remote post: the
+// local channel followed by the post ID on the counterparty chain
+func SentPostRemoteIndex(channelID string, postID string) []byte {
+	var key []byte
+
+	key = append(key, channelID...)
+	key = append(key, '/')
+	key = append(key, postID...)
+
+	return key
+}
+
 const (
 	TimeoutPostKey      = "TimeoutPost/value/"
 	TimeoutPostCountKey = "TimeoutPost/count/"
 )
+
+const (
+	OutboundPostKey      = "OutboundPost/value/"
+	OutboundPostCountKey = "OutboundPost/count/"
+)
+
+// OutboundPostIndex returns the store index of an outbound post: the source
+// channel followed by the packet sequence
+func OutboundPostIndex(channelID string, sequence uint64) []byte {
+	var key []byte
+
+	key = append(key, channelID...)
+	key = append(key, '/')
+	key = append(key, sdk.Uint64ToBigEndian(sequence)...)
+
+	return key
+}
+
+const (
+	PostRevisionKey = "PostRevision/value/"
+)
+
+// PostRevisionPrefix returns the store prefix of the revisions of a post
+func PostRevisionPrefix(postID uint64) []byte {
+	return sdk.Uint64ToBigEndian(postID)
+}
+
+// PostRevisionIndex returns the store index of a post revision: the post ID
+// followed by the revision
+func PostRevisionIndex(postID uint64, revision uint64) []byte {
+	var key []byte
+
+	key = append(key, PostRevisionPrefix(postID)...)
+	key = append(key, sdk.Uint64ToBigEndian(revision)...)
+
+	return key
+}
+
+const (
+	BroadcastKey      = "Broadcast/value/"
+	BroadcastCountKey = "Broadcast/count/"
+)
+
+const (
+	StagedUploadKey       = "StagedUpload/value/"
+	StagedUploadCountKey  = "StagedUpload/count/"
+	StagedUploadRemoteKey = "StagedUpload/remote/"
+	StagedUploadExpiryKey = "StagedUpload/expiry/"
+	UploadChunkKey        = "UploadChunk/value/"
+)
+
+const (
+	RemotePostKey = "RemotePost/value/"
+)
+
+const (
+	InboundPacketCountKey = "InboundPacketCount/value/"
+)
+
+const (
+	ReplicationSubscriptionKey = "ReplicationSubscription/value/"
+	ReplicaKey                 = "Replica/value/"
+	ReplicatedPostKey          = "ReplicatedPost/value/"
+	PostChangeKey              = "PostChange/value/"
+	PostChangeCountKey         = "PostChange/count/"
+)
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendBatchPost = "send_batch_post"
+
+var _ sdk.Msg = &MsgSendBatchPost{}
+
+func NewMsgSendBatchPost(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	posts []BatchPost,
+	atomic bool,
+) *MsgSendBatchPost {
+	return &MsgSendBatchPost{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		Posts:            posts,
+		Atomic:           atomic,
+	}
+}
+
+func (msg *MsgSendBatchPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendBatchPost) Type() string {
+	return TypeMsgSendBatchPost
+}
+
+func (msg *MsgSendBatchPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendBatchPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendBatchPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if len(msg.Posts) == 0 {
+		return ErrEmptyBatch
+	}
+	for i, post := range msg.Posts {
+		if err := ValidatePostTitle(post.Title); err != nil {
+			return sdkerrors.Wrapf(err, "post %d", i)
+		}
+		if err := ValidatePostContent(post.Content); err != nil {
+			return sdkerrors.Wrapf(err, "post %d", i)
+		}
+	}
+	return ValidatePacketMemo(msg.Memo)
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendBatchPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendBatchPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendBatchPost{
+				Creator:   "invalid_address",
+				Port:      "port",
+				ChannelID: "channel-0",
+				Posts:     []BatchPost{{Title: "title"}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid port",
+			msg: MsgSendBatchPost{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Posts:     []BatchPost{{Title: "title"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg: MsgSendBatchPost{
+				Creator: sample.AccAddress(),
+				Port:    "port",
+				Posts:   []BatchPost{{Title: "title"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no post",
+			msg: MsgSendBatchPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+			},
+			err: ErrEmptyBatch,
+		}, {
+			name: "empty title",
+			msg: MsgSendBatchPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Posts:     []BatchPost{{Title: "title"}, {Content: "content"}},
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "invalid content",
+			msg: MsgSendBatchPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Posts:     []BatchPost{{Title: "title", Content: "\x00"}},
+			},
+			err: ErrControlCharacter,
+		}, {
+			name: "memo too long",
+			msg: MsgSendBatchPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Posts:     []BatchPost{{Title: "title"}},
+				Memo:      strings.Repeat("m", MaxPacketMemoLength+1),
+			},
+			err: ErrMemoTooLong,
+		}, {
+			name: "valid message",
+			msg: MsgSendBatchPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Posts:            []BatchPost{{Title: "first", Content: "content"}, {Title: "second"}},
+				Atomic:           true,
+				Memo:             "memo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
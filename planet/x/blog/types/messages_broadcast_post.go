@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBroadcastPost = "broadcast_post"
+
+var _ sdk.Msg = &MsgBroadcastPost{}
+
+func NewMsgBroadcastPost(
+	creator string,
+	channelIDs []string,
+	allChannels bool,
+	timeoutTimestamp uint64,
+	title string,
+	content string,
+) *MsgBroadcastPost {
+	return &MsgBroadcastPost{
+		Creator:          creator,
+		ChannelIDs:       channelIDs,
+		AllChannels:      allChannels,
+		TimeoutTimestamp: timeoutTimestamp,
+		Title:            title,
+		Content:          content,
+	}
+}
+
+func (msg *MsgBroadcastPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBroadcastPost) Type() string {
+	return TypeMsgBroadcastPost
+}
+
+func (msg *MsgBroadcastPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBroadcastPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBroadcastPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.AllChannels == (len(msg.ChannelIDs) != 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either channel IDs or all channels must be set")
+	}
+	channelIDs := make(map[string]bool)
+	for _, channelID := range msg.ChannelIDs {
+		if channelID == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+		}
+		if channelIDs[channelID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated channel %s", channelID)
+		}
+		channelIDs[channelID] = true
+	}
+	if err := ValidatePostTitle(msg.Title); err != nil {
+		return err
+	}
+	if err := ValidatePostContent(msg.Content); err != nil {
+		return err
+	}
+	return ValidatePacketMemo(msg.Memo)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgBroadcastPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBroadcastPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBroadcastPost{
+				Creator:     "invalid_address",
+				AllChannels: true,
+				Title:       "title",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no destination",
+			msg: MsgBroadcastPost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "channels and all channels",
+			msg: MsgBroadcastPost{
+				Creator:     sample.AccAddress(),
+				ChannelIDs:  []string{"channel-0"},
+				AllChannels: true,
+				Title:       "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg: MsgBroadcastPost{
+				Creator:    sample.AccAddress(),
+				ChannelIDs: []string{"channel-0", ""},
+				Title:      "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicated channel",
+			msg: MsgBroadcastPost{
+				Creator:    sample.AccAddress(),
+				ChannelIDs: []string{"channel-0", "channel-0"},
+				Title:      "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty title",
+			msg: MsgBroadcastPost{
+				Creator:     sample.AccAddress(),
+				AllChannels: true,
+				Content:     "content",
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "memo too long",
+			msg: MsgBroadcastPost{
+				Creator:     sample.AccAddress(),
+				AllChannels: true,
+				Title:       "title",
+				Memo:        strings.Repeat("m", MaxPacketMemoLength+1),
+			},
+			err: ErrMemoTooLong,
+		}, {
+			name: "all channels",
+			msg: MsgBroadcastPost{
+				Creator:     sample.AccAddress(),
+				AllChannels: true,
+				Title:       "title",
+			},
+		}, {
+			name: "valid message",
+			msg: MsgBroadcastPost{
+				Creator:          sample.AccAddress(),
+				ChannelIDs:       []string{"channel-0", "channel-1"},
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Content:          "content",
+				Memo:             "memo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendChunkedPost = "send_chunked_post"
+
+var _ sdk.Msg = &MsgSendChunkedPost{}
+
+func NewMsgSendChunkedPost(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	title string,
+	content string,
+	chunkSize uint64,
+) *MsgSendChunkedPost {
+	return &MsgSendChunkedPost{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		Title:            title,
+		Content:          content,
+		ChunkSize:        chunkSize,
+	}
+}
+
+func (msg *MsgSendChunkedPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendChunkedPost) Type() string {
+	return TypeMsgSendChunkedPost
+}
+
+func (msg *MsgSendChunkedPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendChunkedPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendChunkedPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if err := ValidatePostTitle(msg.Title); err != nil {
+		return err
+	}
+	if err := ValidatePostContent(msg.Content); err != nil {
+		return err
+	}
+	if msg.ChunkSize == 0 {
+		return sdkerrors.Wrap(ErrInvalidChunk, "chunk size must be positive")
+	}
+	if chunks := (uint64(len(msg.Content)) + msg.ChunkSize - 1) / msg.ChunkSize; chunks > MaxUploadChunks {
+		return sdkerrors.Wrapf(ErrInvalidChunk, "%d chunks, max %d", chunks, MaxUploadChunks)
+	}
+	return ValidatePacketMemo(msg.Memo)
+}
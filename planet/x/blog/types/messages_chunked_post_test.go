@@ -0,0 +1,102 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendChunkedPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendChunkedPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendChunkedPost{
+				Creator:   "invalid_address",
+				Port:      "port",
+				ChannelID: "channel-0",
+				Title:     "title",
+				ChunkSize: 4,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid port",
+			msg: MsgSendChunkedPost{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				Title:     "title",
+				ChunkSize: 4,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty title",
+			msg: MsgSendChunkedPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				ChunkSize: 4,
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "zero chunk size",
+			msg: MsgSendChunkedPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Title:     "title",
+				Content:   "content",
+			},
+			err: ErrInvalidChunk,
+		}, {
+			name: "too many chunks",
+			msg: MsgSendChunkedPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Title:     "title",
+				Content:   strings.Repeat("c", MaxUploadChunks+1),
+				ChunkSize: 1,
+			},
+			err: ErrInvalidChunk,
+		}, {
+			name: "memo too long",
+			msg: MsgSendChunkedPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Title:     "title",
+				ChunkSize: 4,
+				Memo:      strings.Repeat("m", MaxPacketMemoLength+1),
+			},
+			err: ErrMemoTooLong,
+		}, {
+			name: "valid message",
+			msg: MsgSendChunkedPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Content:          strings.Repeat("c", MaxUploadChunks),
+				ChunkSize:        1,
+				Memo:             "memo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
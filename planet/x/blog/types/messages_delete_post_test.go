@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgSendDeletePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendDeletePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendDeletePost{
+				Creator:   "invalid_address",
+				Port:      "port",
+				ChannelID: "channel-0",
+				PostID:    "0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid port",
+			msg: MsgSendDeletePost{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+				PostID:    "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg: MsgSendDeletePost{
+				Creator: sample.AccAddress(),
+				Port:    "port",
+				PostID:  "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid post ID",
+			msg: MsgSendDeletePost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+			},
+			err: ErrInvalidPostID,
+		}, {
+			name: "valid message",
+			msg: MsgSendDeletePost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				PostID:           "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
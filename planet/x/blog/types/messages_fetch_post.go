@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFetchPost = "fetch_post"
+
+var _ sdk.Msg = &MsgFetchPost{}
+
+func NewMsgFetchPost(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	postID string,
+) *MsgFetchPost {
+	return &MsgFetchPost{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		PostID:           postID,
+	}
+}
+
+func (msg *MsgFetchPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFetchPost) Type() string {
+	return TypeMsgFetchPost
+}
+
+func (msg *MsgFetchPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFetchPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFetchPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if err := ValidatePostID(msg.PostID); err != nil {
+		return err
+	}
+	return ValidatePacketMemo(msg.Memo)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgFetchPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFetchPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFetchPost{
+				Creator:   "invalid_address",
+				Port:      "port",
+				ChannelID: "channel-0",
+				PostID:    "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgFetchPost{
+				Creator: sample.AccAddress(),
+				Port:    "port",
+				PostID:  "1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid post ID",
+			msg: MsgFetchPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				PostID:    "first",
+			},
+			err: ErrInvalidPostID,
+		}, {
+			name: "memo too long",
+			msg: MsgFetchPost{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				PostID:    "1",
+				Memo:      strings.Repeat("m", MaxPacketMemoLength+1),
+			},
+			err: ErrMemoTooLong,
+		}, {
+			name: "valid message",
+			msg: MsgFetchPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				PostID:           "1",
+				Memo:             "memo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
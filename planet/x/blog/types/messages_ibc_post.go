@@ -59,8 +59,11 @@ func (msg *MsgSendIbcPost) ValidateBasic() error {
 	if msg.ChannelID == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
 	}
-	if msg.TimeoutTimestamp == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	if err := ValidatePostTitle(msg.Title); err != nil {
+		return err
 	}
-	return nil
+	if err := ValidatePostContent(msg.Content); err != nil {
+		return err
+	}
+	return ValidatePacketMemo(msg.Memo)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -42,14 +43,45 @@ func TestMsgSendIbcPost_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		}, {
-			name: "invalid timeout",
+			name: "empty title",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Content:          "content",
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "control character in title",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title\n",
+			},
+			err: ErrControlCharacter,
+		}, {
+			name: "memo too long",
+			msg: MsgSendIbcPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Title:            "title",
+				Memo:             strings.Repeat("m", MaxPacketMemoLength+1),
+			},
+			err: ErrMemoTooLong,
+		}, {
+			name: "default timeout",
 			msg: MsgSendIbcPost{
 				Creator:          sample.AccAddress(),
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 0,
+				Title:            "title",
 			},
-			err: sdkerrors.ErrInvalidRequest,
 		}, {
 			name: "valid message",
 			msg: MsgSendIbcPost{
@@ -57,6 +89,9 @@ func TestMsgSendIbcPost_ValidateBasic(t *testing.T) {
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 100,
+				Title:            "title",
+				Content:          "line\n\tline",
+				Memo:             "memo",
 			},
 		},
 	}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgCreatePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreatePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreatePost{
+				Creator: "invalid_address",
+				Title:   "title",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty title",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "invalid content",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+				Content: "\x07",
+			},
+			err: ErrControlCharacter,
+		}, {
+			name: "valid address",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdatePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdatePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdatePost{
+				Creator: "invalid_address",
+				Title:   "title",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty title",
+			msg: MsgUpdatePost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "valid address",
+			msg: MsgUpdatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeletePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeletePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeletePost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgDeletePost{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResyncReplica = "resync_replica"
+
+var _ sdk.Msg = &MsgResyncReplica{}
+
+func NewMsgResyncReplica(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+) *MsgResyncReplica {
+	return &MsgResyncReplica{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgResyncReplica) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResyncReplica) Type() string {
+	return TypeMsgResyncReplica
+}
+
+func (msg *MsgResyncReplica) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResyncReplica) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResyncReplica) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	return ValidatePacketMemo(msg.Memo)
+}
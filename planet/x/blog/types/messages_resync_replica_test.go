@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgResyncReplica_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResyncReplica
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResyncReplica{
+				Creator:   "invalid_address",
+				Port:      "port",
+				ChannelID: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid port",
+			msg: MsgResyncReplica{
+				Creator:   sample.AccAddress(),
+				ChannelID: "channel-0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg: MsgResyncReplica{
+				Creator: sample.AccAddress(),
+				Port:    "port",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "memo too long",
+			msg: MsgResyncReplica{
+				Creator:   sample.AccAddress(),
+				Port:      "port",
+				ChannelID: "channel-0",
+				Memo:      strings.Repeat("m", MaxPacketMemoLength+1),
+			},
+			err: ErrMemoTooLong,
+		}, {
+			name: "valid message",
+			msg: MsgResyncReplica{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				Memo:             "memo",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRetryTimeoutPost = "retry_timeout_post"
+
+var _ sdk.Msg = &MsgRetryTimeoutPost{}
+
+func NewMsgRetryTimeoutPost(
+	creator string,
+	id uint64,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+) *MsgRetryTimeoutPost {
+	return &MsgRetryTimeoutPost{
+		Creator:          creator,
+		Id:               id,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgRetryTimeoutPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryTimeoutPost) Type() string {
+	return TypeMsgRetryTimeoutPost
+}
+
+func (msg *MsgRetryTimeoutPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryTimeoutPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryTimeoutPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port != "" && msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgRetryTimeoutPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRetryTimeoutPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRetryTimeoutPost{
+				Creator:          "invalid_address",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "port without channel",
+			msg: MsgRetryTimeoutPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				TimeoutTimestamp: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "default timeout",
+			msg: MsgRetryTimeoutPost{
+				Creator:          sample.AccAddress(),
+				TimeoutTimestamp: 0,
+			},
+		}, {
+			name: "original channel",
+			msg: MsgRetryTimeoutPost{
+				Creator:          sample.AccAddress(),
+				TimeoutTimestamp: 100,
+			},
+		}, {
+			name: "other channel",
+			msg: MsgRetryTimeoutPost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-1",
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
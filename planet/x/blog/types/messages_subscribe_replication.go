@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubscribeReplication = "subscribe_replication"
+
+var _ sdk.Msg = &MsgSubscribeReplication{}
+
+func NewMsgSubscribeReplication(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+) *MsgSubscribeReplication {
+	return &MsgSubscribeReplication{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSubscribeReplication) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubscribeReplication) Type() string {
+	return TypeMsgSubscribeReplication
+}
+
+func (msg *MsgSubscribeReplication) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubscribeReplication) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubscribeReplication) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	return ValidatePacketMemo(msg.Memo)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    DefaultParams(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid params",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    Params{},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    DefaultParams(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,6 +17,7 @@ func NewMsgSendUpdatePost(
 	postID string,
 	title string,
 	content string,
+	baseRevision uint64,
 ) *MsgSendUpdatePost {
 	return &MsgSendUpdatePost{
 		Creator:          creator,
@@ -26,6 +27,7 @@ func NewMsgSendUpdatePost(
 		PostID:           postID,
 		Title:            title,
 		Content:          content,
+		BaseRevision:     baseRevision,
 	}
 }
 
@@ -61,8 +63,14 @@ func (msg *MsgSendUpdatePost) ValidateBasic() error {
 	if msg.ChannelID == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
 	}
-	if msg.TimeoutTimestamp == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	if err := ValidatePostID(msg.PostID); err != nil {
+		return err
 	}
-	return nil
+	if err := ValidatePostTitle(msg.Title); err != nil {
+		return err
+	}
+	if err := ValidatePostContent(msg.Content); err != nil {
+		return err
+	}
+	return ValidatePacketMemo(msg.Memo)
 }
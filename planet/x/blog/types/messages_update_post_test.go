@@ -42,14 +42,48 @@ func TestMsgSendUpdatePost_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		}, {
-			name: "invalid timeout",
+			name: "invalid post ID",
+			msg: MsgSendUpdatePost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				PostID:           "first",
+				Title:            "title",
+			},
+			err: ErrInvalidPostID,
+		}, {
+			name: "empty title",
+			msg: MsgSendUpdatePost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				PostID:           "0",
+			},
+			err: ErrEmptyTitle,
+		}, {
+			name: "invalid UTF-8 content",
+			msg: MsgSendUpdatePost{
+				Creator:          sample.AccAddress(),
+				Port:             "port",
+				ChannelID:        "channel-0",
+				TimeoutTimestamp: 100,
+				PostID:           "0",
+				Title:            "title",
+				Content:          "\xff",
+			},
+			err: ErrInvalidUTF8,
+		}, {
+			name: "default timeout",
 			msg: MsgSendUpdatePost{
 				Creator:          sample.AccAddress(),
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 0,
+				PostID:           "0",
+				Title:            "title",
 			},
-			err: sdkerrors.ErrInvalidRequest,
 		}, {
 			name: "valid message",
 			msg: MsgSendUpdatePost{
@@ -57,6 +91,9 @@ func TestMsgSendUpdatePost_ValidateBasic(t *testing.T) {
 				Port:             "port",
 				ChannelID:        "channel-0",
 				TimeoutTimestamp: 100,
+				PostID:           "0",
+				Title:            "title",
+				Content:          "content",
 			},
 		},
 	}
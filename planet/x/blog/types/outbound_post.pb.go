@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/outbound_post.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OutboundPostStatus is the delivery status of a post sent over IBC
+type OutboundPostStatus int32
+
+const (
+	OutboundPostStatusUnspecified OutboundPostStatus = 0
+	OutboundPostPending           OutboundPostStatus = 1
+	OutboundPostDelivered         OutboundPostStatus = 2
+	OutboundPostFailed            OutboundPostStatus = 3
+	OutboundPostTimedOut          OutboundPostStatus = 4
+	OutboundPostConflict          OutboundPostStatus = 5
+	// OUTBOUND_POST_STATUS_PARTIAL is a batch of which only some posts were stored
+	OutboundPostPartial OutboundPostStatus = 6
+)
+
+var OutboundPostStatus_name = map[int32]string{
+	0: "OUTBOUND_POST_STATUS_UNSPECIFIED",
+	1: "OUTBOUND_POST_STATUS_PENDING",
+	2: "OUTBOUND_POST_STATUS_DELIVERED",
+	3: "OUTBOUND_POST_STATUS_FAILED",
+	4: "OUTBOUND_POST_STATUS_TIMED_OUT",
+	5: "OUTBOUND_POST_STATUS_CONFLICT",
+	6: "OUTBOUND_POST_STATUS_PARTIAL",
+}
+
+var OutboundPostStatus_value = map[string]int32{
+	"OUTBOUND_POST_STATUS_UNSPECIFIED": 0,
+	"OUTBOUND_POST_STATUS_PENDING":     1,
+	"OUTBOUND_POST_STATUS_DELIVERED":   2,
+	"OUTBOUND_POST_STATUS_FAILED":      3,
+	"OUTBOUND_POST_STATUS_TIMED_OUT":   4,
+	"OUTBOUND_POST_STATUS_CONFLICT":    5,
+	"OUTBOUND_POST_STATUS_PARTIAL":     6,
+}
+
+func (x OutboundPostStatus) String() string {
+	return proto.EnumName(OutboundPostStatus_name, int32(x))
+}
+
+func (OutboundPostStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eac01547518def8, []int{0}
+}
+
+// OutboundPost tracks a post or an update from the moment it is sent until its
+// packet is acknowledged or times out. It is keyed by source channel and packet sequence.
+type OutboundPost struct {
+	Id        uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelID string             `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Sequence  uint64             `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Creator   string             `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title     string             `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Chain     string             `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
+	Status    OutboundPostStatus `protobuf:"varint,7,opt,name=status,proto3,enum=planet.blog.OutboundPostStatus" json:"status,omitempty"`
+	// postID is the post ID on the counterparty chain once delivered, the comma
+	// separated IDs of the stored posts of a batch
+	PostID string `protobuf:"bytes,8,opt,name=postID,proto3" json:"postID,omitempty"`
+	// error is the error acknowledgement of a failed delivery, or the errors of
+	// the posts of a batch that failed
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// revision is the counterparty revision of an updated post once delivered,
+	// or its current revision when the update conflicted
+	Revision uint64 `protobuf:"varint,10,opt,name=revision,proto3" json:"revision,omitempty"`
+	// errorCode is the error code of the error acknowledgement of a failed delivery
+	ErrorCode AckErrorCode `protobuf:"varint,11,opt,name=errorCode,proto3,enum=planet.blog.AckErrorCode" json:"errorCode,omitempty"`
+	// uploadID is the upload of a chunked post the packet carries a chunk of
+	UploadID string `protobuf:"bytes,12,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
+}
+
+func (m *OutboundPost) Reset()         { *m = OutboundPost{} }
+func (m *OutboundPost) String() string { return proto.CompactTextString(m) }
+func (*OutboundPost) ProtoMessage()    {}
+func (*OutboundPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eac01547518def8, []int{0}
+}
+func (m *OutboundPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundPost.Merge(m, src)
+}
+func (m *OutboundPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundPost proto.InternalMessageInfo
+
+func (m *OutboundPost) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OutboundPost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *OutboundPost) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *OutboundPost) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *OutboundPost) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *OutboundPost) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *OutboundPost) GetStatus() OutboundPostStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OutboundPostStatusUnspecified
+}
+
+func (m *OutboundPost) GetPostID() string {
+	if m != nil {
+		return m.PostID
+	}
+	return ""
+}
+
+func (m *OutboundPost) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *OutboundPost) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *OutboundPost) GetErrorCode() AckErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return AckErrorUnspecified
+}
+
+func (m *OutboundPost) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("planet.blog.OutboundPostStatus", OutboundPostStatus_name, OutboundPostStatus_value)
+	proto.RegisterType((*OutboundPost)(nil), "planet.blog.OutboundPost")
+}
+
+func init() { proto.RegisterFile("planet/blog/outbound_post.proto", fileDescriptor_9eac01547518def8) }
+
+var fileDescriptor_9eac01547518def8 = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xfc, 0x6b, 0x33, 0xad, 0x2a, 0x6b, 0x9a, 0xaf, 0xdf, 0xd4, 0xb4, 0xae, 0x61,
+	0x15, 0x21, 0x91, 0x48, 0xb0, 0x88, 0x10, 0xb0, 0x48, 0x63, 0xa7, 0xb2, 0x14, 0xe2, 0xc8, 0xb1,
+	0x59, 0xb0, 0x89, 0x1c, 0x7b, 0x9a, 0x8e, 0x6a, 0x3c, 0xc6, 0x1e, 0x57, 0xf0, 0x06, 0x28, 0x2b,
+	0x5e, 0x20, 0x2b, 0x5e, 0x86, 0x05, 0x8b, 0x2e, 0x59, 0xa2, 0xe4, 0x1d, 0x58, 0x23, 0x8f, 0x93,
+	0xd6, 0x55, 0xd2, 0xdd, 0x9c, 0xab, 0xf3, 0x3b, 0xf7, 0xde, 0x91, 0x2e, 0x38, 0x0b, 0x7d, 0x27,
+	0xc0, 0xac, 0x35, 0xf1, 0xe9, 0xb4, 0x45, 0x13, 0x36, 0xa1, 0x49, 0xe0, 0x8d, 0x43, 0x1a, 0xb3,
+	0x66, 0x18, 0x51, 0x46, 0xe1, 0x5e, 0x66, 0x68, 0xa6, 0x06, 0xa9, 0x3e, 0xa5, 0x53, 0xca, 0xeb,
+	0xad, 0xf4, 0x95, 0x59, 0x24, 0x94, 0xcf, 0x08, 0x1d, 0xf7, 0x1a, 0xaf, 0xe0, 0x67, 0x7f, 0x8b,
+	0x60, 0xdf, 0x58, 0x85, 0x0e, 0x69, 0xcc, 0xe0, 0x01, 0x28, 0x12, 0x0f, 0x09, 0x8a, 0xd0, 0x28,
+	0x9b, 0x45, 0xe2, 0xc1, 0x13, 0x50, 0x73, 0xaf, 0x9c, 0x20, 0xc0, 0xbe, 0xae, 0xa2, 0xa2, 0x22,
+	0x34, 0x6a, 0xe6, 0x7d, 0x01, 0x4a, 0x60, 0x37, 0xc6, 0x9f, 0x13, 0x1c, 0xb8, 0x18, 0x95, 0x38,
+	0x73, 0xa7, 0x21, 0x02, 0x3b, 0x6e, 0x84, 0x1d, 0x46, 0x23, 0x54, 0xe6, 0xdc, 0x5a, 0xc2, 0x3a,
+	0xa8, 0x30, 0xc2, 0x7c, 0x8c, 0x2a, 0xbc, 0x9e, 0x89, 0xb4, 0xea, 0x5e, 0x39, 0x24, 0x40, 0xd5,
+	0xac, 0xca, 0x05, 0x6c, 0x83, 0x6a, 0xcc, 0x1c, 0x96, 0xc4, 0x68, 0x47, 0x11, 0x1a, 0x07, 0x2f,
+	0xcf, 0x9a, 0xb9, 0x75, 0x9b, 0xf9, 0xd1, 0x47, 0xdc, 0x66, 0xae, 0xec, 0xf0, 0x08, 0x54, 0xd3,
+	0x4f, 0xd2, 0x55, 0xb4, 0xcb, 0xf3, 0x56, 0x2a, 0x6d, 0x83, 0xa3, 0x88, 0x46, 0xa8, 0x96, 0xb5,
+	0xe1, 0x22, 0x5d, 0x24, 0xc2, 0x37, 0x24, 0x26, 0x34, 0x40, 0x20, 0x5b, 0x64, 0xad, 0x61, 0x1b,
+	0xd4, 0xb8, 0xa9, 0x4b, 0x3d, 0x8c, 0xf6, 0xf8, 0x14, 0xc7, 0x0f, 0xa6, 0xe8, 0xb8, 0xd7, 0xda,
+	0xda, 0x60, 0xde, 0x7b, 0xd3, 0xd0, 0x24, 0xf4, 0xa9, 0xe3, 0xe9, 0x2a, 0xda, 0xe7, 0xdd, 0xee,
+	0xf4, 0xf3, 0x5f, 0x25, 0x00, 0x37, 0xa7, 0x87, 0x17, 0x40, 0x31, 0x6c, 0xeb, 0xdc, 0xb0, 0x07,
+	0xea, 0x78, 0x68, 0x8c, 0xac, 0xf1, 0xc8, 0xea, 0x58, 0xf6, 0x68, 0x6c, 0x0f, 0x46, 0x43, 0xad,
+	0xab, 0xf7, 0x74, 0x4d, 0x15, 0x0b, 0xd2, 0xd3, 0xd9, 0x5c, 0x39, 0xdd, 0xa4, 0xed, 0x20, 0x0e,
+	0xb1, 0x4b, 0x2e, 0x09, 0xf6, 0xe0, 0x6b, 0x70, 0xb2, 0x35, 0x68, 0xa8, 0x0d, 0x54, 0x7d, 0x70,
+	0x21, 0x0a, 0xd2, 0xff, 0xb3, 0xb9, 0x72, 0x98, 0x0f, 0x19, 0xe2, 0xc0, 0x23, 0xc1, 0x14, 0xbe,
+	0x03, 0xf2, 0x56, 0x54, 0xd5, 0xfa, 0xfa, 0x07, 0xcd, 0xd4, 0x54, 0xb1, 0x28, 0x1d, 0xcf, 0xe6,
+	0xca, 0x7f, 0x79, 0x58, 0xc5, 0x3e, 0xb9, 0xc1, 0x11, 0xf6, 0x60, 0x1b, 0x3c, 0xd9, 0x8a, 0xf7,
+	0x3a, 0x7a, 0x5f, 0x53, 0xc5, 0x92, 0x74, 0x34, 0x9b, 0x2b, 0x0f, 0x76, 0xef, 0x39, 0xc4, 0xc7,
+	0x1e, 0x7c, 0xfb, 0x48, 0x5f, 0x4b, 0x7f, 0xaf, 0xa9, 0x63, 0xc3, 0xb6, 0xc4, 0xb2, 0x84, 0x66,
+	0x73, 0xa5, 0x9e, 0x67, 0x2d, 0xf2, 0x09, 0x7b, 0x46, 0xc2, 0xe0, 0x1b, 0x70, 0xba, 0x95, 0xee,
+	0x1a, 0x83, 0x5e, 0x5f, 0xef, 0x5a, 0x62, 0x65, 0x13, 0xee, 0xd2, 0xe0, 0xd2, 0x27, 0x2e, 0x7b,
+	0xfc, 0xb7, 0x3a, 0xa6, 0xa5, 0x77, 0xfa, 0x62, 0x75, 0xcb, 0x6f, 0x39, 0x11, 0x23, 0x8e, 0x2f,
+	0x95, 0xbf, 0xfd, 0x90, 0x0b, 0xe7, 0x2f, 0x7e, 0x2e, 0x64, 0xe1, 0x76, 0x21, 0x0b, 0x7f, 0x16,
+	0xb2, 0xf0, 0x7d, 0x29, 0x17, 0x6e, 0x97, 0x72, 0xe1, 0xf7, 0x52, 0x2e, 0x7c, 0x3c, 0x5c, 0xdd,
+	0xde, 0x97, 0xec, 0xfa, 0xd8, 0xd7, 0x10, 0xc7, 0x93, 0x2a, 0xbf, 0xbe, 0x57, 0xff, 0x06, 0x00,
+	0xeb, 0x95, 0x86, 0xf4, 0xdd, 0x03, 0x00, 0x00,
+}
+
+func (m *OutboundPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintOutboundPost(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Revision != 0 {
+		i = encodeVarintOutboundPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintOutboundPost(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintOutboundPost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintOutboundPost(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOutboundPost(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutboundPost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutboundPost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutboundPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOutboundPost(uint64(m.Id))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOutboundPost(uint64(m.Sequence))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovOutboundPost(uint64(m.Status))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovOutboundPost(uint64(m.Revision))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovOutboundPost(uint64(m.ErrorCode))
+	}
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovOutboundPost(uint64(l))
+	}
+	return n
+}
+
+func sovOutboundPost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutboundPost(x uint64) (n int) {
+	return sovOutboundPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OutboundPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutboundPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutboundPostStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= AckErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutboundPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutboundPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutboundPost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutboundPost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutboundPost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutboundPost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutboundPost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutboundPost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutboundPost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutboundPost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutboundPost = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,11 +23,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AckErrorCode tells the sending chain why a blog packet failed on the
+// receiving chain
+type AckErrorCode int32
+
+const (
+	// ACK_ERROR_CODE_UNSPECIFIED is an error the blog module doesn't classify,
+	// or the error acknowledgement of another module
+	AckErrorUnspecified AckErrorCode = 0
+	// ACK_ERROR_CODE_NOT_FOUND is returned when the post doesn't exist
+	AckErrorNotFound AckErrorCode = 1
+	// ACK_ERROR_CODE_UNAUTHORIZED is returned when the sender isn't the post author
+	AckErrorUnauthorized AckErrorCode = 2
+	// ACK_ERROR_CODE_INVALID_PAYLOAD is returned when the packet data is invalid
+	AckErrorInvalidPayload AckErrorCode = 3
+	// ACK_ERROR_CODE_CONFLICT is returned when an edit is based on a stale revision
+	AckErrorConflict AckErrorCode = 4
+	// ACK_ERROR_CODE_DISABLED is returned when the channel doesn't accept posts
+	AckErrorDisabled AckErrorCode = 5
+	// ACK_ERROR_CODE_RATE_LIMITED is returned when the sender exceeded its quota
+	AckErrorRateLimited AckErrorCode = 6
+	// ACK_ERROR_CODE_SEQUENCE_GAP is returned when a replicated change doesn't
+	// follow the last change applied
+	AckErrorSequenceGap AckErrorCode = 7
+)
+
+var AckErrorCode_name = map[int32]string{
+	0: "ACK_ERROR_CODE_UNSPECIFIED",
+	1: "ACK_ERROR_CODE_NOT_FOUND",
+	2: "ACK_ERROR_CODE_UNAUTHORIZED",
+	3: "ACK_ERROR_CODE_INVALID_PAYLOAD",
+	4: "ACK_ERROR_CODE_CONFLICT",
+	5: "ACK_ERROR_CODE_DISABLED",
+	6: "ACK_ERROR_CODE_RATE_LIMITED",
+	7: "ACK_ERROR_CODE_SEQUENCE_GAP",
+}
+
+var AckErrorCode_value = map[string]int32{
+	"ACK_ERROR_CODE_UNSPECIFIED":     0,
+	"ACK_ERROR_CODE_NOT_FOUND":       1,
+	"ACK_ERROR_CODE_UNAUTHORIZED":    2,
+	"ACK_ERROR_CODE_INVALID_PAYLOAD": 3,
+	"ACK_ERROR_CODE_CONFLICT":        4,
+	"ACK_ERROR_CODE_DISABLED":        5,
+	"ACK_ERROR_CODE_RATE_LIMITED":    6,
+	"ACK_ERROR_CODE_SEQUENCE_GAP":    7,
+}
+
+func (x AckErrorCode) String() string {
+	return proto.EnumName(AckErrorCode_name, int32(x))
+}
+
+func (AckErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{0}
+}
+
 type BlogPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*BlogPacketData_NoData
 	//	*BlogPacketData_IbcPostPacket
 	//	*BlogPacketData_UpdatePostPacket
+	//	*BlogPacketData_DeletePostPacket
+	//	*BlogPacketData_BatchPostPacket
+	//	*BlogPacketData_PostChunkPacket
+	//	*BlogPacketData_FetchPostPacket
+	//	*BlogPacketData_SubscribeReplicationPacket
+	//	*BlogPacketData_ReplicatePostPacket
 	Packet isBlogPacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -78,10 +140,34 @@ type BlogPacketData_IbcPostPacket struct {
 type BlogPacketData_UpdatePostPacket struct {
 	UpdatePostPacket *UpdatePostPacketData `protobuf:"bytes,3,opt,name=updatePostPacket,proto3,oneof" json:"updatePostPacket,omitempty"`
 }
+type BlogPacketData_DeletePostPacket struct {
+	DeletePostPacket *DeletePostPacketData `protobuf:"bytes,4,opt,name=deletePostPacket,proto3,oneof" json:"deletePostPacket,omitempty"`
+}
+type BlogPacketData_BatchPostPacket struct {
+	BatchPostPacket *BatchPostPacketData `protobuf:"bytes,5,opt,name=batchPostPacket,proto3,oneof" json:"batchPostPacket,omitempty"`
+}
+type BlogPacketData_PostChunkPacket struct {
+	PostChunkPacket *PostChunkPacketData `protobuf:"bytes,6,opt,name=postChunkPacket,proto3,oneof" json:"postChunkPacket,omitempty"`
+}
+type BlogPacketData_FetchPostPacket struct {
+	FetchPostPacket *FetchPostPacketData `protobuf:"bytes,7,opt,name=fetchPostPacket,proto3,oneof" json:"fetchPostPacket,omitempty"`
+}
+type BlogPacketData_SubscribeReplicationPacket struct {
+	SubscribeReplicationPacket *SubscribeReplicationPacketData `protobuf:"bytes,8,opt,name=subscribeReplicationPacket,proto3,oneof" json:"subscribeReplicationPacket,omitempty"`
+}
+type BlogPacketData_ReplicatePostPacket struct {
+	ReplicatePostPacket *ReplicatePostPacketData `protobuf:"bytes,9,opt,name=replicatePostPacket,proto3,oneof" json:"replicatePostPacket,omitempty"`
+}
 
-func (*BlogPacketData_NoData) isBlogPacketData_Packet()           {}
-func (*BlogPacketData_IbcPostPacket) isBlogPacketData_Packet()    {}
-func (*BlogPacketData_UpdatePostPacket) isBlogPacketData_Packet() {}
+func (*BlogPacketData_NoData) isBlogPacketData_Packet()                     {}
+func (*BlogPacketData_IbcPostPacket) isBlogPacketData_Packet()              {}
+func (*BlogPacketData_UpdatePostPacket) isBlogPacketData_Packet()           {}
+func (*BlogPacketData_DeletePostPacket) isBlogPacketData_Packet()           {}
+func (*BlogPacketData_BatchPostPacket) isBlogPacketData_Packet()            {}
+func (*BlogPacketData_PostChunkPacket) isBlogPacketData_Packet()            {}
+func (*BlogPacketData_FetchPostPacket) isBlogPacketData_Packet()            {}
+func (*BlogPacketData_SubscribeReplicationPacket) isBlogPacketData_Packet() {}
+func (*BlogPacketData_ReplicatePostPacket) isBlogPacketData_Packet()        {}
 
 func (m *BlogPacketData) GetPacket() isBlogPacketData_Packet {
 	if m != nil {
@@ -111,12 +197,60 @@ func (m *BlogPacketData) GetUpdatePostPacket() *UpdatePostPacketData {
 	return nil
 }
 
+func (m *BlogPacketData) GetDeletePostPacket() *DeletePostPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_DeletePostPacket); ok {
+		return x.DeletePostPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetBatchPostPacket() *BatchPostPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_BatchPostPacket); ok {
+		return x.BatchPostPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetPostChunkPacket() *PostChunkPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_PostChunkPacket); ok {
+		return x.PostChunkPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetFetchPostPacket() *FetchPostPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_FetchPostPacket); ok {
+		return x.FetchPostPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetSubscribeReplicationPacket() *SubscribeReplicationPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_SubscribeReplicationPacket); ok {
+		return x.SubscribeReplicationPacket
+	}
+	return nil
+}
+
+func (m *BlogPacketData) GetReplicatePostPacket() *ReplicatePostPacketData {
+	if x, ok := m.GetPacket().(*BlogPacketData_ReplicatePostPacket); ok {
+		return x.ReplicatePostPacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*BlogPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*BlogPacketData_NoData)(nil),
 		(*BlogPacketData_IbcPostPacket)(nil),
 		(*BlogPacketData_UpdatePostPacket)(nil),
+		(*BlogPacketData_DeletePostPacket)(nil),
+		(*BlogPacketData_BatchPostPacket)(nil),
+		(*BlogPacketData_PostChunkPacket)(nil),
+		(*BlogPacketData_FetchPostPacket)(nil),
+		(*BlogPacketData_SubscribeReplicationPacket)(nil),
+		(*BlogPacketData_ReplicatePostPacket)(nil),
 	}
 }
 
@@ -156,6 +290,136 @@ func (m *NoData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoData proto.InternalMessageInfo
 
+// BlogPacketV2 is the packet data of blog-2 channels: the BlogPacketData
+// encoded after a protocol header
+type BlogPacketV2 struct {
+	Header *PacketHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// data is the encoded BlogPacketData
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BlogPacketV2) Reset()         { *m = BlogPacketV2{} }
+func (m *BlogPacketV2) String() string { return proto.CompactTextString(m) }
+func (*BlogPacketV2) ProtoMessage()    {}
+func (*BlogPacketV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{2}
+}
+func (m *BlogPacketV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlogPacketV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlogPacketV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlogPacketV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlogPacketV2.Merge(m, src)
+}
+func (m *BlogPacketV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlogPacketV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlogPacketV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlogPacketV2 proto.InternalMessageInfo
+
+func (m *BlogPacketV2) GetHeader() *PacketHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlogPacketV2) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// PacketHeader is the protocol header of blog-2 packets
+type PacketHeader struct {
+	// chainID is the chain ID of the sending chain
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// revision is the revision of the post the packet is based on: the base
+	// revision of an edit, 0 otherwise
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// contentHash is the SHA-256 hash of the encoded packet data: the data bytes
+	// of blog-2 packets, the data JSON as written of blog-2-json packets
+	ContentHash []byte `protobuf:"bytes,3,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	// memo is an optional note of the sender
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *PacketHeader) Reset()         { *m = PacketHeader{} }
+func (m *PacketHeader) String() string { return proto.CompactTextString(m) }
+func (*PacketHeader) ProtoMessage()    {}
+func (*PacketHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{3}
+}
+func (m *PacketHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketHeader.Merge(m, src)
+}
+func (m *PacketHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketHeader proto.InternalMessageInfo
+
+func (m *PacketHeader) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *PacketHeader) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *PacketHeader) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *PacketHeader) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 // IbcPostPacketData defines a struct for the packet payload
 type IbcPostPacketData struct {
 	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -167,7 +431,7 @@ func (m *IbcPostPacketData) Reset()         { *m = IbcPostPacketData{} }
 func (m *IbcPostPacketData) String() string { return proto.CompactTextString(m) }
 func (*IbcPostPacketData) ProtoMessage()    {}
 func (*IbcPostPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d544876606a92415, []int{2}
+	return fileDescriptor_d544876606a92415, []int{4}
 }
 func (m *IbcPostPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +490,7 @@ func (m *IbcPostPacketAck) Reset()         { *m = IbcPostPacketAck{} }
 func (m *IbcPostPacketAck) String() string { return proto.CompactTextString(m) }
 func (*IbcPostPacketAck) ProtoMessage()    {}
 func (*IbcPostPacketAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d544876606a92415, []int{3}
+	return fileDescriptor_d544876606a92415, []int{5}
 }
 func (m *IbcPostPacketAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,13 +531,16 @@ type UpdatePostPacketData struct {
 	PostID  string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
 	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// baseRevision is the revision of the post the edit is based on
+	BaseRevision uint64 `protobuf:"varint,5,opt,name=baseRevision,proto3" json:"baseRevision,omitempty"`
 }
 
 func (m *UpdatePostPacketData) Reset()         { *m = UpdatePostPacketData{} }
 func (m *UpdatePostPacketData) String() string { return proto.CompactTextString(m) }
 func (*UpdatePostPacketData) ProtoMessage()    {}
 func (*UpdatePostPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d544876606a92415, []int{4}
+	return fileDescriptor_d544876606a92415, []int{6}
 }
 func (m *UpdatePostPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,16 +590,36 @@ func (m *UpdatePostPacketData) GetContent() string {
 	return ""
 }
 
+func (m *UpdatePostPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *UpdatePostPacketData) GetBaseRevision() uint64 {
+	if m != nil {
+		return m.BaseRevision
+	}
+	return 0
+}
+
 // UpdatePostPacketAck defines a struct for the packet acknowledgment
 type UpdatePostPacketAck struct {
 	IsSuccess bool `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
+	// conflict is set when the edit was based on a stale revision. Only chains
+	// predating ack error codes set it, the others write a conflict error ack.
+	Conflict bool `protobuf:"varint,2,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	// revision is the revision of the post after the edit, or its current
+	// revision on conflict
+	Revision uint64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
 func (m *UpdatePostPacketAck) Reset()         { *m = UpdatePostPacketAck{} }
 func (m *UpdatePostPacketAck) String() string { return proto.CompactTextString(m) }
 func (*UpdatePostPacketAck) ProtoMessage()    {}
 func (*UpdatePostPacketAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d544876606a92415, []int{5}
+	return fileDescriptor_d544876606a92415, []int{7}
 }
 func (m *UpdatePostPacketAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,453 +655,4145 @@ func (m *UpdatePostPacketAck) GetIsSuccess() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*BlogPacketData)(nil), "planet.blog.BlogPacketData")
-	proto.RegisterType((*NoData)(nil), "planet.blog.NoData")
-	proto.RegisterType((*IbcPostPacketData)(nil), "planet.blog.IbcPostPacketData")
-	proto.RegisterType((*IbcPostPacketAck)(nil), "planet.blog.IbcPostPacketAck")
-	proto.RegisterType((*UpdatePostPacketData)(nil), "planet.blog.UpdatePostPacketData")
-	proto.RegisterType((*UpdatePostPacketAck)(nil), "planet.blog.UpdatePostPacketAck")
+func (m *UpdatePostPacketAck) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("planet/blog/packet.proto", fileDescriptor_d544876606a92415) }
+func (m *UpdatePostPacketAck) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
 
-var fileDescriptor_d544876606a92415 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x4a, 0x02, 0x41,
-	0x14, 0x86, 0x77, 0x95, 0x36, 0x3d, 0x52, 0xd8, 0x28, 0xb1, 0x17, 0x31, 0xd4, 0x5e, 0x45, 0xe0,
-	0x0a, 0xf9, 0x04, 0x89, 0x44, 0xde, 0x94, 0x6c, 0x74, 0x13, 0x14, 0x8c, 0xd3, 0x20, 0xe2, 0xb2,
-	0x33, 0x38, 0x47, 0xa8, 0xb7, 0xe8, 0xb1, 0xba, 0xf4, 0xb2, 0xcb, 0x58, 0x5f, 0x24, 0x76, 0x66,
-	0x42, 0x57, 0xf3, 0x6e, 0xcf, 0xfe, 0xff, 0xff, 0xcd, 0x7f, 0xe0, 0x40, 0xa8, 0x52, 0x96, 0x09,
-	0xec, 0x8e, 0x53, 0x39, 0xe9, 0x2a, 0xc6, 0x67, 0x02, 0x63, 0x35, 0x97, 0x28, 0x49, 0xc3, 0x2a,
-	0x71, 0xa1, 0x44, 0xb9, 0x0f, 0xc7, 0xfd, 0x54, 0x4e, 0x46, 0xc6, 0x31, 0x60, 0xc8, 0x48, 0x07,
-	0x82, 0x4c, 0x16, 0x5f, 0xa1, 0x7f, 0xee, 0x5f, 0x36, 0xae, 0x5b, 0xf1, 0x46, 0x20, 0xbe, 0x37,
-	0xd2, 0x9d, 0x97, 0x38, 0x13, 0xb9, 0x85, 0xa3, 0xe9, 0x98, 0x8f, 0xa4, 0x46, 0xcb, 0x08, 0x2b,
-	0x26, 0x45, 0x4b, 0xa9, 0xe1, 0xa6, 0xc3, 0x01, 0xca, 0x31, 0xf2, 0x00, 0xcd, 0x85, 0x7a, 0x63,
-	0x28, 0x36, 0x50, 0x55, 0x83, 0xba, 0x28, 0xa1, 0x9e, 0xb6, 0x4c, 0x8e, 0xb6, 0x13, 0xee, 0xd7,
-	0x20, 0xb0, 0x7b, 0x47, 0x35, 0x08, 0x6c, 0xed, 0xe8, 0x05, 0x4e, 0x76, 0xaa, 0x90, 0x36, 0x1c,
-	0xe0, 0x14, 0x53, 0x61, 0xf6, 0xad, 0x27, 0x76, 0x20, 0x21, 0x1c, 0x72, 0x99, 0xa1, 0xc8, 0xec,
-	0x46, 0xf5, 0xe4, 0x6f, 0x34, 0xca, 0x5c, 0x30, 0x94, 0x73, 0x53, 0xb0, 0x50, 0xec, 0x18, 0x5d,
-	0x41, 0xb3, 0x84, 0xbf, 0xe1, 0x33, 0x72, 0x0a, 0x81, 0x92, 0x1a, 0x87, 0x03, 0x87, 0x77, 0x53,
-	0xf4, 0x0a, 0xed, 0xff, 0x56, 0xd9, 0xe7, 0x5f, 0xb7, 0xac, 0xec, 0x69, 0x59, 0x2d, 0xb5, 0x8c,
-	0x7a, 0xd0, 0xda, 0xe6, 0x17, 0x75, 0xce, 0xa0, 0x3e, 0xd5, 0x8f, 0x0b, 0xce, 0x85, 0xd6, 0xe6,
-	0x85, 0x5a, 0xb2, 0xfe, 0xd1, 0xef, 0x7c, 0xe5, 0xd4, 0x5f, 0xe6, 0xd4, 0xff, 0xc9, 0xa9, 0xff,
-	0xb9, 0xa2, 0xde, 0x72, 0x45, 0xbd, 0xef, 0x15, 0xf5, 0x9e, 0x5b, 0xee, 0x9e, 0xde, 0xed, 0x45,
-	0xe1, 0x87, 0x12, 0x7a, 0x1c, 0x98, 0x8b, 0xea, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x28, 0x61,
-	0xb8, 0xd0, 0x6d, 0x02, 0x00, 0x00,
+// DeletePostPacketData defines a struct for the packet payload
+type DeletePostPacketData struct {
+	PostID  string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *BlogPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeletePostPacketData) Reset()         { *m = DeletePostPacketData{} }
+func (m *DeletePostPacketData) String() string { return proto.CompactTextString(m) }
+func (*DeletePostPacketData) ProtoMessage()    {}
+func (*DeletePostPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{8}
+}
+func (m *DeletePostPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePostPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePostPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DeletePostPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePostPacketData.Merge(m, src)
+}
+func (m *DeletePostPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePostPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePostPacketData.DiscardUnknown(m)
 }
 
-func (m *BlogPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DeletePostPacketData proto.InternalMessageInfo
+
+func (m *DeletePostPacketData) GetPostID() string {
+	if m != nil {
+		return m.PostID
+	}
+	return ""
 }
 
-func (m *BlogPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Packet != nil {
-		{
-			size := m.Packet.Size()
-			i -= size
-			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *DeletePostPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BlogPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeletePostPacketAck defines a struct for the packet acknowledgment
+type DeletePostPacketAck struct {
+	// isSuccess confirms that the post was deleted
+	IsSuccess bool `protobuf:"varint,1,opt,name=isSuccess,proto3" json:"isSuccess,omitempty"`
 }
 
-func (m *BlogPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NoData != nil {
-		{
-			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
+func (m *DeletePostPacketAck) Reset()         { *m = DeletePostPacketAck{} }
+func (m *DeletePostPacketAck) String() string { return proto.CompactTextString(m) }
+func (*DeletePostPacketAck) ProtoMessage()    {}
+func (*DeletePostPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{9}
+}
+func (m *DeletePostPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePostPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePostPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *BlogPacketData_IbcPostPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeletePostPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePostPacketAck.Merge(m, src)
+}
+func (m *DeletePostPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePostPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePostPacketAck.DiscardUnknown(m)
 }
 
-func (m *BlogPacketData_IbcPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.IbcPostPacket != nil {
-		{
-			size, err := m.IbcPostPacket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_DeletePostPacketAck proto.InternalMessageInfo
+
+func (m *DeletePostPacketAck) GetIsSuccess() bool {
+	if m != nil {
+		return m.IsSuccess
 	}
-	return len(dAtA) - i, nil
+	return false
 }
-func (m *BlogPacketData_UpdatePostPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// BatchPostPacketData defines a struct for the payload of a packet carrying
+// several posts. Batches need a blog-2 channel.
+type BatchPostPacketData struct {
+	Posts []IbcPostPacketData `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+	// atomic stores the posts only if all of them are accepted. Otherwise each
+	// post is stored or fails on its own.
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
 }
 
-func (m *BlogPacketData_UpdatePostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdatePostPacket != nil {
-		{
-			size, err := m.UpdatePostPacket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPacket(dAtA, i, uint64(size))
+func (m *BatchPostPacketData) Reset()         { *m = BatchPostPacketData{} }
+func (m *BatchPostPacketData) String() string { return proto.CompactTextString(m) }
+func (*BatchPostPacketData) ProtoMessage()    {}
+func (*BatchPostPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{10}
+}
+func (m *BatchPostPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPostPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPostPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *NoData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BatchPostPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPostPacketData.Merge(m, src)
 }
-
-func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchPostPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPostPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPostPacketData.DiscardUnknown(m)
 }
 
-func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_BatchPostPacketData proto.InternalMessageInfo
+
+func (m *BatchPostPacketData) GetPosts() []IbcPostPacketData {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
 }
 
-func (m *IbcPostPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchPostPacketData) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *IbcPostPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchPostPacketAck defines a struct for the packet acknowledgment: the
+// result of each post of the batch, in order
+type BatchPostPacketAck struct {
+	Results []BatchPostResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *IbcPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *BatchPostPacketAck) Reset()         { *m = BatchPostPacketAck{} }
+func (m *BatchPostPacketAck) String() string { return proto.CompactTextString(m) }
+func (*BatchPostPacketAck) ProtoMessage()    {}
+func (*BatchPostPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{11}
+}
+func (m *BatchPostPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPostPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPostPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchPostPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPostPacketAck.Merge(m, src)
+}
+func (m *BatchPostPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPostPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPostPacketAck.DiscardUnknown(m)
 }
 
-func (m *IbcPostPacketAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchPostPacketAck proto.InternalMessageInfo
+
+func (m *BatchPostPacketAck) GetResults() []BatchPostResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IbcPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchPostResult is the result of a post of a batch. A post with neither a
+// post ID nor an error was accepted but not stored, because another post of
+// an atomic batch failed.
+type BatchPostResult struct {
+	// postID is the ID of the stored post
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// error is the error code of a post that failed
+	Error AckErrorCode `protobuf:"varint,2,opt,name=error,proto3,enum=planet.blog.AckErrorCode" json:"error,omitempty"`
 }
 
-func (m *IbcPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PostID) > 0 {
-		i -= len(m.PostID)
-		copy(dAtA[i:], m.PostID)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
-		i--
-		dAtA[i] = 0xa
+func (m *BatchPostResult) Reset()         { *m = BatchPostResult{} }
+func (m *BatchPostResult) String() string { return proto.CompactTextString(m) }
+func (*BatchPostResult) ProtoMessage()    {}
+func (*BatchPostResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{12}
+}
+func (m *BatchPostResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPostResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPostResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchPostResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPostResult.Merge(m, src)
+}
+func (m *BatchPostResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPostResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPostResult.DiscardUnknown(m)
 }
 
-func (m *UpdatePostPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchPostResult proto.InternalMessageInfo
+
+func (m *BatchPostResult) GetPostID() string {
+	if m != nil {
+		return m.PostID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdatePostPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchPostResult) GetError() AckErrorCode {
+	if m != nil {
+		return m.Error
+	}
+	return AckErrorUnspecified
 }
 
-func (m *UpdatePostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PostID) > 0 {
-		i -= len(m.PostID)
-		copy(dAtA[i:], m.PostID)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
-		i--
-		dAtA[i] = 0xa
+// PostChunkPacketData defines a struct for the payload of a packet carrying a
+// chunk of the content of a post sent in several packets. Chunked posts need a
+// blog-2 channel.
+type PostChunkPacketData struct {
+	// uploadID identifies the upload on the source channel
+	UploadID string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Creator  string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// contentHash is the SHA-256 hash of the whole content
+	ContentHash []byte `protobuf:"bytes,4,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	// index is the position of the chunk in the content, from 0
+	Index      uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	ChunkCount uint32 `protobuf:"varint,6,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	Chunk      []byte `protobuf:"bytes,7,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *PostChunkPacketData) Reset()         { *m = PostChunkPacketData{} }
+func (m *PostChunkPacketData) String() string { return proto.CompactTextString(m) }
+func (*PostChunkPacketData) ProtoMessage()    {}
+func (*PostChunkPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{13}
+}
+func (m *PostChunkPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostChunkPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostChunkPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PostChunkPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostChunkPacketData.Merge(m, src)
+}
+func (m *PostChunkPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostChunkPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostChunkPacketData.DiscardUnknown(m)
 }
 
-func (m *UpdatePostPacketAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PostChunkPacketData proto.InternalMessageInfo
+
+func (m *PostChunkPacketData) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdatePostPacketAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PostChunkPacketData) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *UpdatePostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsSuccess {
-		i--
-		if m.IsSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *PostChunkPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PostChunkPacketData) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *BlogPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PostChunkPacketData) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	var l int
-	_ = l
-	if m.Packet != nil {
-		n += m.Packet.Size()
+	return 0
+}
+
+func (m *PostChunkPacketData) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
 	}
-	return n
+	return 0
 }
 
-func (m *BlogPacketData_NoData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PostChunkPacketData) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	var l int
-	_ = l
-	if m.NoData != nil {
-		l = m.NoData.Size()
-		n += 1 + l + sovPacket(uint64(l))
+	return nil
+}
+
+// PostChunkPacketAck defines a struct for the packet acknowledgment
+type PostChunkPacketAck struct {
+	// receivedChunks is the number of chunks of the upload received so far
+	ReceivedChunks uint32 `protobuf:"varint,1,opt,name=receivedChunks,proto3" json:"receivedChunks,omitempty"`
+	// postID is the ID of the post, set by the chunk completing the upload
+	PostID string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
+}
+
+func (m *PostChunkPacketAck) Reset()         { *m = PostChunkPacketAck{} }
+func (m *PostChunkPacketAck) String() string { return proto.CompactTextString(m) }
+func (*PostChunkPacketAck) ProtoMessage()    {}
+func (*PostChunkPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{14}
+}
+func (m *PostChunkPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostChunkPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostChunkPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-func (m *BlogPacketData_IbcPostPacket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PostChunkPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostChunkPacketAck.Merge(m, src)
+}
+func (m *PostChunkPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostChunkPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostChunkPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostChunkPacketAck proto.InternalMessageInfo
+
+func (m *PostChunkPacketAck) GetReceivedChunks() uint32 {
+	if m != nil {
+		return m.ReceivedChunks
 	}
-	var l int
-	_ = l
-	if m.IbcPostPacket != nil {
-		l = m.IbcPostPacket.Size()
-		n += 1 + l + sovPacket(uint64(l))
+	return 0
+}
+
+func (m *PostChunkPacketAck) GetPostID() string {
+	if m != nil {
+		return m.PostID
 	}
-	return n
+	return ""
 }
-func (m *BlogPacketData_UpdatePostPacket) Size() (n int) {
-	if m == nil {
-		return 0
+
+// FetchPostPacketData defines a struct for the payload of a packet requesting a
+// post of the counterparty chain, which answers with the post in its
+// acknowledgement. Fetching posts needs a blog-2 channel.
+type FetchPostPacketData struct {
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+}
+
+func (m *FetchPostPacketData) Reset()         { *m = FetchPostPacketData{} }
+func (m *FetchPostPacketData) String() string { return proto.CompactTextString(m) }
+func (*FetchPostPacketData) ProtoMessage()    {}
+func (*FetchPostPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{15}
+}
+func (m *FetchPostPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchPostPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchPostPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.UpdatePostPacket != nil {
-		l = m.UpdatePostPacket.Size()
-		n += 1 + l + sovPacket(uint64(l))
+}
+func (m *FetchPostPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchPostPacketData.Merge(m, src)
+}
+func (m *FetchPostPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchPostPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchPostPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchPostPacketData proto.InternalMessageInfo
+
+func (m *FetchPostPacketData) GetPostID() string {
+	if m != nil {
+		return m.PostID
 	}
-	return n
+	return ""
 }
-func (m *NoData) Size() (n int) {
-	if m == nil {
-		return 0
+
+// FetchPostPacketAck defines a struct for the packet acknowledgment
+type FetchPostPacketAck struct {
+	Post Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	// readHeight is the height at which the counterparty read the post
+	ReadHeight int64 `protobuf:"varint,2,opt,name=readHeight,proto3" json:"readHeight,omitempty"`
+}
+
+func (m *FetchPostPacketAck) Reset()         { *m = FetchPostPacketAck{} }
+func (m *FetchPostPacketAck) String() string { return proto.CompactTextString(m) }
+func (*FetchPostPacketAck) ProtoMessage()    {}
+func (*FetchPostPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{16}
+}
+func (m *FetchPostPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchPostPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchPostPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *FetchPostPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchPostPacketAck.Merge(m, src)
+}
+func (m *FetchPostPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchPostPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchPostPacketAck.DiscardUnknown(m)
 }
 
-func (m *IbcPostPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_FetchPostPacketAck proto.InternalMessageInfo
+
+func (m *FetchPostPacketAck) GetPost() Post {
+	if m != nil {
+		return m.Post
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	return Post{}
+}
+
+func (m *FetchPostPacketAck) GetReadHeight() int64 {
+	if m != nil {
+		return m.ReadHeight
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	return 0
+}
+
+// SubscribeReplicationPacketData defines a struct for the payload of a packet
+// asking the counterparty chain to replicate its posts over the channel. The
+// counterparty answers with a snapshot of its posts, followed by their
+// changes. Replication needs a blog-2 channel.
+type SubscribeReplicationPacketData struct {
+	// resync restarts the replication of an existing subscription from a snapshot
+	Resync bool `protobuf:"varint,1,opt,name=resync,proto3" json:"resync,omitempty"`
+}
+
+func (m *SubscribeReplicationPacketData) Reset()         { *m = SubscribeReplicationPacketData{} }
+func (m *SubscribeReplicationPacketData) String() string { return proto.CompactTextString(m) }
+func (*SubscribeReplicationPacketData) ProtoMessage()    {}
+func (*SubscribeReplicationPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{17}
+}
+func (m *SubscribeReplicationPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeReplicationPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeReplicationPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+}
+func (m *SubscribeReplicationPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeReplicationPacketData.Merge(m, src)
+}
+func (m *SubscribeReplicationPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeReplicationPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeReplicationPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeReplicationPacketData proto.InternalMessageInfo
+
+func (m *SubscribeReplicationPacketData) GetResync() bool {
+	if m != nil {
+		return m.Resync
 	}
-	return n
+	return false
 }
 
-func (m *IbcPostPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
+// SubscribeReplicationPacketAck defines a struct for the packet acknowledgment
+type SubscribeReplicationPacketAck struct {
+	// resetSequence is the replication sequence of the reset starting the snapshot
+	ResetSequence uint64 `protobuf:"varint,1,opt,name=resetSequence,proto3" json:"resetSequence,omitempty"`
+}
+
+func (m *SubscribeReplicationPacketAck) Reset()         { *m = SubscribeReplicationPacketAck{} }
+func (m *SubscribeReplicationPacketAck) String() string { return proto.CompactTextString(m) }
+func (*SubscribeReplicationPacketAck) ProtoMessage()    {}
+func (*SubscribeReplicationPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{18}
+}
+func (m *SubscribeReplicationPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeReplicationPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeReplicationPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.PostID)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+}
+func (m *SubscribeReplicationPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeReplicationPacketAck.Merge(m, src)
+}
+func (m *SubscribeReplicationPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeReplicationPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeReplicationPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeReplicationPacketAck proto.InternalMessageInfo
+
+func (m *SubscribeReplicationPacketAck) GetResetSequence() uint64 {
+	if m != nil {
+		return m.ResetSequence
 	}
-	return n
+	return 0
 }
 
-func (m *UpdatePostPacketData) Size() (n int) {
-	if m == nil {
-		return 0
+// ReplicatePostPacketData defines a struct for the payload of a packet carrying
+// a change of a replicated post
+type ReplicatePostPacketData struct {
+	// sequence orders the changes sent on the channel, from 1
+	Sequence uint64        `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Op       ReplicationOp `protobuf:"varint,2,opt,name=op,proto3,enum=planet.blog.ReplicationOp" json:"op,omitempty"`
+	// post is the post set, or the removed post with only its ID
+	Post Post `protobuf:"bytes,3,opt,name=post,proto3" json:"post"`
+}
+
+func (m *ReplicatePostPacketData) Reset()         { *m = ReplicatePostPacketData{} }
+func (m *ReplicatePostPacketData) String() string { return proto.CompactTextString(m) }
+func (*ReplicatePostPacketData) ProtoMessage()    {}
+func (*ReplicatePostPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{19}
+}
+func (m *ReplicatePostPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicatePostPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicatePostPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.PostID)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+}
+func (m *ReplicatePostPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicatePostPacketData.Merge(m, src)
+}
+func (m *ReplicatePostPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicatePostPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicatePostPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicatePostPacketData proto.InternalMessageInfo
+
+func (m *ReplicatePostPacketData) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	return 0
+}
+
+func (m *ReplicatePostPacketData) GetOp() ReplicationOp {
+	if m != nil {
+		return m.Op
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	return ReplicationOpUnspecified
+}
+
+func (m *ReplicatePostPacketData) GetPost() Post {
+	if m != nil {
+		return m.Post
 	}
-	return n
+	return Post{}
 }
 
-func (m *UpdatePostPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
+// ReplicatePostPacketAck defines a struct for the packet acknowledgment
+type ReplicatePostPacketAck struct {
+	// applied is unset for a change the replica already had
+	Applied bool `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (m *ReplicatePostPacketAck) Reset()         { *m = ReplicatePostPacketAck{} }
+func (m *ReplicatePostPacketAck) String() string { return proto.CompactTextString(m) }
+func (*ReplicatePostPacketAck) ProtoMessage()    {}
+func (*ReplicatePostPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{20}
+}
+func (m *ReplicatePostPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicatePostPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicatePostPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.IsSuccess {
-		n += 2
+}
+func (m *ReplicatePostPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicatePostPacketAck.Merge(m, src)
+}
+func (m *ReplicatePostPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicatePostPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicatePostPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicatePostPacketAck proto.InternalMessageInfo
+
+func (m *ReplicatePostPacketAck) GetApplied() bool {
+	if m != nil {
+		return m.Applied
 	}
-	return n
+	return false
 }
 
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// PacketAckError is the error of a blog error acknowledgement, encoded in JSON
+type PacketAckError struct {
+	Code AckErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=planet.blog.AckErrorCode" json:"code,omitempty"`
+	// revision is the current revision of the post on conflict
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PacketAckError) Reset()         { *m = PacketAckError{} }
+func (m *PacketAckError) String() string { return proto.CompactTextString(m) }
+func (*PacketAckError) ProtoMessage()    {}
+func (*PacketAckError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d544876606a92415, []int{21}
+}
+func (m *PacketAckError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketAckError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketAckError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketAckError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketAckError.Merge(m, src)
+}
+func (m *PacketAckError) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketAckError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketAckError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketAckError proto.InternalMessageInfo
+
+func (m *PacketAckError) GetCode() AckErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return AckErrorUnspecified
+}
+
+func (m *PacketAckError) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("planet.blog.AckErrorCode", AckErrorCode_name, AckErrorCode_value)
+	proto.RegisterType((*BlogPacketData)(nil), "planet.blog.BlogPacketData")
+	proto.RegisterType((*NoData)(nil), "planet.blog.NoData")
+	proto.RegisterType((*BlogPacketV2)(nil), "planet.blog.BlogPacketV2")
+	proto.RegisterType((*PacketHeader)(nil), "planet.blog.PacketHeader")
+	proto.RegisterType((*IbcPostPacketData)(nil), "planet.blog.IbcPostPacketData")
+	proto.RegisterType((*IbcPostPacketAck)(nil), "planet.blog.IbcPostPacketAck")
+	proto.RegisterType((*UpdatePostPacketData)(nil), "planet.blog.UpdatePostPacketData")
+	proto.RegisterType((*UpdatePostPacketAck)(nil), "planet.blog.UpdatePostPacketAck")
+	proto.RegisterType((*DeletePostPacketData)(nil), "planet.blog.DeletePostPacketData")
+	proto.RegisterType((*DeletePostPacketAck)(nil), "planet.blog.DeletePostPacketAck")
+	proto.RegisterType((*BatchPostPacketData)(nil), "planet.blog.BatchPostPacketData")
+	proto.RegisterType((*BatchPostPacketAck)(nil), "planet.blog.BatchPostPacketAck")
+	proto.RegisterType((*BatchPostResult)(nil), "planet.blog.BatchPostResult")
+	proto.RegisterType((*PostChunkPacketData)(nil), "planet.blog.PostChunkPacketData")
+	proto.RegisterType((*PostChunkPacketAck)(nil), "planet.blog.PostChunkPacketAck")
+	proto.RegisterType((*FetchPostPacketData)(nil), "planet.blog.FetchPostPacketData")
+	proto.RegisterType((*FetchPostPacketAck)(nil), "planet.blog.FetchPostPacketAck")
+	proto.RegisterType((*SubscribeReplicationPacketData)(nil), "planet.blog.SubscribeReplicationPacketData")
+	proto.RegisterType((*SubscribeReplicationPacketAck)(nil), "planet.blog.SubscribeReplicationPacketAck")
+	proto.RegisterType((*ReplicatePostPacketData)(nil), "planet.blog.ReplicatePostPacketData")
+	proto.RegisterType((*ReplicatePostPacketAck)(nil), "planet.blog.ReplicatePostPacketAck")
+	proto.RegisterType((*PacketAckError)(nil), "planet.blog.PacketAckError")
+}
+
+func init() { proto.RegisterFile("planet/blog/packet.proto", fileDescriptor_d544876606a92415) }
+
+var fileDescriptor_d544876606a92415 = []byte{
+	// 1234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xd6, 0xbf, 0xed, 0x89, 0xec, 0x28, 0x2b, 0xc1, 0x51, 0xd9, 0x44, 0x55, 0x89, 0xa0, 0x28,
+	0x12, 0xd8, 0x46, 0x9c, 0x43, 0xd3, 0xa2, 0x28, 0x20, 0x8b, 0x74, 0x2c, 0x54, 0x95, 0xd4, 0xb5,
+	0x14, 0xb4, 0x29, 0x0a, 0x81, 0x22, 0x37, 0x16, 0x61, 0x99, 0xcb, 0x92, 0x54, 0x90, 0xe4, 0x09,
+	0x0a, 0x1f, 0x8a, 0x3e, 0x40, 0x7d, 0xea, 0xcb, 0xe4, 0xd6, 0x9c, 0x8a, 0x9e, 0x8a, 0x22, 0x06,
+	0xfa, 0x1c, 0xc5, 0x2e, 0x97, 0x32, 0x97, 0xa2, 0x9c, 0xde, 0x38, 0xbb, 0xf3, 0x7d, 0xf3, 0xed,
+	0xec, 0xcc, 0x2c, 0x08, 0x75, 0x77, 0x66, 0x38, 0x24, 0xd8, 0x9b, 0xcc, 0xe8, 0xc9, 0x9e, 0x6b,
+	0x98, 0xa7, 0x24, 0xd8, 0x75, 0x3d, 0x1a, 0x50, 0x74, 0x23, 0xdc, 0xd9, 0x65, 0x3b, 0x4a, 0xed,
+	0x84, 0x9e, 0x50, 0xbe, 0xbe, 0xc7, 0xbe, 0x42, 0x17, 0x65, 0x5b, 0x02, 0x53, 0x5f, 0x40, 0x95,
+	0xbb, 0xf1, 0x75, 0x8f, 0xb8, 0x33, 0xdb, 0x34, 0x02, 0x9b, 0x3a, 0xe1, 0xb6, 0xfa, 0x6f, 0x11,
+	0xb6, 0x0e, 0x66, 0xf4, 0x64, 0xc0, 0xc3, 0x69, 0x46, 0x60, 0xa0, 0x1d, 0x28, 0x39, 0x94, 0x7d,
+	0xd5, 0xb3, 0xcd, 0xec, 0xa7, 0x37, 0xf6, 0xab, 0xbb, 0xb1, 0xe8, 0xbb, 0x3d, 0xbe, 0x75, 0x94,
+	0xc1, 0xc2, 0x09, 0x1d, 0xc2, 0xa6, 0x3d, 0x31, 0x07, 0xd4, 0x0f, 0x42, 0x8e, 0x7a, 0x8e, 0xa3,
+	0x1a, 0x12, 0xaa, 0x13, 0xf7, 0x10, 0x04, 0x32, 0x0c, 0xf5, 0xa1, 0x32, 0x77, 0x2d, 0x23, 0x20,
+	0x31, 0xaa, 0x3c, 0xa7, 0xfa, 0x58, 0xa2, 0x1a, 0x25, 0x9c, 0x04, 0xdb, 0x12, 0x98, 0x11, 0x5a,
+	0x64, 0x46, 0x24, 0xc2, 0x42, 0x0a, 0xa1, 0x96, 0x70, 0x8a, 0x08, 0x93, 0x60, 0xd4, 0x85, 0x9b,
+	0x13, 0x23, 0x30, 0xa7, 0x31, 0xbe, 0x22, 0xe7, 0x6b, 0x4a, 0x7c, 0x07, 0xb2, 0x8f, 0xa0, 0x4b,
+	0x42, 0x19, 0x1b, 0xbb, 0xa6, 0xf6, 0x74, 0xee, 0x9c, 0x0a, 0xb6, 0x52, 0x0a, 0xdb, 0x40, 0xf6,
+	0x89, 0xd8, 0x12, 0x50, 0xc6, 0xf6, 0x9c, 0xc8, 0xda, 0xd6, 0x52, 0xd8, 0x0e, 0x49, 0xaa, 0xb6,
+	0x04, 0x14, 0x9d, 0x81, 0xe2, 0xcf, 0x27, 0xbe, 0xe9, 0xd9, 0x13, 0x82, 0xaf, 0x6a, 0x46, 0x10,
+	0xaf, 0x73, 0xe2, 0x07, 0x12, 0xf1, 0xf1, 0x4a, 0x77, 0x11, 0xe3, 0x1a, 0x42, 0xf4, 0x1d, 0x54,
+	0xa3, 0xca, 0x8c, 0x5f, 0xd6, 0x06, 0x8f, 0x73, 0x4f, 0x8a, 0x83, 0x97, 0xfd, 0x44, 0x80, 0x34,
+	0x8a, 0x83, 0x75, 0x28, 0x85, 0x8d, 0xa4, 0xae, 0x43, 0x29, 0x2c, 0x5d, 0x75, 0x04, 0xe5, 0xab,
+	0x8a, 0x7f, 0xba, 0x8f, 0x1e, 0x42, 0x69, 0x4a, 0x0c, 0x8b, 0x78, 0xa2, 0xde, 0x3f, 0x90, 0xf3,
+	0xcf, 0xdd, 0x8e, 0xb8, 0x03, 0x16, 0x8e, 0x08, 0x41, 0xc1, 0x62, 0x0d, 0xc2, 0x4a, 0xbd, 0x8c,
+	0xf9, 0xb7, 0xfa, 0x1a, 0xca, 0x71, 0x5f, 0x54, 0x87, 0x35, 0x73, 0x6a, 0xd8, 0x4e, 0x47, 0xe3,
+	0xbc, 0x1b, 0x38, 0x32, 0x91, 0x02, 0xeb, 0x1e, 0x79, 0x61, 0xfb, 0x36, 0x75, 0x38, 0x43, 0x01,
+	0x2f, 0x6c, 0xd4, 0x84, 0x1b, 0x26, 0x75, 0x02, 0xe2, 0x04, 0x47, 0x86, 0x3f, 0xe5, 0x0d, 0x50,
+	0xc6, 0xf1, 0x25, 0x16, 0xfb, 0x8c, 0x9c, 0x51, 0x5e, 0xca, 0x1b, 0x98, 0x7f, 0xab, 0x3f, 0xc2,
+	0xad, 0xa5, 0x0e, 0x43, 0x35, 0x28, 0x06, 0x76, 0x30, 0x23, 0x22, 0x7c, 0x68, 0x70, 0x59, 0x21,
+	0x5b, 0x3d, 0x27, 0x64, 0x85, 0x26, 0xdf, 0xf1, 0x88, 0x11, 0x50, 0xaf, 0x9e, 0x17, 0x3b, 0xa1,
+	0xa9, 0xde, 0x87, 0x8a, 0x44, 0xdf, 0x32, 0x4f, 0xd1, 0x36, 0x94, 0x58, 0x0d, 0x2e, 0x4e, 0x27,
+	0x2c, 0xf5, 0xb7, 0x2c, 0xd4, 0xd2, 0x5a, 0x74, 0x15, 0xe0, 0x4a, 0x66, 0x6e, 0x85, 0xcc, 0xfc,
+	0x4a, 0x99, 0x05, 0x49, 0x26, 0x52, 0xa1, 0x3c, 0x31, 0x7c, 0x82, 0xa3, 0xdc, 0x16, 0x79, 0x6e,
+	0xa5, 0x35, 0xf5, 0x14, 0xaa, 0x49, 0x75, 0xec, 0x34, 0x77, 0x60, 0xc3, 0xf6, 0x8f, 0xe7, 0xa6,
+	0x49, 0x7c, 0x9f, 0xeb, 0x5b, 0xc7, 0x57, 0x0b, 0xec, 0xc2, 0x4c, 0xea, 0x3c, 0x9f, 0xd9, 0x66,
+	0x98, 0xb4, 0x75, 0xbc, 0xb0, 0xa5, 0xcb, 0xcc, 0xcb, 0x97, 0xa9, 0x1e, 0x41, 0x2d, 0x6d, 0xb8,
+	0xac, 0x4c, 0x45, 0xec, 0x68, 0x39, 0xf9, 0x06, 0x1e, 0x41, 0x35, 0xc9, 0xf4, 0x5e, 0xd9, 0xaa,
+	0x0d, 0xd5, 0x94, 0x59, 0x84, 0xbe, 0x80, 0x22, 0x8b, 0xc7, 0x00, 0xf9, 0xf7, 0x0f, 0xea, 0x83,
+	0xc2, 0x9b, 0xbf, 0x3f, 0xca, 0xe0, 0x10, 0xc2, 0x94, 0x1b, 0x01, 0x3d, 0xb3, 0x4d, 0x91, 0x07,
+	0x61, 0xa9, 0x18, 0x50, 0x22, 0x14, 0x93, 0xf7, 0x25, 0xac, 0x79, 0xc4, 0x9f, 0xcf, 0x16, 0xb1,
+	0xee, 0xa4, 0x0f, 0x4a, 0xcc, 0x9d, 0x44, 0xa4, 0x08, 0xa2, 0x3e, 0x83, 0x9b, 0x09, 0x8f, 0x95,
+	0x89, 0xdb, 0x83, 0x22, 0xf1, 0x3c, 0x91, 0xb6, 0xad, 0x44, 0x07, 0xb7, 0xcc, 0x53, 0x9d, 0x6d,
+	0xb6, 0xa9, 0x45, 0x70, 0xe8, 0xa7, 0xfe, 0x91, 0x85, 0x6a, 0xca, 0x64, 0x65, 0xb7, 0x39, 0x77,
+	0x67, 0xd4, 0xb0, 0x16, 0x21, 0x16, 0xf6, 0x35, 0x85, 0x9a, 0xda, 0x35, 0xc9, 0x56, 0x2e, 0x2c,
+	0xb7, 0x72, 0x0d, 0x8a, 0xb6, 0x63, 0x91, 0x97, 0xbc, 0x52, 0x37, 0x71, 0x68, 0xa0, 0x06, 0x80,
+	0xc9, 0x64, 0xb5, 0xe9, 0xdc, 0x09, 0xdf, 0x84, 0x4d, 0x1c, 0x5b, 0x61, 0x28, 0x6e, 0xf1, 0x01,
+	0x5f, 0xc6, 0xa1, 0xa1, 0x0e, 0x01, 0x25, 0x0e, 0xc4, 0x6e, 0xe0, 0x13, 0xd8, 0xf2, 0x88, 0x49,
+	0xec, 0x17, 0xc4, 0xe2, 0x3b, 0x61, 0x95, 0x6c, 0xe2, 0xc4, 0x6a, 0x2c, 0xb1, 0x39, 0xa9, 0x9b,
+	0x77, 0xa0, 0x9a, 0xf2, 0x64, 0xac, 0x6c, 0x7e, 0x03, 0x50, 0xc2, 0x9d, 0x89, 0x78, 0x00, 0x05,
+	0xb6, 0x2f, 0xc6, 0xeb, 0xad, 0xa5, 0xe7, 0x4d, 0x5c, 0x3c, 0x77, 0x62, 0xa7, 0xf7, 0x88, 0x61,
+	0x1d, 0x11, 0xfb, 0x64, 0x1a, 0x76, 0x5b, 0x1e, 0xc7, 0x56, 0xd4, 0xc7, 0xd0, 0xb8, 0xfe, 0xad,
+	0x61, 0xe2, 0x3c, 0xe2, 0xbf, 0x72, 0x4c, 0xd1, 0x11, 0xc2, 0x52, 0x75, 0xb8, 0xbb, 0x1a, 0xc9,
+	0x74, 0xde, 0x83, 0x4d, 0x8f, 0xf8, 0x24, 0x38, 0x26, 0x3f, 0xcd, 0x89, 0x63, 0x86, 0x83, 0xb3,
+	0x80, 0xe5, 0x45, 0xf5, 0x97, 0x2c, 0xdc, 0x5e, 0xf1, 0x0a, 0xb1, 0xf2, 0xf1, 0x65, 0xf0, 0xc2,
+	0x46, 0xf7, 0x21, 0x47, 0x5d, 0x51, 0xa0, 0x4a, 0xea, 0x9b, 0x66, 0x53, 0xa7, 0xef, 0xe2, 0x1c,
+	0x75, 0x17, 0x19, 0xcb, 0xff, 0x8f, 0x8c, 0xa9, 0xfb, 0xb0, 0x9d, 0xa2, 0x87, 0x1d, 0xa8, 0x0e,
+	0x6b, 0x86, 0xeb, 0xce, 0x6c, 0x62, 0x89, 0x54, 0x44, 0xa6, 0xfa, 0x03, 0x6c, 0x2d, 0xdc, 0x78,
+	0x73, 0xa0, 0x1d, 0x28, 0x98, 0xd4, 0x0a, 0x65, 0x5f, 0xdb, 0x41, 0xdc, 0xed, 0xba, 0x37, 0xec,
+	0xfe, 0x9f, 0x79, 0x28, 0xc7, 0x21, 0xe8, 0x33, 0x50, 0x5a, 0xed, 0xaf, 0xc7, 0x3a, 0xc6, 0x7d,
+	0x3c, 0x6e, 0xf7, 0x35, 0x7d, 0x3c, 0xea, 0x1d, 0x0f, 0xf4, 0x76, 0xe7, 0xb0, 0xa3, 0x6b, 0x95,
+	0x8c, 0x72, 0xfb, 0xfc, 0xa2, 0x59, 0x8d, 0x10, 0x23, 0xc7, 0x77, 0x89, 0x69, 0x3f, 0xb7, 0x89,
+	0x85, 0xf6, 0xa1, 0x9e, 0x00, 0xf6, 0xfa, 0xc3, 0xf1, 0x61, 0x7f, 0xd4, 0xd3, 0x2a, 0x59, 0xa5,
+	0x76, 0x7e, 0xd1, 0xac, 0x44, 0xb0, 0x1e, 0x0d, 0x0e, 0xe9, 0xdc, 0xb1, 0xd0, 0xe7, 0xf0, 0xe1,
+	0x52, 0xb0, 0xd6, 0x68, 0x78, 0xd4, 0xc7, 0x9d, 0x67, 0xba, 0x56, 0xc9, 0x29, 0xf5, 0xf3, 0x8b,
+	0x66, 0xed, 0x2a, 0x9a, 0x31, 0x0f, 0xa6, 0xd4, 0xb3, 0x5f, 0x13, 0x0b, 0x7d, 0x05, 0x8d, 0x04,
+	0xb4, 0xd3, 0x7b, 0xda, 0xea, 0x76, 0xb4, 0xf1, 0xa0, 0xf5, 0x7d, 0xb7, 0xdf, 0xd2, 0x2a, 0x79,
+	0x45, 0x39, 0xbf, 0x68, 0x6e, 0x47, 0xe8, 0x8e, 0xf3, 0xc2, 0x98, 0xd9, 0xd6, 0xc0, 0x78, 0xc5,
+	0x66, 0x04, 0x7a, 0x08, 0xb7, 0x13, 0xf8, 0x76, 0xbf, 0x77, 0xd8, 0xed, 0xb4, 0x87, 0x95, 0x82,
+	0xac, 0xb6, 0x1d, 0x3d, 0x1f, 0xcb, 0x10, 0xad, 0x73, 0xdc, 0x3a, 0xe8, 0xea, 0x5a, 0xa5, 0x28,
+	0x43, 0x34, 0xdb, 0x37, 0x26, 0x33, 0x62, 0xa1, 0xc7, 0x4b, 0x07, 0xc4, 0xad, 0xa1, 0x3e, 0xee,
+	0x76, 0xbe, 0xe9, 0x0c, 0x75, 0xad, 0x52, 0x92, 0xd3, 0x89, 0x8d, 0x80, 0x74, 0xed, 0x33, 0x3b,
+	0x48, 0x45, 0x1e, 0xeb, 0xdf, 0x8e, 0xf4, 0x5e, 0x5b, 0x1f, 0x3f, 0x69, 0x0d, 0x2a, 0x6b, 0x32,
+	0x32, 0xaa, 0xf8, 0x27, 0x86, 0xab, 0x14, 0x7e, 0xfe, 0xbd, 0x91, 0x39, 0xd8, 0x79, 0xf3, 0xae,
+	0x91, 0x7d, 0xfb, 0xae, 0x91, 0xfd, 0xe7, 0x5d, 0x23, 0xfb, 0xeb, 0x65, 0x23, 0xf3, 0xf6, 0xb2,
+	0x91, 0xf9, 0xeb, 0xb2, 0x91, 0x79, 0x56, 0x15, 0x7f, 0x19, 0x2f, 0xc3, 0xff, 0x8c, 0xe0, 0x95,
+	0x4b, 0xfc, 0x49, 0x89, 0xff, 0x62, 0x3c, 0xfa, 0x6f, 0x00, 0x4b, 0x9a, 0x86, 0xc4, 0xd8, 0x0c,
+	0x00, 0x00,
+}
+
+func (m *BlogPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlogPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlogPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_IbcPostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_IbcPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcPostPacket != nil {
+		{
+			size, err := m.IbcPostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_UpdatePostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_UpdatePostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePostPacket != nil {
+		{
+			size, err := m.UpdatePostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_DeletePostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_DeletePostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeletePostPacket != nil {
+		{
+			size, err := m.DeletePostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_BatchPostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_BatchPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BatchPostPacket != nil {
+		{
+			size, err := m.BatchPostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_PostChunkPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_PostChunkPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PostChunkPacket != nil {
+		{
+			size, err := m.PostChunkPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_FetchPostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_FetchPostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FetchPostPacket != nil {
+		{
+			size, err := m.FetchPostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_SubscribeReplicationPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_SubscribeReplicationPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SubscribeReplicationPacket != nil {
+		{
+			size, err := m.SubscribeReplicationPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlogPacketData_ReplicatePostPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketData_ReplicatePostPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReplicatePostPacket != nil {
+		{
+			size, err := m.ReplicatePostPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BlogPacketV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlogPacketV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlogPacketV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcPostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcPostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcPostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseRevision != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.BaseRevision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Conflict {
+		i--
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsSuccess {
+		i--
+		if m.IsSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsSuccess {
+		i--
+		if m.IsSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPostResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPostResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPostResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Error))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostChunkPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostChunkPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostChunkPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Index != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostChunkPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostChunkPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostChunkPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReceivedChunks != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReceivedChunks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchPostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchPostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchPostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchPostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchPostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchPostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReadHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeReplicationPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeReplicationPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeReplicationPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resync {
+		i--
+		if m.Resync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeReplicationPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeReplicationPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeReplicationPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetSequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ResetSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicatePostPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicatePostPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicatePostPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Op != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicatePostPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicatePostPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicatePostPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketAckError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketAckError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketAckError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Code != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlogPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *BlogPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_IbcPostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcPostPacket != nil {
+		l = m.IbcPostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_UpdatePostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePostPacket != nil {
+		l = m.UpdatePostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_DeletePostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeletePostPacket != nil {
+		l = m.DeletePostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_BatchPostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchPostPacket != nil {
+		l = m.BatchPostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_PostChunkPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostChunkPacket != nil {
+		l = m.PostChunkPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_FetchPostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FetchPostPacket != nil {
+		l = m.FetchPostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_SubscribeReplicationPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscribeReplicationPacket != nil {
+		l = m.SubscribeReplicationPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BlogPacketData_ReplicatePostPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicatePostPacket != nil {
+		l = m.ReplicatePostPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlogPacketV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPacket(uint64(m.Revision))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcPostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcPostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdatePostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.BaseRevision != 0 {
+		n += 1 + sovPacket(uint64(m.BaseRevision))
+	}
+	return n
+}
+
+func (m *UpdatePostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsSuccess {
+		n += 2
+	}
+	if m.Conflict {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPacket(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *DeletePostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *DeletePostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsSuccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchPostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchPostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchPostResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Error != 0 {
+		n += 1 + sovPacket(uint64(m.Error))
+	}
+	return n
+}
+
+func (m *PostChunkPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPacket(uint64(m.Index))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovPacket(uint64(m.ChunkCount))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PostChunkPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceivedChunks != 0 {
+		n += 1 + sovPacket(uint64(m.ReceivedChunks))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *FetchPostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *FetchPostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.ReadHeight != 0 {
+		n += 1 + sovPacket(uint64(m.ReadHeight))
+	}
+	return n
+}
+
+func (m *SubscribeReplicationPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resync {
+		n += 2
+	}
+	return n
+}
+
+func (m *SubscribeReplicationPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetSequence != 0 {
+		n += 1 + sovPacket(uint64(m.ResetSequence))
+	}
+	return n
+}
+
+func (m *ReplicatePostPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovPacket(uint64(m.Sequence))
+	}
+	if m.Op != 0 {
+		n += 1 + sovPacket(uint64(m.Op))
+	}
+	l = m.Post.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func (m *ReplicatePostPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
+func (m *PacketAckError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPacket(uint64(m.Revision))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlogPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlogPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IbcPostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_IbcPostPacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdatePostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_UpdatePostPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeletePostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_DeletePostPacket{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchPostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BatchPostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_BatchPostPacket{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostChunkPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PostChunkPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_PostChunkPacket{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchPostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FetchPostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_FetchPostPacket{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscribeReplicationPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SubscribeReplicationPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_SubscribeReplicationPacket{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatePostPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReplicatePostPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &BlogPacketData_ReplicatePostPacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlogPacketV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlogPacketV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlogPacketV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &PacketHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcPostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcPostPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRevision", wireType)
+			}
+			m.BaseRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePostPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePostPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSuccess = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePostPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePostPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSuccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchPostPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchPostPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, IbcPostPacketData{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchPostPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchPostPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchPostResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchPostResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchPostResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchPostResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= AckErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
+func (m *PostChunkPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,17 +4816,17 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlogPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostChunkPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlogPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostChunkPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -857,32 +4836,29 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NoData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Packet = &BlogPacketData_NoData{v}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcPostPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -892,32 +4868,29 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IbcPostPacketData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Packet = &BlogPacketData_IbcPostPacket{v}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePostPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -927,26 +4900,230 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UpdatePostPacketData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Packet = &BlogPacketData_UpdatePostPacket{v}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostChunkPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostChunkPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostChunkPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedChunks", wireType)
+			}
+			m.ReceivedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -969,7 +5146,7 @@ func (m *BlogPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoData) Unmarshal(dAtA []byte) error {
+func (m *FetchPostPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,12 +5169,44 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchPostPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1019,7 +5228,7 @@ func (m *NoData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
+func (m *FetchPostPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,17 +5251,17 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IbcPostPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchPostPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IbcPostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1062,29 +5271,30 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadHeight", wireType)
 			}
-			var stringLen uint64
+			m.ReadHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1094,29 +5304,66 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPacket
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeReplicationPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeReplicationPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeReplicationPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resync", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1126,24 +5373,12 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Resync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1165,7 +5400,7 @@ func (m *IbcPostPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
+func (m *SubscribeReplicationPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1188,17 +5423,17 @@ func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IbcPostPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeReplicationPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IbcPostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeReplicationPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetSequence", wireType)
 			}
-			var stringLen uint64
+			m.ResetSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1208,24 +5443,11 @@ func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ResetSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1247,7 +5469,7 @@ func (m *IbcPostPacketAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
+func (m *ReplicatePostPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,17 +5492,17 @@ func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePostPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicatePostPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicatePostPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1290,29 +5512,16 @@ func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var stringLen uint64
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1322,29 +5531,16 @@ func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Op |= ReplicationOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -1354,23 +5550,24 @@ func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1393,7 +5590,7 @@ func (m *UpdatePostPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePostPacketAck) Unmarshal(dAtA []byte) error {
+func (m *ReplicatePostPacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1416,15 +5613,15 @@ func (m *UpdatePostPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePostPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicatePostPacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicatePostPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSuccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1441,7 +5638,95 @@ func (m *UpdatePostPacketAck) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsSuccess = bool(v != 0)
+			m.Applied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketAckError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketAckError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketAckError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= AckErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
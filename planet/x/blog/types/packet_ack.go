@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// ackErrorCodes maps the errors of packet reception to the code written in
+// their error acknowledgement
+var ackErrorCodes = []struct {
+	code AckErrorCode
+	errs []error
+}{
+	{AckErrorNotFound, []error{ErrPostNotFound}},
+	{AckErrorUnauthorized, []error{ErrUnauthorized}},
+	{AckErrorConflict, []error{ErrRevisionConflict}},
+	{AckErrorDisabled, []error{ErrInboundDisabled, ErrChannelNotAllowed, ErrReplicationNotFound}},
+	{AckErrorRateLimited, []error{ErrRateLimited}},
+	{AckErrorSequenceGap, []error{ErrSequenceGap}},
+	{AckErrorInvalidPayload, []error{
+		ErrTitleTooLong,
+		ErrContentTooLong,
+		ErrEmptyTitle,
+		ErrInvalidUTF8,
+		ErrControlCharacter,
+		ErrInvalidCreator,
+		ErrInvalidPostID,
+		ErrInvalidPacketHeader,
+		ErrMemoTooLong,
+		ErrBatchTooLarge,
+		ErrEmptyBatch,
+		ErrInvalidChunk,
+		ErrContentHashMismatch,
+		ErrInvalidReplication,
+		ErrInvalidChainID,
+		sdkerrors.ErrInvalidType,
+		sdkerrors.ErrUnknownRequest,
+	}},
+}
+
+// AckErrorCodeOf returns the acknowledgement error code of an error returned
+// while receiving a packet
+func AckErrorCodeOf(err error) AckErrorCode {
+	for _, c := range ackErrorCodes {
+		for _, target := range c.errs {
+			if errors.Is(err, target) {
+				return c.code
+			}
+		}
+	}
+	return AckErrorUnspecified
+}
+
+// NewPacketAckError returns the acknowledgement error of an error returned
+// while receiving a packet
+func NewPacketAckError(err error) PacketAckError {
+	return PacketAckError{Code: AckErrorCodeOf(err)}
+}
+
+// Acknowledgement returns the error acknowledgement of the error. Only the
+// code and revision are written, never the error message, so that every
+// validator writes the same acknowledgement.
+func (e PacketAckError) Acknowledgement() channeltypes.Acknowledgement {
+	bz := sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&e))
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{Error: string(bz)},
+	}
+}
+
+// ParsePacketAckError decodes the error of an error acknowledgement. Errors
+// not written by the blog module decode to an unspecified code.
+func ParsePacketAckError(ackError string) PacketAckError {
+	var e PacketAckError
+	if err := ModuleCdc.UnmarshalJSON([]byte(ackError), &e); err != nil {
+		return PacketAckError{}
+	}
+	return e
+}
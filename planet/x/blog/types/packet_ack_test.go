@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAckErrorCodeOf(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code AckErrorCode
+	}{
+		{err: sdkerrors.Wrapf(ErrPostNotFound, "post %d", 1), code: AckErrorNotFound},
+		{err: sdkerrors.Wrap(ErrUnauthorized, "post 1"), code: AckErrorUnauthorized},
+		{err: ErrContentTooLong, code: AckErrorInvalidPayload},
+		{err: ErrInvalidCreator, code: AckErrorInvalidPayload},
+		{err: sdkerrors.ErrUnknownRequest, code: AckErrorInvalidPayload},
+		{err: ErrRevisionConflict, code: AckErrorConflict},
+		{err: ErrInboundDisabled, code: AckErrorDisabled},
+		{err: ErrChannelNotAllowed, code: AckErrorDisabled},
+		{err: ErrRateLimited, code: AckErrorRateLimited},
+		{err: sdkerrors.Wrapf(ErrSequenceGap, "expected %d, got %d", 2, 3), code: AckErrorSequenceGap},
+		{err: ErrReplicationNotFound, code: AckErrorDisabled},
+		{err: ErrInvalidReplication, code: AckErrorInvalidPayload},
+		{err: ErrInvalidChainID, code: AckErrorInvalidPayload},
+		{err: errors.New("store failure"), code: AckErrorUnspecified},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			require.Equal(t, tc.code, AckErrorCodeOf(tc.err))
+		})
+	}
+}
+
+func TestPacketAckError(t *testing.T) {
+	ack := PacketAckError{Code: AckErrorConflict, Revision: 3}.Acknowledgement()
+	require.False(t, ack.Success())
+	ackError := ack.GetError()
+	require.Equal(t, `{"code":"ACK_ERROR_CODE_CONFLICT","revision":"3"}`, ackError)
+	require.Equal(t, PacketAckError{Code: AckErrorConflict, Revision: 3}, ParsePacketAckError(ackError))
+
+	// The error message never reaches the acknowledgement
+	ack = NewPacketAckError(sdkerrors.Wrap(ErrUnauthorized, "post 1 of cosmos1xyz")).Acknowledgement()
+	require.Equal(t, `{"code":"ACK_ERROR_CODE_UNAUTHORIZED","revision":"0"}`, ack.GetError())
+
+	// Errors written by other modules have no code
+	ack = channeltypes.NewErrorAcknowledgement(ErrUnauthorized)
+	require.Equal(t, AckErrorUnspecified, ParsePacketAckError(ack.GetError()).Code)
+	require.Equal(t, AckErrorUnspecified, ParsePacketAckError("").Code)
+}
@@ -0,0 +1,20 @@
+package types
+
+// ValidateBasic is used for validating the packet. The posts are validated one
+// by one when the packet is received, so that a batch applied best-effort
+// stores the valid posts.
+func (p BatchPostPacketData) ValidateBasic() error {
+	if len(p.Posts) == 0 {
+		return ErrEmptyBatch
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p BatchPostPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_BatchPostPacket{&p}
+
+	return modulePacket.Marshal()
+}
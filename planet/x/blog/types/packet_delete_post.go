@@ -0,0 +1,18 @@
+package types
+
+// ValidateBasic is used for validating the packet
+func (p DeletePostPacketData) ValidateBasic() error {
+	if err := ValidatePostID(p.PostID); err != nil {
+		return err
+	}
+	return ValidatePostCreator(p.Creator)
+}
+
+// GetBytes is a helper for serialising
+func (p DeletePostPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_DeletePostPacket{&p}
+
+	return modulePacket.Marshal()
+}
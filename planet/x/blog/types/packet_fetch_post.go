@@ -0,0 +1,15 @@
+package types
+
+// ValidateBasic is used for validating the packet
+func (p FetchPostPacketData) ValidateBasic() error {
+	return ValidatePostID(p.PostID)
+}
+
+// GetBytes is a helper for serialising
+func (p FetchPostPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_FetchPostPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packetJSON is a blog-2 packet encoded in JSON. The data is kept as written by
+// the sender since the content hash of the header is computed from its bytes.
+type packetJSON struct {
+	Data   json.RawMessage `json:"data"`
+	Header json.RawMessage `json:"header,omitempty"`
+}
+
+// marshalPacketDataJSON encodes the packet data in canonical JSON: the proto
+// JSON mapping with the field names of the proto files, every field and
+// sorted keys, like the acknowledgements
+func marshalPacketDataJSON(data BlogPacketData) ([]byte, error) {
+	bz, err := ModuleCdc.MarshalJSON(&data)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// marshalPacketJSON encodes the header and the encoded packet data in JSON
+func marshalPacketJSON(header PacketHeader, dataBytes []byte) ([]byte, error) {
+	headerBytes, err := ModuleCdc.MarshalJSON(&header)
+	if err != nil {
+		return nil, err
+	}
+	headerBytes, err = sdk.SortJSON(headerBytes)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(packetJSON{
+		Data:   dataBytes,
+		Header: headerBytes,
+	})
+}
+
+// unmarshalPacketJSON decodes a packet encoded in JSON into its header, nil
+// if missing, and its encoded packet data
+func unmarshalPacketJSON(bz []byte) (*PacketHeader, []byte, error) {
+	var packet packetJSON
+	if err := json.Unmarshal(bz, &packet); err != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet: %s", err.Error())
+	}
+	if len(packet.Header) == 0 || string(packet.Header) == "null" {
+		return nil, packet.Data, nil
+	}
+	var header PacketHeader
+	if err := ModuleCdc.UnmarshalJSON(packet.Header, &header); err != nil {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidPacketHeader, "cannot unmarshal header: %s", err.Error())
+	}
+	return &header, packet.Data, nil
+}
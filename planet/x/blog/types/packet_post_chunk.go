@@ -0,0 +1,86 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxUploadChunks is the maximum number of chunks of a post sent in several packets
+const MaxUploadChunks = 1024
+
+// MaxUploadIDLength is the maximum length in bytes of an upload ID
+const MaxUploadIDLength = 64
+
+// ContentHash returns the hash of a post content carried by the chunks of an upload
+func ContentHash(content []byte) []byte {
+	hash := sha256.Sum256(content)
+	return hash[:]
+}
+
+// SplitContent splits a post content in chunks of at most chunkSize bytes. An
+// empty content is a single empty chunk. Chunks may split a UTF-8 character,
+// the content is validated once put back together.
+func SplitContent(content string, chunkSize uint64) [][]byte {
+	if len(content) == 0 {
+		return [][]byte{{}}
+	}
+	var chunks [][]byte
+	for bz := []byte(content); len(bz) > 0; {
+		n := len(bz)
+		if uint64(n) > chunkSize {
+			n = int(chunkSize)
+		}
+		chunks = append(chunks, bz[:n])
+		bz = bz[n:]
+	}
+	return chunks
+}
+
+// ValidateUploadID checks that an upload ID is non-empty, short and free of
+// control characters
+func ValidateUploadID(uploadID string) error {
+	if uploadID == "" {
+		return sdkerrors.Wrap(ErrInvalidChunk, "empty upload ID")
+	}
+	if len(uploadID) > MaxUploadIDLength {
+		return sdkerrors.Wrapf(ErrInvalidChunk, "upload ID of %d bytes, max %d", len(uploadID), MaxUploadIDLength)
+	}
+	return validateText("upload ID", uploadID, false)
+}
+
+// ValidateBasic is used for validating the packet. The content is validated
+// once all the chunks have arrived.
+func (p PostChunkPacketData) ValidateBasic() error {
+	if err := ValidateUploadID(p.UploadID); err != nil {
+		return err
+	}
+	if err := ValidatePostTitle(p.Title); err != nil {
+		return err
+	}
+	if err := ValidatePostCreator(p.Creator); err != nil {
+		return err
+	}
+	if len(p.ContentHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidChunk, "content hash of %d bytes, want %d", len(p.ContentHash), sha256.Size)
+	}
+	if p.ChunkCount == 0 || p.ChunkCount > MaxUploadChunks {
+		return sdkerrors.Wrapf(ErrInvalidChunk, "%d chunks, max %d", p.ChunkCount, MaxUploadChunks)
+	}
+	if p.Index >= p.ChunkCount {
+		return sdkerrors.Wrapf(ErrInvalidChunk, "chunk %d of %d", p.Index, p.ChunkCount)
+	}
+	if len(p.Chunk) == 0 && p.ChunkCount > 1 {
+		return sdkerrors.Wrapf(ErrInvalidChunk, "chunk %d is empty", p.Index)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p PostChunkPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_PostChunkPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p SubscribeReplicationPacketData) ValidateBasic() error {
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p SubscribeReplicationPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_SubscribeReplicationPacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// ValidateBasic is used for validating the packet
+func (p ReplicatePostPacketData) ValidateBasic() error {
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidReplication, "sequence starts at 1")
+	}
+	switch p.Op {
+	case ReplicationOpReset, ReplicationOpRemove:
+		return nil
+	case ReplicationOpSet:
+		// A set carries the whole post, 0 being the ID of the first post
+		if err := ValidateRemotePost(p.Post); err != nil {
+			return sdkerrors.Wrapf(err, "post %d", p.Post.Id)
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidReplication, "unknown operation %s", p.Op)
+	}
+}
+
+// GetBytes is a helper for serialising
+func (p ReplicatePostPacketData) GetBytes() ([]byte, error) {
+	var modulePacket BlogPacketData
+
+	modulePacket.Packet = &BlogPacketData_ReplicatePostPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,243 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+	"planet/testutil/sample"
+)
+
+// counterpartyAddress is an address of a chain with another bech32 prefix
+func counterpartyAddress(t *testing.T) string {
+	_, bz, err := bech32.DecodeAndConvert(sample.AccAddress())
+	require.NoError(t, err)
+	addr, err := bech32.ConvertAndEncode("osmo", bz)
+	require.NoError(t, err)
+	return addr
+}
+
+func TestIbcPostPacketData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		data IbcPostPacketData
+		err  error
+	}{
+		{
+			name: "empty title",
+			data: IbcPostPacketData{Content: "content", Creator: sample.AccAddress()},
+			err:  ErrEmptyTitle,
+		}, {
+			name: "invalid UTF-8 title",
+			data: IbcPostPacketData{Title: "ti\xc3\x28tle", Creator: sample.AccAddress()},
+			err:  ErrInvalidUTF8,
+		}, {
+			name: "control character in title",
+			data: IbcPostPacketData{Title: "ti\ttle", Creator: sample.AccAddress()},
+			err:  ErrControlCharacter,
+		}, {
+			name: "invalid UTF-8 content",
+			data: IbcPostPacketData{Title: "title", Content: "\xff\xfe", Creator: sample.AccAddress()},
+			err:  ErrInvalidUTF8,
+		}, {
+			name: "control character in content",
+			data: IbcPostPacketData{Title: "title", Content: "con\x00tent", Creator: sample.AccAddress()},
+			err:  ErrControlCharacter,
+		}, {
+			name: "C1 control character in content",
+			data: IbcPostPacketData{Title: "title", Content: "con\u0085tent", Creator: sample.AccAddress()},
+			err:  ErrControlCharacter,
+		}, {
+			name: "empty creator",
+			data: IbcPostPacketData{Title: "title"},
+			err:  ErrInvalidCreator,
+		}, {
+			name: "invalid creator",
+			data: IbcPostPacketData{Title: "title", Creator: "invalid_address"},
+			err:  ErrInvalidCreator,
+		}, {
+			name: "counterparty creator",
+			data: IbcPostPacketData{Title: "title", Creator: counterpartyAddress(t)},
+		}, {
+			name: "multiline content",
+			data: IbcPostPacketData{Title: "títle ✓", Content: "line\r\n\tline", Creator: sample.AccAddress()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdatePostPacketData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		data UpdatePostPacketData
+		err  error
+	}{
+		{
+			name: "empty post ID",
+			data: UpdatePostPacketData{Title: "title", Creator: sample.AccAddress()},
+			err:  ErrInvalidPostID,
+		}, {
+			name: "non-numeric post ID",
+			data: UpdatePostPacketData{PostID: "1a", Title: "title", Creator: sample.AccAddress()},
+			err:  ErrInvalidPostID,
+		}, {
+			name: "negative post ID",
+			data: UpdatePostPacketData{PostID: "-1", Title: "title", Creator: sample.AccAddress()},
+			err:  ErrInvalidPostID,
+		}, {
+			name: "empty title",
+			data: UpdatePostPacketData{PostID: "1", Creator: sample.AccAddress()},
+			err:  ErrEmptyTitle,
+		}, {
+			name: "invalid UTF-8 content",
+			data: UpdatePostPacketData{PostID: "1", Title: "title", Content: "\xff", Creator: sample.AccAddress()},
+			err:  ErrInvalidUTF8,
+		}, {
+			name: "invalid creator",
+			data: UpdatePostPacketData{PostID: "1", Title: "title", Creator: "cosmos1invalid"},
+			err:  ErrInvalidCreator,
+		}, {
+			name: "valid",
+			data: UpdatePostPacketData{PostID: "18446744073709551615", Title: "title", Creator: sample.AccAddress()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeletePostPacketData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		data DeletePostPacketData
+		err  error
+	}{
+		{
+			name: "invalid post ID",
+			data: DeletePostPacketData{PostID: "one", Creator: sample.AccAddress()},
+			err:  ErrInvalidPostID,
+		}, {
+			name: "invalid creator",
+			data: DeletePostPacketData{PostID: "1"},
+			err:  ErrInvalidCreator,
+		}, {
+			name: "valid",
+			data: DeletePostPacketData{PostID: "1", Creator: counterpartyAddress(t)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSplitContent(t *testing.T) {
+	require.Equal(t, [][]byte{{}}, SplitContent("", 4))
+	require.Equal(t, [][]byte{[]byte("abcd"), []byte("efgh"), []byte("i")}, SplitContent("abcdefghi", 4))
+	require.Equal(t, [][]byte{[]byte("abc")}, SplitContent("abc", 4))
+}
+
+func TestPostChunkPacketData_ValidateBasic(t *testing.T) {
+	valid := func() PostChunkPacketData {
+		return PostChunkPacketData{
+			UploadID:    "1",
+			Title:       "title",
+			Creator:     counterpartyAddress(t),
+			ContentHash: ContentHash([]byte("content")),
+			Index:       1,
+			ChunkCount:  2,
+			Chunk:       []byte("tent"),
+		}
+	}
+	tests := []struct {
+		name string
+		data func(*PostChunkPacketData)
+		err  error
+	}{
+		{
+			name: "empty upload ID",
+			data: func(p *PostChunkPacketData) { p.UploadID = "" },
+			err:  ErrInvalidChunk,
+		}, {
+			name: "empty title",
+			data: func(p *PostChunkPacketData) { p.Title = "" },
+			err:  ErrEmptyTitle,
+		}, {
+			name: "invalid creator",
+			data: func(p *PostChunkPacketData) { p.Creator = "" },
+			err:  ErrInvalidCreator,
+		}, {
+			name: "invalid content hash",
+			data: func(p *PostChunkPacketData) { p.ContentHash = []byte("hash") },
+			err:  ErrInvalidChunk,
+		}, {
+			name: "too many chunks",
+			data: func(p *PostChunkPacketData) { p.ChunkCount = MaxUploadChunks + 1 },
+			err:  ErrInvalidChunk,
+		}, {
+			name: "index out of range",
+			data: func(p *PostChunkPacketData) { p.Index = 2 },
+			err:  ErrInvalidChunk,
+		}, {
+			name: "empty chunk",
+			data: func(p *PostChunkPacketData) { p.Chunk = nil },
+			err:  ErrInvalidChunk,
+		}, {
+			name: "valid",
+			data: func(*PostChunkPacketData) {},
+		}, {
+			name: "empty content",
+			data: func(p *PostChunkPacketData) { p.Index, p.ChunkCount, p.Chunk = 0, 1, nil },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid()
+			tt.data(&data)
+			err := data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFetchPostPacketData_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, FetchPostPacketData{PostID: "first"}.ValidateBasic(), ErrInvalidPostID)
+	require.NoError(t, FetchPostPacketData{PostID: "1"}.ValidateBasic())
+}
+
+func TestReplicatePostPacketData_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, ReplicatePostPacketData{Op: ReplicationOpSet}.ValidateBasic(), ErrInvalidReplication)
+	require.ErrorIs(t, ReplicatePostPacketData{Sequence: 1}.ValidateBasic(), ErrInvalidReplication)
+	require.ErrorIs(t, ReplicatePostPacketData{Sequence: 1, Op: 7}.ValidateBasic(), ErrInvalidReplication)
+	require.NoError(t, ReplicatePostPacketData{Sequence: 1, Op: ReplicationOpReset}.ValidateBasic())
+	require.NoError(t, ReplicatePostPacketData{Sequence: 2, Op: ReplicationOpRemove, Post: Post{Id: 3}}.ValidateBasic())
+	require.ErrorIs(t, ReplicatePostPacketData{Sequence: 2, Op: ReplicationOpSet, Post: Post{Id: 3}}.ValidateBasic(), ErrEmptyTitle)
+	require.ErrorIs(t, ReplicatePostPacketData{Sequence: 2, Op: ReplicationOpSet, Post: Post{Id: 3, Title: "title", Content: "con\x00tent"}}.ValidateBasic(), ErrControlCharacter)
+	require.NoError(t, ReplicatePostPacketData{Sequence: 2, Op: ReplicationOpSet, Post: Post{Title: "title", Content: "content"}}.ValidateBasic())
+}
@@ -2,10 +2,16 @@ package types
 
 // ValidateBasic is used for validating the packet
 func (p UpdatePostPacketData) ValidateBasic() error {
-
-	// TODO: Validate the packet data
-
-	return nil
+	if err := ValidatePostID(p.PostID); err != nil {
+		return err
+	}
+	if err := ValidatePostTitle(p.Title); err != nil {
+		return err
+	}
+	if err := ValidatePostContent(p.Content); err != nil {
+		return err
+	}
+	return ValidatePostCreator(p.Creator)
 }
 
 // GetBytes is a helper for serialising
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+// packetVector is a packet of the conformance vectors: the packet data in
+// canonical JSON and the hex encoded packet sent on a channel of the version
+type packetVector struct {
+	Name    string          `json:"name"`
+	Version string          `json:"version"`
+	ChainID string          `json:"chainID"`
+	Memo    string          `json:"memo"`
+	Data    json.RawMessage `json:"data"`
+	Packet  string          `json:"packet"`
+}
+
+// ackVector is an acknowledgement of the conformance vectors: the result or
+// error written by the blog module and the acknowledgement relayed back
+type ackVector struct {
+	Name            string          `json:"name"`
+	Result          json.RawMessage `json:"result,omitempty"`
+	Error           json.RawMessage `json:"error,omitempty"`
+	Acknowledgement string          `json:"acknowledgement"`
+}
+
+type conformanceVectors struct {
+	Packets []packetVector `json:"packets"`
+	Acks    []ackVector    `json:"acks"`
+}
+
+// packetAcks returns the acknowledgement result of each packet type
+var packetAcks = map[string]func() proto.Message{
+	"ibcPost":    func() proto.Message { return &IbcPostPacketAck{} },
+	"updatePost": func() proto.Message { return &UpdatePostPacketAck{} },
+	"deletePost": func() proto.Message { return &DeletePostPacketAck{} },
+}
+
+func compactJSON(t *testing.T, bz []byte) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, json.Compact(&buf, bz))
+	return buf.Bytes()
+}
+
+func TestConformanceVectors(t *testing.T) {
+	bz, err := os.ReadFile("testdata/packet_vectors.json")
+	require.NoError(t, err)
+	var vectors conformanceVectors
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+
+	covered := make(map[string]bool)
+	for _, v := range vectors.Packets {
+		t.Run(v.Name+"/"+v.Version, func(t *testing.T) {
+			var data BlogPacketData
+			require.NoError(t, ModuleCdc.UnmarshalJSON(v.Data, &data))
+			dataBytes, err := marshalPacketDataJSON(data)
+			require.NoError(t, err)
+			require.Equal(t, string(compactJSON(t, v.Data)), string(dataBytes), "data is not in canonical JSON")
+
+			packet, err := EncodePacket(v.Version, PacketHeader{ChainID: v.ChainID, Memo: v.Memo}, data)
+			require.NoError(t, err)
+			require.Equal(t, v.Packet, hex.EncodeToString(packet))
+
+			header, decoded, err := DecodePacket(v.Version, packet)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+			if v.Version != Version1 {
+				require.Equal(t, v.ChainID, header.ChainID)
+				require.Equal(t, v.Memo, header.Memo)
+			}
+			covered[v.Name+"/"+v.Version] = true
+		})
+	}
+	for name := range packetAcks {
+		for _, version := range SupportedVersions {
+			require.True(t, covered[name+"/"+version], "no vector for packet %s on %s", name, version)
+		}
+	}
+
+	for _, v := range vectors.Acks {
+		t.Run(v.Name, func(t *testing.T) {
+			var ack channeltypes.Acknowledgement
+			if v.Error != nil {
+				ackError := ParsePacketAckError(string(compactJSON(t, v.Error)))
+				require.Equal(t, v.Name, ackError.Code.String())
+				ack = ackError.Acknowledgement()
+			} else {
+				packetAck, ok := packetAcks[v.Name]
+				require.True(t, ok, "unknown packet %s", v.Name)
+				result := packetAck()
+				require.NoError(t, ModuleCdc.UnmarshalJSON(v.Result, result))
+				resultBytes := sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(result))
+				require.Equal(t, string(compactJSON(t, v.Result)), string(resultBytes), "result is not in canonical JSON")
+				ack = channeltypes.NewResultAcknowledgement(resultBytes)
+			}
+			require.Equal(t, v.Acknowledgement, string(ack.Acknowledgement()))
+			covered[v.Name] = true
+		})
+	}
+	for name := range packetAcks {
+		require.True(t, covered[name], "no vector for the acknowledgement of %s", name)
+	}
+	for _, code := range AckErrorCode_name {
+		require.True(t, covered[code], "no vector for %s", code)
+	}
+}
+
+// TestDecodePacketEncodings checks that blog-2 channels decode packets in the
+// encoding of their version only
+func TestDecodePacketEncodings(t *testing.T) {
+	data := BlogPacketData{Packet: &BlogPacketData_IbcPostPacket{IbcPostPacket: &IbcPostPacketData{Title: "title"}}}
+	for _, encoding := range []string{Version2, Version2JSON} {
+		packet, err := EncodePacket(encoding, PacketHeader{ChainID: "mars"}, data)
+		require.NoError(t, err)
+		for _, version := range []string{Version2, Version2JSON} {
+			_, decoded, err := DecodePacket(version, packet)
+			if version != encoding {
+				require.Error(t, err)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+		}
+	}
+
+	// The header of a protobuf packet starts with 0x0a, followed by its
+	// length, 0x7b or '{' here: the packet isn't JSON
+	memo := strings.Repeat("m", 81)
+	packet, err := EncodePacket(Version2, PacketHeader{ChainID: "mars", Memo: memo}, data)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a, '{'}, packet[:2])
+	header, decoded, err := DecodePacket(Version2, packet)
+	require.NoError(t, err)
+	require.Equal(t, memo, header.Memo)
+	require.Equal(t, data, decoded)
+
+	// Counterparties may indent their JSON: the content hash is computed from
+	// the data as written
+	dataBytes := []byte(`{ "ibcPostPacket": { "title": "title" } }`)
+	sum := sha256.Sum256(dataBytes)
+	hash := base64.StdEncoding.EncodeToString(sum[:])
+	packet = []byte(`
+{
+  "header": {"chainID": "mars", "contentHash": "` + hash + `"},
+  "data": { "ibcPostPacket": { "title": "title" } }
+}`)
+	header, decoded, err = DecodePacket(Version2JSON, packet)
+	require.NoError(t, err)
+	require.Equal(t, "mars", header.ChainID)
+	require.Equal(t, "title", decoded.GetIbcPostPacket().Title)
+
+	_, _, err = DecodePacket(Version2JSON, []byte(`{"data":{"ibcPostPacket":{"title":"title"}}}`))
+	require.ErrorIs(t, err, ErrInvalidPacketHeader)
+	_, _, err = DecodePacket(Version2JSON, []byte(`{"header":{"chainID":"mars","contentHash":"`+hash+`"},"data":{"ibcPostPacket":{"title":"edited"}}}`))
+	require.ErrorIs(t, err, ErrInvalidPacketHeader)
+}
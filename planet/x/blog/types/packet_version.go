@@ -0,0 +1,168 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SupportedVersions are the channel versions the blog module speaks, oldest first
+var SupportedVersions = []string{Version1, Version2, Version2JSON}
+
+// IsSupportedVersion returns true if the blog module speaks the channel version
+func IsSupportedVersion(version string) bool {
+	for _, v := range SupportedVersions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// versionRank returns the position of a supported version in SupportedVersions,
+// -1 for the versions the module doesn't speak
+func versionRank(version string) int {
+	for i, v := range SupportedVersions {
+		if v == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsAllowedVersion returns true if the version is supported and not newer than
+// maxVersion. All the supported versions are allowed when maxVersion is empty.
+func IsAllowedVersion(version, maxVersion string) bool {
+	rank := versionRank(version)
+	return rank >= 0 && (maxVersion == "" || rank <= versionRank(maxVersion))
+}
+
+// NegotiateVersion returns the highest version spoken by both the module, up to
+// maxVersion, and a counterparty proposing counterpartyVersion. The counterparty
+// speaks the version it proposes and blog-1, which every blog chain speaks.
+func NegotiateVersion(counterpartyVersion, maxVersion string) (string, error) {
+	if !IsSupportedVersion(counterpartyVersion) {
+		return "", sdkerrors.Wrapf(ErrInvalidVersion, "got %s, expected one of %v", counterpartyVersion, SupportedVersions)
+	}
+	if IsAllowedVersion(counterpartyVersion, maxVersion) {
+		return counterpartyVersion, nil
+	}
+	return Version1, nil
+}
+
+// Revision returns the revision of the post the packet is based on: the base
+// revision of an edit, 0 otherwise
+func (p BlogPacketData) Revision() uint64 {
+	if packet, ok := p.Packet.(*BlogPacketData_UpdatePostPacket); ok {
+		return packet.UpdatePostPacket.BaseRevision
+	}
+	return 0
+}
+
+// ValidateBasic is used for validating the packet header
+func (h PacketHeader) ValidateBasic() error {
+	if h.ChainID == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketHeader, "empty chain ID")
+	}
+	if len(h.ContentHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidPacketHeader, "content hash of %d bytes", len(h.ContentHash))
+	}
+	return ValidatePacketMemo(h.Memo)
+}
+
+// EncodePacket encodes the packet data in the format of the channel version.
+// blog-1 packets are the bare packet data, blog-2 packets prefix it with the
+// header, whose content hash and revision are computed from the data.
+// blog-2-json packets are blog-2 packets in canonical JSON, for counterparties
+// without protobuf.
+func EncodePacket(version string, header PacketHeader, data BlogPacketData) ([]byte, error) {
+	var (
+		dataBytes []byte
+		err       error
+	)
+	switch version {
+	case Version1, Version2:
+		dataBytes, err = data.Marshal()
+	case Version2JSON:
+		dataBytes, err = marshalPacketDataJSON(data)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidVersion, "unsupported channel version %s", version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if version == Version1 {
+		return dataBytes, nil
+	}
+
+	hash := sha256.Sum256(dataBytes)
+	header.ContentHash = hash[:]
+	header.Revision = data.Revision()
+	if version == Version2JSON {
+		return marshalPacketJSON(header, dataBytes)
+	}
+	packet := BlogPacketV2{
+		Header: &header,
+		Data:   dataBytes,
+	}
+	return packet.Marshal()
+}
+
+// DecodePacket decodes a packet in the format of the channel version. The
+// header of blog-1 packets is empty. The encoding of blog-2 packets is the one
+// of the channel version, never guessed from the packet bytes: a protobuf
+// packet may start like a JSON object.
+func DecodePacket(version string, bz []byte) (header PacketHeader, data BlogPacketData, err error) {
+	switch version {
+	case Version1:
+		if err := data.Unmarshal(bz); err != nil {
+			return header, data, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+		}
+		return header, data, nil
+	case Version2, Version2JSON:
+	default:
+		return header, data, sdkerrors.Wrapf(ErrInvalidVersion, "unsupported channel version %s", version)
+	}
+
+	var (
+		packetHeader *PacketHeader
+		dataBytes    []byte
+	)
+	isJSON := version == Version2JSON
+	if isJSON {
+		packetHeader, dataBytes, err = unmarshalPacketJSON(bz)
+		if err != nil {
+			return header, data, err
+		}
+	} else {
+		var packet BlogPacketV2
+		if err := packet.Unmarshal(bz); err != nil {
+			return header, data, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet: %s", err.Error())
+		}
+		packetHeader, dataBytes = packet.Header, packet.Data
+	}
+
+	if packetHeader == nil {
+		return header, data, sdkerrors.Wrap(ErrInvalidPacketHeader, "missing header")
+	}
+	header = *packetHeader
+	if err := header.ValidateBasic(); err != nil {
+		return header, data, err
+	}
+	if hash := sha256.Sum256(dataBytes); !bytes.Equal(hash[:], header.ContentHash) {
+		return header, data, sdkerrors.Wrap(ErrInvalidPacketHeader, "content hash mismatch")
+	}
+	if isJSON {
+		err = ModuleCdc.UnmarshalJSON(dataBytes, &data)
+	} else {
+		err = data.Unmarshal(dataBytes)
+	}
+	if err != nil {
+		return header, data, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+	if header.Revision != data.Revision() {
+		return header, data, sdkerrors.Wrapf(ErrInvalidPacketHeader, "revision %d, packet data is based on %d", header.Revision, data.Revision())
+	}
+	return header, data, nil
+}
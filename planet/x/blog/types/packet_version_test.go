@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePacket(t *testing.T) {
+	data := BlogPacketData{Packet: &BlogPacketData_UpdatePostPacket{UpdatePostPacket: &UpdatePostPacketData{
+		PostID:       "1",
+		Title:        "title",
+		BaseRevision: 3,
+	}}}
+
+	// blog-1 packets are the bare packet data
+	bz, err := EncodePacket(Version1, PacketHeader{ChainID: "mars", Memo: "memo"}, data)
+	require.NoError(t, err)
+	dataBytes, err := data.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, dataBytes, bz)
+	header, decoded, err := DecodePacket(Version1, bz)
+	require.NoError(t, err)
+	require.Equal(t, PacketHeader{}, header)
+	require.Equal(t, data, decoded)
+
+	bz, err = EncodePacket(Version2, PacketHeader{ChainID: "mars", Memo: "memo"}, data)
+	require.NoError(t, err)
+	header, decoded, err = DecodePacket(Version2, bz)
+	require.NoError(t, err)
+	require.Equal(t, "mars", header.ChainID)
+	require.Equal(t, "memo", header.Memo)
+	require.Equal(t, uint64(3), header.Revision)
+	require.Len(t, header.ContentHash, 32)
+	require.Equal(t, data, decoded)
+
+	_, err = EncodePacket("blog-3", PacketHeader{}, data)
+	require.ErrorIs(t, err, ErrInvalidVersion)
+	_, _, err = DecodePacket("blog-3", bz)
+	require.ErrorIs(t, err, ErrInvalidVersion)
+}
+
+func TestDecodePacket(t *testing.T) {
+	data := BlogPacketData{Packet: &BlogPacketData_IbcPostPacket{IbcPostPacket: &IbcPostPacketData{Title: "title"}}}
+	bz, err := EncodePacket(Version2, PacketHeader{ChainID: "mars"}, data)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(*BlogPacketV2)
+		err    error
+	}{
+		{
+			desc:   "missing header",
+			modify: func(p *BlogPacketV2) { p.Header = nil },
+			err:    ErrInvalidPacketHeader,
+		},
+		{
+			desc:   "empty chain ID",
+			modify: func(p *BlogPacketV2) { p.Header.ChainID = "" },
+			err:    ErrInvalidPacketHeader,
+		},
+		{
+			desc:   "content hash mismatch",
+			modify: func(p *BlogPacketV2) { p.Header.ContentHash[0] ^= 1 },
+			err:    ErrInvalidPacketHeader,
+		},
+		{
+			desc:   "revision mismatch",
+			modify: func(p *BlogPacketV2) { p.Header.Revision = 1 },
+			err:    ErrInvalidPacketHeader,
+		},
+		{
+			desc:   "memo too long",
+			modify: func(p *BlogPacketV2) { p.Header.Memo = string(make([]byte, MaxPacketMemoLength+1)) },
+			err:    ErrMemoTooLong,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var p BlogPacketV2
+			require.NoError(t, p.Unmarshal(bz))
+			tc.modify(&p)
+			modified, err := p.Marshal()
+			require.NoError(t, err)
+			_, _, err = DecodePacket(Version2, modified)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, AckErrorInvalidPayload, AckErrorCodeOf(err))
+		})
+	}
+}
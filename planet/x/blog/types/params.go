@@ -1,35 +1,119 @@
 package types
 
 import (
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"fmt"
+	"time"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
-var _ paramtypes.ParamSet = (*Params)(nil)
-
-// ParamKeyTable the param key table for launch module
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
-}
+var (
+	// DefaultMaxTitleLength is the default maximum size of a post title in bytes
+	DefaultMaxTitleLength uint64 = 256
+	// DefaultMaxContentLength is the default maximum size of a post content in bytes
+	DefaultMaxContentLength uint64 = 10240
+	// DefaultPacketTimeout is the default relative packet timeout
+	DefaultPacketTimeout = 10 * time.Minute
+	// DefaultInboundEnabled is the default for accepting posts from counterparties
+	DefaultInboundEnabled = true
+	// DefaultMaxBatchPosts is the default maximum number of posts of a batch packet
+	DefaultMaxBatchPosts uint64 = 50
+	// DefaultUploadTimeout is the default time the chunks of a post are kept until all of them have arrived
+	DefaultUploadTimeout = time.Hour
+	// DefaultMaxInboundPacketsPerBlock is the default maximum number of packets accepted on a channel in a block
+	DefaultMaxInboundPacketsPerBlock uint64 = 100
+	// DefaultSnapshotPageSize is the default maximum number of posts of a snapshot sent on a channel in a block
+	DefaultSnapshotPageSize uint64 = 100
+)
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxTitleLength uint64,
+	maxContentLength uint64,
+	allowedChannels []string,
+	defaultPacketTimeout time.Duration,
+	inboundEnabled bool,
+	maxBatchPosts uint64,
+	uploadTimeout time.Duration,
+	maxInboundPacketsPerBlock uint64,
+	maxChannelVersion string,
+	snapshotPageSize uint64,
+) Params {
+	return Params{
+		MaxTitleLength:       maxTitleLength,
+		MaxContentLength:     maxContentLength,
+		AllowedChannels:      allowedChannels,
+		DefaultPacketTimeout: defaultPacketTimeout,
+		InboundEnabled:       inboundEnabled,
+		MaxBatchPosts:        maxBatchPosts,
+		UploadTimeout:        uploadTimeout,
+
+		MaxInboundPacketsPerBlock: maxInboundPacketsPerBlock,
+		MaxChannelVersion:         maxChannelVersion,
+		SnapshotPageSize:          snapshotPageSize,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
-}
-
-// ParamSetPairs get the params.ParamSet
-func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return NewParams(
+		DefaultMaxTitleLength,
+		DefaultMaxContentLength,
+		nil,
+		DefaultPacketTimeout,
+		DefaultInboundEnabled,
+		DefaultMaxBatchPosts,
+		DefaultUploadTimeout,
+		DefaultMaxInboundPacketsPerBlock,
+		"",
+		DefaultSnapshotPageSize,
+	)
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateMaxLength(p.MaxTitleLength); err != nil {
+		return err
+	}
+	if err := validateMaxLength(p.MaxContentLength); err != nil {
+		return err
+	}
+	if err := validateAllowedChannels(p.AllowedChannels); err != nil {
+		return err
+	}
+	if err := validateDefaultPacketTimeout(p.DefaultPacketTimeout); err != nil {
+		return err
+	}
+	if err := validateInboundEnabled(p.InboundEnabled); err != nil {
+		return err
+	}
+	if err := validateMaxBatchPosts(p.MaxBatchPosts); err != nil {
+		return err
+	}
+	if err := validateUploadTimeout(p.UploadTimeout); err != nil {
+		return err
+	}
+	if err := validateMaxInboundPacketsPerBlock(p.MaxInboundPacketsPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxChannelVersion(p.MaxChannelVersion); err != nil {
+		return err
+	}
+	return validateSnapshotPageSize(p.SnapshotPageSize)
+}
+
+// IsChannelAllowed reports whether posts may be sent and received on the channel
+func (p Params) IsChannelAllowed(channelID string) bool {
+	if len(p.AllowedChannels) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedChannels {
+		if allowed == channelID {
+			return true
+		}
+	}
+	return false
 }
 
 // String implements the Stringer interface.
@@ -37,3 +121,105 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max length must be positive")
+	}
+	return nil
+}
+
+func validateAllowedChannels(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, channelID := range v {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid allowed channel: %w", err)
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicated allowed channel: %s", channelID)
+		}
+		seen[channelID] = true
+	}
+	return nil
+}
+
+func validateDefaultPacketTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("default packet timeout must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateInboundEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxBatchPosts(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max batch posts must be positive")
+	}
+	return nil
+}
+
+func validateUploadTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("upload timeout must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateMaxInboundPacketsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max inbound packets per block must be positive")
+	}
+	return nil
+}
+
+func validateMaxChannelVersion(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v != "" && !IsSupportedVersion(v) {
+		return fmt.Errorf("unsupported max channel version: %s", v)
+	}
+	return nil
+}
+
+func validateSnapshotPageSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("snapshot page size must be positive")
+	}
+	return nil
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// maxTitleLength is the maximum size of a post title in bytes
+	MaxTitleLength uint64 `protobuf:"varint,1,opt,name=maxTitleLength,proto3" json:"maxTitleLength,omitempty" yaml:"max_title_length"`
+	// maxContentLength is the maximum size of a post content in bytes
+	MaxContentLength uint64 `protobuf:"varint,2,opt,name=maxContentLength,proto3" json:"maxContentLength,omitempty" yaml:"max_content_length"`
+	// allowedChannels restricts the channels posts are sent and received on, all
+	// channels are allowed when empty
+	AllowedChannels []string `protobuf:"bytes,3,rep,name=allowedChannels,proto3" json:"allowedChannels,omitempty" yaml:"allowed_channels"`
+	// defaultPacketTimeout is the packet timeout, relative to the block time,
+	// used when a message doesn't set one
+	DefaultPacketTimeout time.Duration `protobuf:"bytes,4,opt,name=defaultPacketTimeout,proto3,stdduration" json:"defaultPacketTimeout" yaml:"default_packet_timeout"`
+	// inboundEnabled controls whether posts and updates are accepted from counterparties
+	InboundEnabled bool `protobuf:"varint,5,opt,name=inboundEnabled,proto3" json:"inboundEnabled,omitempty" yaml:"inbound_enabled"`
+	// maxBatchPosts is the maximum number of posts of a batch packet
+	MaxBatchPosts uint64 `protobuf:"varint,6,opt,name=maxBatchPosts,proto3" json:"maxBatchPosts,omitempty" yaml:"max_batch_posts"`
+	// uploadTimeout is how long the chunks of a post received in several packets
+	// are kept until all of them have arrived
+	UploadTimeout time.Duration `protobuf:"bytes,7,opt,name=uploadTimeout,proto3,stdduration" json:"uploadTimeout" yaml:"upload_timeout"`
+	// maxInboundPacketsPerBlock is the maximum number of packets accepted from
+	// the counterparty of a channel in a block, replication packets excepted
+	MaxInboundPacketsPerBlock uint64 `protobuf:"varint,8,opt,name=maxInboundPacketsPerBlock,proto3" json:"maxInboundPacketsPerBlock,omitempty" yaml:"max_inbound_packets_per_block"`
+	// maxChannelVersion is the highest channel version negotiated on the new
+	// channels, the highest supported version when empty
+	MaxChannelVersion string `protobuf:"bytes,9,opt,name=maxChannelVersion,proto3" json:"maxChannelVersion,omitempty" yaml:"max_channel_version"`
+	// snapshotPageSize is the maximum number of posts of a replication snapshot
+	// sent on a channel in a block
+	SnapshotPageSize uint64 `protobuf:"varint,10,opt,name=snapshotPageSize,proto3" json:"snapshotPageSize,omitempty" yaml:"snapshot_page_size"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +89,76 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxTitleLength() uint64 {
+	if m != nil {
+		return m.MaxTitleLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxContentLength() uint64 {
+	if m != nil {
+		return m.MaxContentLength
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetDefaultPacketTimeout() time.Duration {
+	if m != nil {
+		return m.DefaultPacketTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetInboundEnabled() bool {
+	if m != nil {
+		return m.InboundEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxBatchPosts() uint64 {
+	if m != nil {
+		return m.MaxBatchPosts
+	}
+	return 0
+}
+
+func (m *Params) GetUploadTimeout() time.Duration {
+	if m != nil {
+		return m.UploadTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInboundPacketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxInboundPacketsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChannelVersion() string {
+	if m != nil {
+		return m.MaxChannelVersion
+	}
+	return ""
+}
+
+func (m *Params) GetSnapshotPageSize() uint64 {
+	if m != nil {
+		return m.SnapshotPageSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "planet.blog.Params")
 }
@@ -66,15 +166,42 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/params.proto", fileDescriptor_616183f35c929fe8) }
 
 var fileDescriptor_616183f35c929fe8 = []byte{
-	// 128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xc8, 0xe8, 0x81, 0x64, 0xa4, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xe2, 0xfa, 0x20, 0x16, 0x44, 0x89, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58, 0x8b, 0x15,
-	0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xba, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x25, 0x0c, 0xb5, 0xa6, 0x02, 0x62, 0x51, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x14, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xf6, 0x9f, 0x9a, 0x84, 0x00, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0xf9, 0x42, 0x33, 0x55, 0x0b, 0x98, 0x02, 0x4e, 0x50, 0xed, 0x60, 0xb1,
+	0x30, 0x0b, 0x12, 0x09, 0x76, 0x5d, 0x21, 0x87, 0x2e, 0x2a, 0x75, 0x11, 0x99, 0x8a, 0x05, 0x9b,
+	0xd1, 0xd8, 0x9e, 0x38, 0x56, 0xc7, 0x33, 0x23, 0xcf, 0x18, 0xdc, 0x3e, 0x05, 0xcb, 0x2e, 0x79,
+	0x9c, 0x8a, 0x55, 0x97, 0xac, 0x0c, 0x4a, 0xde, 0xc0, 0x4f, 0x80, 0xec, 0x71, 0x68, 0xfe, 0x80,
+	0xd8, 0xd9, 0xbe, 0xe7, 0xfc, 0xe6, 0xde, 0xe3, 0x3b, 0xc0, 0xe0, 0x04, 0x51, 0x2c, 0x47, 0x3e,
+	0x61, 0xd1, 0x88, 0xa3, 0x14, 0x25, 0x62, 0xc8, 0x53, 0x26, 0x99, 0xbe, 0xa7, 0x2a, 0xc3, 0xaa,
+	0xd2, 0x3f, 0x8c, 0x58, 0xc4, 0xea, 0xef, 0xa3, 0xea, 0x49, 0x49, 0xfa, 0x66, 0xc4, 0x58, 0x44,
+	0xf0, 0xa8, 0x7e, 0xf3, 0xb3, 0xe9, 0x28, 0xcc, 0x52, 0x24, 0x63, 0x46, 0x55, 0xdd, 0xfe, 0xd6,
+	0x01, 0x9d, 0x49, 0xcd, 0xd4, 0xc7, 0xe0, 0x20, 0x41, 0xf9, 0x79, 0x2c, 0x09, 0x3e, 0xc3, 0x34,
+	0x92, 0x33, 0x43, 0x1b, 0x68, 0x4e, 0xdb, 0x7d, 0x56, 0x16, 0xd6, 0xd3, 0x4b, 0x94, 0x90, 0x63,
+	0x3b, 0x41, 0x39, 0x94, 0x95, 0x00, 0x92, 0x5a, 0x61, 0x7b, 0x1b, 0x16, 0xfd, 0x14, 0x3c, 0x48,
+	0x50, 0x3e, 0x66, 0x54, 0x62, 0x2a, 0x1b, 0xcc, 0x7f, 0x35, 0xe6, 0xa8, 0x2c, 0xac, 0xde, 0x1d,
+	0x26, 0x50, 0x92, 0xdf, 0xa0, 0x2d, 0x9b, 0x7e, 0x02, 0xee, 0x23, 0x42, 0xd8, 0x67, 0x1c, 0x8e,
+	0x67, 0x88, 0x52, 0x4c, 0x84, 0xb1, 0x33, 0xd8, 0x71, 0xba, 0xab, 0x0d, 0x35, 0x02, 0x18, 0x34,
+	0x0a, 0xdb, 0xdb, 0xf4, 0xe8, 0x39, 0x38, 0x0c, 0xf1, 0x14, 0x65, 0x44, 0x4e, 0x50, 0x70, 0x81,
+	0xe5, 0x79, 0x9c, 0x60, 0x96, 0x49, 0xa3, 0x3d, 0xd0, 0x9c, 0xbd, 0xd7, 0xbd, 0xa1, 0x0a, 0x68,
+	0xb8, 0x0c, 0x68, 0xf8, 0xae, 0x09, 0xc8, 0x7d, 0x79, 0x53, 0x58, 0xad, 0xb2, 0xb0, 0x8e, 0xd4,
+	0x51, 0x0d, 0x04, 0xf2, 0x9a, 0x02, 0xa5, 0xc2, 0xd8, 0xd7, 0x3f, 0x2c, 0xcd, 0xfb, 0xe3, 0x09,
+	0xba, 0x0b, 0x0e, 0x62, 0xea, 0xb3, 0x8c, 0x86, 0x27, 0x14, 0xf9, 0x04, 0x87, 0xc6, 0xff, 0x03,
+	0xcd, 0xd9, 0x75, 0xfb, 0x65, 0x61, 0x3d, 0x51, 0xd0, 0xa6, 0x0e, 0xb1, 0x12, 0xd8, 0xde, 0x86,
+	0x43, 0x7f, 0x0b, 0xf6, 0x13, 0x94, 0xbb, 0x48, 0x06, 0xb3, 0x09, 0x13, 0x52, 0x18, 0x9d, 0x3a,
+	0xcc, 0x15, 0x44, 0x15, 0xa6, 0x5f, 0xd5, 0x21, 0xaf, 0x04, 0xb6, 0xb7, 0x6e, 0xd0, 0x7d, 0xb0,
+	0x9f, 0x71, 0xc2, 0x50, 0xb8, 0x1c, 0xfc, 0xde, 0xbf, 0x06, 0x7f, 0xde, 0x0c, 0xfe, 0x58, 0x1d,
+	0xa0, 0xdc, 0xeb, 0x03, 0xaf, 0x23, 0xf5, 0x29, 0xe8, 0x25, 0x28, 0x3f, 0x55, 0xad, 0xab, 0x10,
+	0xc4, 0x04, 0xa7, 0x2e, 0x61, 0xc1, 0x85, 0xb1, 0x5b, 0x77, 0xec, 0x94, 0x85, 0xf5, 0xe2, 0xae,
+	0xe3, 0xe5, 0xe0, 0x2a, 0x4d, 0x01, 0x39, 0x4e, 0xa1, 0x5f, 0xc9, 0x6d, 0xef, 0xef, 0x28, 0xfd,
+	0x0c, 0x3c, 0xac, 0xd6, 0x44, 0xfd, 0xda, 0x0f, 0x38, 0x15, 0x31, 0xa3, 0x46, 0x77, 0xa0, 0x39,
+	0x5d, 0xd7, 0x2c, 0x0b, 0xab, 0xbf, 0xb2, 0x5e, 0x4a, 0x03, 0x3f, 0x29, 0x91, 0xed, 0x6d, 0x1b,
+	0xab, 0x5d, 0x15, 0x14, 0x71, 0x31, 0x63, 0x72, 0x82, 0x22, 0xfc, 0x3e, 0xbe, 0xc2, 0x06, 0xd8,
+	0xdc, 0xd5, 0xa5, 0x02, 0x72, 0x14, 0x61, 0x28, 0xe2, 0x2b, 0x6c, 0x7b, 0x5b, 0xb6, 0xe3, 0xf6,
+	0xf5, 0x57, 0xab, 0xe5, 0xbe, 0xba, 0x99, 0x9b, 0xda, 0xed, 0xdc, 0xd4, 0x7e, 0xce, 0x4d, 0xed,
+	0xcb, 0xc2, 0x6c, 0xdd, 0x2e, 0xcc, 0xd6, 0xf7, 0x85, 0xd9, 0xfa, 0xf8, 0xa8, 0xb9, 0xc3, 0xb9,
+	0xba, 0xc5, 0xf2, 0x92, 0x63, 0xe1, 0x77, 0xea, 0xe8, 0xdf, 0xfc, 0x1a, 0x00, 0x79, 0x37, 0xd6,
+	0xb5, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -97,6 +224,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SnapshotPageSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotPageSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MaxChannelVersion) > 0 {
+		i -= len(m.MaxChannelVersion)
+		copy(dAtA[i:], m.MaxChannelVersion)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxChannelVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxInboundPacketsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxInboundPacketsPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UploadTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UploadTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxBatchPosts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchPosts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InboundEnabled {
+		i--
+		if m.InboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DefaultPacketTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultPacketTimeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxContentLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxContentLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTitleLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTitleLength))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -117,6 +311,38 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxTitleLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTitleLength))
+	}
+	if m.MaxContentLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxContentLength))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultPacketTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	if m.InboundEnabled {
+		n += 2
+	}
+	if m.MaxBatchPosts != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchPosts))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UploadTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxInboundPacketsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxInboundPacketsPerBlock))
+	}
+	l = len(m.MaxChannelVersion)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.SnapshotPageSize != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotPageSize))
+	}
 	return n
 }
 
@@ -155,6 +381,251 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTitleLength", wireType)
+			}
+			m.MaxTitleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTitleLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContentLength", wireType)
+			}
+			m.MaxContentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContentLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPacketTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DefaultPacketTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchPosts", wireType)
+			}
+			m.MaxBatchPosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchPosts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UploadTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInboundPacketsPerBlock", wireType)
+			}
+			m.MaxInboundPacketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInboundPacketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChannelVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxChannelVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotPageSize", wireType)
+			}
+			m.SnapshotPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotPageSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,41 @@
+/*
+NOTE: Usage of x/params to manage parameters is deprecated in favor of x/gov
+controlled execution of MsgUpdateParams messages. These types remain solely
+for migration purposes and will be removed in a future release.
+*/
+package types
+
+import (
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyMaxTitleLength       = []byte("MaxTitleLength")
+	KeyMaxContentLength     = []byte("MaxContentLength")
+	KeyAllowedChannels      = []byte("AllowedChannels")
+	KeyDefaultPacketTimeout = []byte("DefaultPacketTimeout")
+	KeyInboundEnabled       = []byte("InboundEnabled")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for launch module
+//
+// Deprecated: only used to migrate the params out of the legacy subspace.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs get the params.ParamSet
+//
+// Deprecated: only used to migrate the params out of the legacy subspace.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxTitleLength, &p.MaxTitleLength, validateMaxLength),
+		paramtypes.NewParamSetPair(KeyMaxContentLength, &p.MaxContentLength, validateMaxLength),
+		paramtypes.NewParamSetPair(KeyAllowedChannels, &p.AllowedChannels, validateAllowedChannels),
+		paramtypes.NewParamSetPair(KeyDefaultPacketTimeout, &p.DefaultPacketTimeout, validateDefaultPacketTimeout),
+		paramtypes.NewParamSetPair(KeyInboundEnabled, &p.InboundEnabled, validateInboundEnabled),
+	}
+}
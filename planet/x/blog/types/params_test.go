@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		params func(*Params)
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			params: func(*Params) {},
+			valid:  true,
+		},
+		{
+			desc:   "allowed channels",
+			params: func(p *Params) { p.AllowedChannels = []string{"channel-0", "channel-1"} },
+			valid:  true,
+		},
+		{
+			desc:   "max channel version",
+			params: func(p *Params) { p.MaxChannelVersion = Version1 },
+			valid:  true,
+		},
+		{
+			desc:   "zero max title length",
+			params: func(p *Params) { p.MaxTitleLength = 0 },
+		},
+		{
+			desc:   "zero max content length",
+			params: func(p *Params) { p.MaxContentLength = 0 },
+		},
+		{
+			desc:   "invalid allowed channel",
+			params: func(p *Params) { p.AllowedChannels = []string{"?"} },
+		},
+		{
+			desc:   "duplicated allowed channel",
+			params: func(p *Params) { p.AllowedChannels = []string{"channel-0", "channel-0"} },
+		},
+		{
+			desc:   "zero default packet timeout",
+			params: func(p *Params) { p.DefaultPacketTimeout = 0 },
+		},
+		{
+			desc:   "zero max batch posts",
+			params: func(p *Params) { p.MaxBatchPosts = 0 },
+		},
+		{
+			desc:   "zero upload timeout",
+			params: func(p *Params) { p.UploadTimeout = 0 },
+		},
+		{
+			desc:   "zero max inbound packets per block",
+			params: func(p *Params) { p.MaxInboundPacketsPerBlock = 0 },
+		},
+		{
+			desc:   "unsupported max channel version",
+			params: func(p *Params) { p.MaxChannelVersion = "blog-3" },
+		},
+		{
+			desc:   "zero snapshot page size",
+			params: func(p *Params) { p.SnapshotPageSize = 0 },
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := DefaultParams()
+			tc.params(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsIsChannelAllowed(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.IsChannelAllowed("channel-5"))
+
+	params.AllowedChannels = []string{"channel-0"}
+	require.True(t, params.IsChannelAllowed("channel-0"))
+	require.False(t, params.IsChannelAllowed("channel-5"))
+}
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,7 +31,19 @@ type Post struct {
 	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// creator is the author address; for posts received over IBC it is the
+	// sender address on the counterparty chain
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// origin of a post received over IBC, empty for local posts
+	OriginPort     string    `protobuf:"bytes,5,opt,name=originPort,proto3" json:"originPort,omitempty"`
+	OriginChannel  string    `protobuf:"bytes,6,opt,name=originChannel,proto3" json:"originChannel,omitempty"`
+	OriginChainID  string    `protobuf:"bytes,7,opt,name=originChainID,proto3" json:"originChainID,omitempty"`
+	ReceivedHeight int64     `protobuf:"varint,8,opt,name=receivedHeight,proto3" json:"receivedHeight,omitempty"`
+	ReceivedAt     time.Time `protobuf:"bytes,9,opt,name=receivedAt,proto3,stdtime" json:"receivedAt"`
+	// revision is incremented on every edit of the post
+	Revision uint64 `protobuf:"varint,10,opt,name=revision,proto3" json:"revision,omitempty"`
+	// channelID is the local channel a post received over IBC arrived on
+	ChannelID string `protobuf:"bytes,11,opt,name=channelID,proto3" json:"channelID,omitempty"`
 }
 
 func (m *Post) Reset()         { *m = Post{} }
@@ -90,6 +107,55 @@ func (m *Post) GetCreator() string {
 	return ""
 }
 
+func (m *Post) GetOriginPort() string {
+	if m != nil {
+		return m.OriginPort
+	}
+	return ""
+}
+
+func (m *Post) GetOriginChannel() string {
+	if m != nil {
+		return m.OriginChannel
+	}
+	return ""
+}
+
+func (m *Post) GetOriginChainID() string {
+	if m != nil {
+		return m.OriginChainID
+	}
+	return ""
+}
+
+func (m *Post) GetReceivedHeight() int64 {
+	if m != nil {
+		return m.ReceivedHeight
+	}
+	return 0
+}
+
+func (m *Post) GetReceivedAt() time.Time {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return time.Time{}
+}
+
+func (m *Post) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Post) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Post)(nil), "planet.blog.Post")
 }
@@ -97,18 +163,29 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/post.proto", fileDescriptor_be5d14fb1ad7fad3) }
 
 var fileDescriptor_be5d14fb1ad7fad3 = []byte{
-	// 172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0xc8, 0x2f, 0x2e, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x88, 0xeb, 0x81, 0xc4, 0x95, 0x12, 0xb8, 0x58, 0x02, 0xf2, 0x8b,
-	0x4b, 0x84, 0xf8, 0xb8, 0x98, 0x32, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0x98, 0x32,
-	0x53, 0x84, 0x44, 0xb8, 0x58, 0x4b, 0x32, 0x4b, 0x72, 0x52, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38,
-	0x83, 0x20, 0x1c, 0x21, 0x09, 0x2e, 0xf6, 0xe4, 0xfc, 0xbc, 0x92, 0xd4, 0xbc, 0x12, 0x09, 0x66,
-	0xb0, 0x38, 0x8c, 0x0b, 0x96, 0x29, 0x4a, 0x4d, 0x2c, 0xc9, 0x2f, 0x92, 0x60, 0x81, 0xca, 0x40,
-	0xb8, 0x4e, 0xba, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x0c, 0x75,
-	0x60, 0x05, 0xc4, 0x89, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x47, 0x1a, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x2f, 0xff, 0x27, 0x13, 0xbe, 0x00, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0x3b, 0xe5, 0x7f, 0xc8, 0xc7, 0x62, 0x3e, 0x62, 0x26, 0x8d, 0x29, 0x8d, 0x31, 0xa6,
+	0x1b, 0xdb, 0x44, 0xaf, 0x40, 0x64, 0x21, 0x3b, 0xd2, 0xb8, 0x72, 0x57, 0x60, 0x2c, 0x93, 0x94,
+	0x39, 0x4d, 0x7b, 0x24, 0x7a, 0x17, 0x5c, 0x16, 0x4b, 0x96, 0xae, 0xd4, 0xc0, 0x2d, 0x78, 0x01,
+	0x86, 0x19, 0xaa, 0xc0, 0x6e, 0x9e, 0xe7, 0xbc, 0x93, 0x9c, 0xbc, 0x87, 0x9e, 0x65, 0x69, 0xac,
+	0x04, 0x86, 0xe3, 0x14, 0x92, 0x30, 0x83, 0x02, 0x83, 0x2c, 0x07, 0x04, 0xd6, 0x36, 0x3e, 0xd8,
+	0x79, 0xa7, 0x9b, 0x40, 0x02, 0xda, 0x87, 0xbb, 0x97, 0x89, 0x38, 0xbd, 0x04, 0x20, 0x49, 0x45,
+	0xa8, 0x69, 0xfc, 0xf2, 0x1c, 0xa2, 0x9c, 0x8b, 0x02, 0xe3, 0x79, 0x66, 0x02, 0x17, 0xdf, 0x36,
+	0xad, 0x8e, 0xa0, 0x40, 0xd6, 0xa1, 0xb6, 0x9c, 0x72, 0xe2, 0x11, 0xbf, 0x1a, 0xd9, 0x72, 0xca,
+	0xba, 0xb4, 0x86, 0x12, 0x53, 0xc1, 0x6d, 0x8f, 0xf8, 0xad, 0xc8, 0x00, 0xe3, 0xb4, 0x31, 0x01,
+	0x85, 0x42, 0x21, 0xaf, 0x68, 0x5f, 0xa2, 0x9e, 0xe4, 0x22, 0x46, 0xc8, 0x79, 0x75, 0x3f, 0x31,
+	0xc8, 0x5c, 0x4a, 0x21, 0x97, 0x89, 0x54, 0x23, 0xc8, 0x91, 0xd7, 0xf4, 0xf0, 0xc0, 0xb0, 0x4b,
+	0xfa, 0xcf, 0xd0, 0xfd, 0x2c, 0x56, 0x4a, 0xa4, 0xbc, 0xae, 0x23, 0xc7, 0xf2, 0x28, 0x25, 0xd5,
+	0x70, 0xc0, 0x1b, 0x27, 0xa9, 0x9d, 0x64, 0x57, 0xb4, 0x93, 0x8b, 0x89, 0x90, 0x0b, 0x31, 0x7d,
+	0x10, 0x32, 0x99, 0x21, 0x6f, 0x7a, 0xc4, 0xaf, 0x44, 0x27, 0x96, 0x0d, 0x28, 0x2d, 0xcd, 0x1d,
+	0xf2, 0x96, 0x47, 0xfc, 0xf6, 0x8d, 0x13, 0x98, 0xb2, 0x82, 0xb2, 0xac, 0xe0, 0xb1, 0x2c, 0xab,
+	0xdf, 0x5c, 0x7d, 0xf4, 0xac, 0xe5, 0x67, 0x8f, 0x44, 0x07, 0xff, 0x98, 0x43, 0x9b, 0xb9, 0x58,
+	0xc8, 0x42, 0x82, 0xe2, 0x54, 0x37, 0xf7, 0xcb, 0xec, 0x9c, 0xb6, 0x26, 0x66, 0xf5, 0xe1, 0x80,
+	0xb7, 0xf5, 0xae, 0x7f, 0xa2, 0x7f, 0xbd, 0xda, 0xb8, 0x64, 0xbd, 0x71, 0xc9, 0xd7, 0xc6, 0x25,
+	0xcb, 0xad, 0x6b, 0xad, 0xb7, 0xae, 0xf5, 0xbe, 0x75, 0xad, 0xa7, 0xff, 0xfb, 0x63, 0xbf, 0x9a,
+	0x73, 0xe3, 0x5b, 0x26, 0x8a, 0x71, 0x5d, 0xaf, 0x74, 0xfb, 0x33, 0x00, 0x93, 0x1a, 0x82, 0x66,
+	0x0a, 0x02, 0x00, 0x00,
 }
 
 func (m *Post) Marshal() (dAtA []byte, err error) {
@@ -131,6 +208,52 @@ func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x50
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPost(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.ReceivedHeight != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.ReceivedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.OriginChainID) > 0 {
+		i -= len(m.OriginChainID)
+		copy(dAtA[i:], m.OriginChainID)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.OriginChainID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OriginChannel) > 0 {
+		i -= len(m.OriginChannel)
+		copy(dAtA[i:], m.OriginChannel)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.OriginChannel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OriginPort) > 0 {
+		i -= len(m.OriginPort)
+		copy(dAtA[i:], m.OriginPort)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.OriginPort)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -192,6 +315,30 @@ func (m *Post) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPost(uint64(l))
 	}
+	l = len(m.OriginPort)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.OriginChannel)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.OriginChainID)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.ReceivedHeight != 0 {
+		n += 1 + sovPost(uint64(m.ReceivedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedAt)
+	n += 1 + l + sovPost(uint64(l))
+	if m.Revision != 0 {
+		n += 1 + sovPost(uint64(m.Revision))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
 	return n
 }
 
@@ -345,6 +492,205 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedHeight", wireType)
+			}
+			m.ReceivedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPost(dAtA[iNdEx:])
@@ -0,0 +1,587 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/post_revision.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PostRevision is a previous version of a post. It is recorded when an edit
+// replaces the revision: editor, height and time are those of that edit.
+type PostRevision struct {
+	PostID   uint64    `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Revision uint64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title    string    `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string    `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Editor   string    `protobuf:"bytes,5,opt,name=editor,proto3" json:"editor,omitempty"`
+	Height   int64     `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PostRevision) Reset()         { *m = PostRevision{} }
+func (m *PostRevision) String() string { return proto.CompactTextString(m) }
+func (*PostRevision) ProtoMessage()    {}
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9f4817bdc508f2b, []int{0}
+}
+func (m *PostRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRevision.Merge(m, src)
+}
+func (m *PostRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostRevision proto.InternalMessageInfo
+
+func (m *PostRevision) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
+	}
+	return 0
+}
+
+func (m *PostRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *PostRevision) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PostRevision) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *PostRevision) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *PostRevision) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PostRevision) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PostRevision)(nil), "planet.blog.PostRevision")
+}
+
+func init() { proto.RegisterFile("planet/blog/post_revision.proto", fileDescriptor_d9f4817bdc508f2b) }
+
+var fileDescriptor_d9f4817bdc508f2b = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0x31, 0x4f, 0xc3, 0x30,
+	0x10, 0x85, 0x63, 0x9a, 0xa6, 0xc5, 0x65, 0x32, 0x15, 0xb2, 0x32, 0x38, 0x11, 0x53, 0x16, 0x12,
+	0x09, 0x16, 0xe6, 0x8a, 0x85, 0x0d, 0x45, 0x4c, 0x2c, 0xa8, 0x01, 0xe3, 0x5a, 0x4a, 0x73, 0x51,
+	0x72, 0x20, 0xf8, 0x17, 0xfd, 0x59, 0x1d, 0x3b, 0x32, 0x20, 0x40, 0xc9, 0x1f, 0x41, 0xb6, 0x13,
+	0xb6, 0x7c, 0xef, 0x5e, 0xee, 0xf9, 0x1d, 0x8d, 0xea, 0x72, 0x5d, 0x49, 0xcc, 0x8a, 0x12, 0x54,
+	0x56, 0x43, 0x8b, 0x8f, 0x8d, 0x7c, 0xd3, 0xad, 0x86, 0x2a, 0xad, 0x1b, 0x40, 0x60, 0x0b, 0x67,
+	0x48, 0x8d, 0x21, 0x5c, 0x2a, 0x50, 0x60, 0xf5, 0xcc, 0x7c, 0x39, 0x4b, 0x18, 0x29, 0x00, 0x55,
+	0xca, 0xcc, 0x52, 0xf1, 0xfa, 0x92, 0xa1, 0xde, 0xca, 0x16, 0xd7, 0xdb, 0xda, 0x19, 0xce, 0xbf,
+	0x08, 0x3d, 0xb9, 0x83, 0x16, 0xf3, 0x61, 0x35, 0x3b, 0xa3, 0x81, 0xc9, 0xba, 0xbd, 0xe1, 0x24,
+	0x26, 0x89, 0x9f, 0x0f, 0xc4, 0x42, 0x3a, 0x1f, 0xe3, 0xf9, 0x91, 0x9d, 0xfc, 0x33, 0x5b, 0xd2,
+	0x29, 0x6a, 0x2c, 0x25, 0x9f, 0xc4, 0x24, 0x39, 0xce, 0x1d, 0x30, 0x4e, 0x67, 0x4f, 0x50, 0xa1,
+	0xac, 0x90, 0xfb, 0x56, 0x1f, 0xd1, 0x64, 0xc8, 0x67, 0x8d, 0xd0, 0xf0, 0xa9, 0x1d, 0x0c, 0x64,
+	0xf4, 0x8d, 0xd4, 0x6a, 0x83, 0x3c, 0x88, 0x49, 0x32, 0xc9, 0x07, 0x62, 0xd7, 0xd4, 0x37, 0xef,
+	0xe6, 0xb3, 0x98, 0x24, 0x8b, 0xcb, 0x30, 0x75, 0xa5, 0xd2, 0xb1, 0x54, 0x7a, 0x3f, 0x96, 0x5a,
+	0xcd, 0xf7, 0xdf, 0x91, 0xb7, 0xfb, 0x89, 0x48, 0x6e, 0xff, 0x58, 0x5d, 0xec, 0x3b, 0x41, 0x0e,
+	0x9d, 0x20, 0xbf, 0x9d, 0x20, 0xbb, 0x5e, 0x78, 0x87, 0x5e, 0x78, 0x9f, 0xbd, 0xf0, 0x1e, 0x4e,
+	0x87, 0xeb, 0xbe, 0xbb, 0xfb, 0xe2, 0x47, 0x2d, 0xdb, 0x22, 0xb0, 0x2b, 0xaf, 0xfe, 0x06, 0x00,
+	0x8c, 0x85, 0x3d, 0xda, 0x7b, 0x01, 0x00, 0x00,
+}
+
+func (m *PostRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPostRevision(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintPostRevision(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintPostRevision(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPostRevision(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPostRevision(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPostRevision(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostID != 0 {
+		i = encodeVarintPostRevision(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPostRevision(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPostRevision(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PostRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovPostRevision(uint64(m.PostID))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPostRevision(uint64(m.Revision))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPostRevision(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPostRevision(uint64(l))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovPostRevision(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPostRevision(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPostRevision(uint64(l))
+	return n
+}
+
+func sovPostRevision(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPostRevision(x uint64) (n int) {
+	return sovPostRevision(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PostRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPostRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPostRevision(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPostRevision
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPostRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPostRevision
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPostRevision
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPostRevision
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPostRevision        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPostRevision          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPostRevision = fmt.Errorf("proto: unexpected end of group")
+)
@@ -297,6 +297,318 @@ func (m *QueryAllPostResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryPostByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostByCreatorRequest) Reset()         { *m = QueryPostByCreatorRequest{} }
+func (m *QueryPostByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostByCreatorRequest) ProtoMessage()    {}
+func (*QueryPostByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{6}
+}
+func (m *QueryPostByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostByCreatorRequest.Merge(m, src)
+}
+func (m *QueryPostByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryPostByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryPostByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostByCreatorResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostByCreatorResponse) Reset()         { *m = QueryPostByCreatorResponse{} }
+func (m *QueryPostByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostByCreatorResponse) ProtoMessage()    {}
+func (*QueryPostByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{7}
+}
+func (m *QueryPostByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostByCreatorResponse.Merge(m, src)
+}
+func (m *QueryPostByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryPostByCreatorResponse) GetPost() []Post {
+	if m != nil {
+		return m.Post
+	}
+	return nil
+}
+
+func (m *QueryPostByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostByChannelRequest struct {
+	ChannelID  string             `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostByChannelRequest) Reset()         { *m = QueryPostByChannelRequest{} }
+func (m *QueryPostByChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostByChannelRequest) ProtoMessage()    {}
+func (*QueryPostByChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{8}
+}
+func (m *QueryPostByChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostByChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostByChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostByChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostByChannelRequest.Merge(m, src)
+}
+func (m *QueryPostByChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostByChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostByChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostByChannelRequest proto.InternalMessageInfo
+
+func (m *QueryPostByChannelRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *QueryPostByChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostByChannelResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostByChannelResponse) Reset()         { *m = QueryPostByChannelResponse{} }
+func (m *QueryPostByChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostByChannelResponse) ProtoMessage()    {}
+func (*QueryPostByChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{9}
+}
+func (m *QueryPostByChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostByChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostByChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostByChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostByChannelResponse.Merge(m, src)
+}
+func (m *QueryPostByChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostByChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostByChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostByChannelResponse proto.InternalMessageInfo
+
+func (m *QueryPostByChannelResponse) GetPost() []Post {
+	if m != nil {
+		return m.Post
+	}
+	return nil
+}
+
+func (m *QueryPostByChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostByChainRequest struct {
+	ChainID    string             `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostByChainRequest) Reset()         { *m = QueryPostByChainRequest{} }
+func (m *QueryPostByChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostByChainRequest) ProtoMessage()    {}
+func (*QueryPostByChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{10}
+}
+func (m *QueryPostByChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostByChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostByChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostByChainRequest.Merge(m, src)
+}
+func (m *QueryPostByChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostByChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostByChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostByChainRequest proto.InternalMessageInfo
+
+func (m *QueryPostByChainRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryPostByChainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostByChainResponse struct {
+	Post       []Post              `protobuf:"bytes,1,rep,name=Post,proto3" json:"Post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostByChainResponse) Reset()         { *m = QueryPostByChainResponse{} }
+func (m *QueryPostByChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostByChainResponse) ProtoMessage()    {}
+func (*QueryPostByChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{11}
+}
+func (m *QueryPostByChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostByChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostByChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostByChainResponse.Merge(m, src)
+}
+func (m *QueryPostByChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostByChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostByChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostByChainResponse proto.InternalMessageInfo
+
+func (m *QueryPostByChainResponse) GetPost() []Post {
+	if m != nil {
+		return m.Post
+	}
+	return nil
+}
+
+func (m *QueryPostByChainResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetSentPostRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -305,7 +617,7 @@ func (m *QueryGetSentPostRequest) Reset()         { *m = QueryGetSentPostRequest
 func (m *QueryGetSentPostRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSentPostRequest) ProtoMessage()    {}
 func (*QueryGetSentPostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{6}
+	return fileDescriptor_b1671705bf75f3f4, []int{12}
 }
 func (m *QueryGetSentPostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +661,7 @@ func (m *QueryGetSentPostResponse) Reset()         { *m = QueryGetSentPostRespon
 func (m *QueryGetSentPostResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSentPostResponse) ProtoMessage()    {}
 func (*QueryGetSentPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{7}
+	return fileDescriptor_b1671705bf75f3f4, []int{13}
 }
 func (m *QueryGetSentPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +705,7 @@ func (m *QueryAllSentPostRequest) Reset()         { *m = QueryAllSentPostRequest
 func (m *QueryAllSentPostRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSentPostRequest) ProtoMessage()    {}
 func (*QueryAllSentPostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{8}
+	return fileDescriptor_b1671705bf75f3f4, []int{14}
 }
 func (m *QueryAllSentPostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +750,7 @@ func (m *QueryAllSentPostResponse) Reset()         { *m = QueryAllSentPostRespon
 func (m *QueryAllSentPostResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSentPostResponse) ProtoMessage()    {}
 func (*QueryAllSentPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{9}
+	return fileDescriptor_b1671705bf75f3f4, []int{15}
 }
 func (m *QueryAllSentPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,22 +793,23 @@ func (m *QueryAllSentPostResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type QueryGetTimeoutPostRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type QuerySentPostByRemoteRequest struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PostID    string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
 }
 
-func (m *QueryGetTimeoutPostRequest) Reset()         { *m = QueryGetTimeoutPostRequest{} }
-func (m *QueryGetTimeoutPostRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetTimeoutPostRequest) ProtoMessage()    {}
-func (*QueryGetTimeoutPostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{10}
+func (m *QuerySentPostByRemoteRequest) Reset()         { *m = QuerySentPostByRemoteRequest{} }
+func (m *QuerySentPostByRemoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySentPostByRemoteRequest) ProtoMessage()    {}
+func (*QuerySentPostByRemoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{16}
 }
-func (m *QueryGetTimeoutPostRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySentPostByRemoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetTimeoutPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySentPostByRemoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetTimeoutPostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySentPostByRemoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -506,41 +819,48 @@ func (m *QueryGetTimeoutPostRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetTimeoutPostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetTimeoutPostRequest.Merge(m, src)
+func (m *QuerySentPostByRemoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySentPostByRemoteRequest.Merge(m, src)
 }
-func (m *QueryGetTimeoutPostRequest) XXX_Size() int {
+func (m *QuerySentPostByRemoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetTimeoutPostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetTimeoutPostRequest.DiscardUnknown(m)
+func (m *QuerySentPostByRemoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySentPostByRemoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetTimeoutPostRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySentPostByRemoteRequest proto.InternalMessageInfo
 
-func (m *QueryGetTimeoutPostRequest) GetId() uint64 {
+func (m *QuerySentPostByRemoteRequest) GetChannelID() string {
 	if m != nil {
-		return m.Id
+		return m.ChannelID
 	}
-	return 0
+	return ""
 }
 
-type QueryGetTimeoutPostResponse struct {
-	TimeoutPost TimeoutPost `protobuf:"bytes,1,opt,name=TimeoutPost,proto3" json:"TimeoutPost"`
+func (m *QuerySentPostByRemoteRequest) GetPostID() string {
+	if m != nil {
+		return m.PostID
+	}
+	return ""
 }
 
-func (m *QueryGetTimeoutPostResponse) Reset()         { *m = QueryGetTimeoutPostResponse{} }
-func (m *QueryGetTimeoutPostResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetTimeoutPostResponse) ProtoMessage()    {}
-func (*QueryGetTimeoutPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{11}
+type QuerySentPostByRemoteResponse struct {
+	SentPost SentPost `protobuf:"bytes,1,opt,name=SentPost,proto3" json:"SentPost"`
 }
-func (m *QueryGetTimeoutPostResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QuerySentPostByRemoteResponse) Reset()         { *m = QuerySentPostByRemoteResponse{} }
+func (m *QuerySentPostByRemoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySentPostByRemoteResponse) ProtoMessage()    {}
+func (*QuerySentPostByRemoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{17}
+}
+func (m *QuerySentPostByRemoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetTimeoutPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySentPostByRemoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetTimeoutPostResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySentPostByRemoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -550,26 +870,218 @@ func (m *QueryGetTimeoutPostResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetTimeoutPostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetTimeoutPostResponse.Merge(m, src)
+func (m *QuerySentPostByRemoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySentPostByRemoteResponse.Merge(m, src)
 }
-func (m *QueryGetTimeoutPostResponse) XXX_Size() int {
+func (m *QuerySentPostByRemoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetTimeoutPostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetTimeoutPostResponse.DiscardUnknown(m)
+func (m *QuerySentPostByRemoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySentPostByRemoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetTimeoutPostResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySentPostByRemoteResponse proto.InternalMessageInfo
 
-func (m *QueryGetTimeoutPostResponse) GetTimeoutPost() TimeoutPost {
+func (m *QuerySentPostByRemoteResponse) GetSentPost() SentPost {
 	if m != nil {
-		return m.TimeoutPost
+		return m.SentPost
 	}
-	return TimeoutPost{}
+	return SentPost{}
 }
 
-type QueryAllTimeoutPostRequest struct {
+type QuerySentPostByChainRequest struct {
+	ChainID    string             `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySentPostByChainRequest) Reset()         { *m = QuerySentPostByChainRequest{} }
+func (m *QuerySentPostByChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySentPostByChainRequest) ProtoMessage()    {}
+func (*QuerySentPostByChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{18}
+}
+func (m *QuerySentPostByChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySentPostByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySentPostByChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySentPostByChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySentPostByChainRequest.Merge(m, src)
+}
+func (m *QuerySentPostByChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySentPostByChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySentPostByChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySentPostByChainRequest proto.InternalMessageInfo
+
+func (m *QuerySentPostByChainRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QuerySentPostByChainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySentPostByChainResponse struct {
+	SentPost   []SentPost          `protobuf:"bytes,1,rep,name=SentPost,proto3" json:"SentPost"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySentPostByChainResponse) Reset()         { *m = QuerySentPostByChainResponse{} }
+func (m *QuerySentPostByChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySentPostByChainResponse) ProtoMessage()    {}
+func (*QuerySentPostByChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{19}
+}
+func (m *QuerySentPostByChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySentPostByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySentPostByChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySentPostByChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySentPostByChainResponse.Merge(m, src)
+}
+func (m *QuerySentPostByChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySentPostByChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySentPostByChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySentPostByChainResponse proto.InternalMessageInfo
+
+func (m *QuerySentPostByChainResponse) GetSentPost() []SentPost {
+	if m != nil {
+		return m.SentPost
+	}
+	return nil
+}
+
+func (m *QuerySentPostByChainResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetTimeoutPostRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetTimeoutPostRequest) Reset()         { *m = QueryGetTimeoutPostRequest{} }
+func (m *QueryGetTimeoutPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTimeoutPostRequest) ProtoMessage()    {}
+func (*QueryGetTimeoutPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{20}
+}
+func (m *QueryGetTimeoutPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTimeoutPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTimeoutPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTimeoutPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTimeoutPostRequest.Merge(m, src)
+}
+func (m *QueryGetTimeoutPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTimeoutPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTimeoutPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTimeoutPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetTimeoutPostRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetTimeoutPostResponse struct {
+	TimeoutPost TimeoutPost `protobuf:"bytes,1,opt,name=TimeoutPost,proto3" json:"TimeoutPost"`
+}
+
+func (m *QueryGetTimeoutPostResponse) Reset()         { *m = QueryGetTimeoutPostResponse{} }
+func (m *QueryGetTimeoutPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTimeoutPostResponse) ProtoMessage()    {}
+func (*QueryGetTimeoutPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{21}
+}
+func (m *QueryGetTimeoutPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTimeoutPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTimeoutPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTimeoutPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTimeoutPostResponse.Merge(m, src)
+}
+func (m *QueryGetTimeoutPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTimeoutPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTimeoutPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTimeoutPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetTimeoutPostResponse) GetTimeoutPost() TimeoutPost {
+	if m != nil {
+		return m.TimeoutPost
+	}
+	return TimeoutPost{}
+}
+
+type QueryAllTimeoutPostRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
@@ -577,7 +1089,7 @@ func (m *QueryAllTimeoutPostRequest) Reset()         { *m = QueryAllTimeoutPostR
 func (m *QueryAllTimeoutPostRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTimeoutPostRequest) ProtoMessage()    {}
 func (*QueryAllTimeoutPostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{12}
+	return fileDescriptor_b1671705bf75f3f4, []int{22}
 }
 func (m *QueryAllTimeoutPostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,7 +1134,7 @@ func (m *QueryAllTimeoutPostResponse) Reset()         { *m = QueryAllTimeoutPost
 func (m *QueryAllTimeoutPostResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTimeoutPostResponse) ProtoMessage()    {}
 func (*QueryAllTimeoutPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1671705bf75f3f4, []int{13}
+	return fileDescriptor_b1671705bf75f3f4, []int{23}
 }
 func (m *QueryAllTimeoutPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,1071 +1177,6890 @@ func (m *QueryAllTimeoutPostResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "planet.blog.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "planet.blog.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPostRequest)(nil), "planet.blog.QueryGetPostRequest")
-	proto.RegisterType((*QueryGetPostResponse)(nil), "planet.blog.QueryGetPostResponse")
-	proto.RegisterType((*QueryAllPostRequest)(nil), "planet.blog.QueryAllPostRequest")
-	proto.RegisterType((*QueryAllPostResponse)(nil), "planet.blog.QueryAllPostResponse")
-	proto.RegisterType((*QueryGetSentPostRequest)(nil), "planet.blog.QueryGetSentPostRequest")
-	proto.RegisterType((*QueryGetSentPostResponse)(nil), "planet.blog.QueryGetSentPostResponse")
-	proto.RegisterType((*QueryAllSentPostRequest)(nil), "planet.blog.QueryAllSentPostRequest")
-	proto.RegisterType((*QueryAllSentPostResponse)(nil), "planet.blog.QueryAllSentPostResponse")
-	proto.RegisterType((*QueryGetTimeoutPostRequest)(nil), "planet.blog.QueryGetTimeoutPostRequest")
-	proto.RegisterType((*QueryGetTimeoutPostResponse)(nil), "planet.blog.QueryGetTimeoutPostResponse")
-	proto.RegisterType((*QueryAllTimeoutPostRequest)(nil), "planet.blog.QueryAllTimeoutPostRequest")
-	proto.RegisterType((*QueryAllTimeoutPostResponse)(nil), "planet.blog.QueryAllTimeoutPostResponse")
+type QueryGetOutboundPostRequest struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func init() { proto.RegisterFile("planet/blog/query.proto", fileDescriptor_b1671705bf75f3f4) }
-
-var fileDescriptor_b1671705bf75f3f4 = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x4f, 0x14, 0x41,
-	0x10, 0xc7, 0x77, 0x16, 0x5c, 0x4d, 0x6f, 0x42, 0x42, 0x2f, 0x8f, 0x65, 0x56, 0x07, 0x18, 0x79,
-	0xf9, 0x9a, 0x0e, 0x78, 0xf0, 0xea, 0x62, 0x22, 0x1e, 0x71, 0xf1, 0x64, 0x62, 0xc8, 0xac, 0xdb,
-	0x19, 0x27, 0x0e, 0xd3, 0x03, 0xdd, 0x18, 0xf1, 0x71, 0xf1, 0x60, 0x3c, 0x78, 0x30, 0xf1, 0xea,
-	0x07, 0xf0, 0xa3, 0x70, 0x24, 0xf1, 0xa0, 0x27, 0x63, 0xc0, 0x0f, 0x62, 0xa6, 0xbb, 0x16, 0xba,
-	0x77, 0x7a, 0x59, 0x4c, 0xf6, 0x06, 0x5d, 0xff, 0xae, 0xff, 0xaf, 0xaa, 0xe8, 0x1a, 0xd0, 0x74,
-	0x96, 0x84, 0x29, 0x15, 0xa4, 0x9d, 0xb0, 0x88, 0xec, 0xee, 0xd3, 0xbd, 0x83, 0x20, 0xdb, 0x63,
-	0x82, 0xe1, 0xaa, 0x0a, 0x04, 0x79, 0xc0, 0x9d, 0x88, 0x58, 0xc4, 0xe4, 0x39, 0xc9, 0x7f, 0x52,
-	0x12, 0xf7, 0x6a, 0xc4, 0x58, 0x94, 0x50, 0x12, 0x66, 0x31, 0x09, 0xd3, 0x94, 0x89, 0x50, 0xc4,
-	0x2c, 0xe5, 0x10, 0xbd, 0xf9, 0x9c, 0xf1, 0x1d, 0xc6, 0x49, 0x3b, 0xe4, 0x54, 0x65, 0x26, 0xaf,
-	0x56, 0xdb, 0x54, 0x84, 0xab, 0x24, 0x0b, 0xa3, 0x38, 0x95, 0x62, 0xd0, 0xd6, 0x75, 0x8a, 0x2c,
-	0xdc, 0x0b, 0x77, 0xba, 0x59, 0xa6, 0x8c, 0x08, 0xe3, 0x02, 0xce, 0x1b, 0xfa, 0x39, 0xa7, 0xa9,
-	0xd8, 0xd6, 0x82, 0x9e, 0x1e, 0x14, 0xf1, 0x0e, 0x65, 0xfb, 0x7a, 0xdc, 0x9f, 0x40, 0xf8, 0x71,
-	0x0e, 0xb4, 0x29, 0x9d, 0x5a, 0x74, 0x77, 0x9f, 0x72, 0xe1, 0x3f, 0x42, 0x35, 0xe3, 0x94, 0x67,
-	0x2c, 0xe5, 0x14, 0xaf, 0xa2, 0x8a, 0x22, 0xaa, 0x3b, 0x73, 0xce, 0x4a, 0x75, 0xad, 0x16, 0x68,
-	0x9d, 0x09, 0x94, 0x78, 0x7d, 0xf4, 0xf0, 0xf7, 0x6c, 0xa9, 0x05, 0x42, 0x7f, 0x11, 0x32, 0x6d,
-	0x50, 0xb1, 0xc9, 0xb8, 0x00, 0x03, 0x3c, 0x86, 0xca, 0x71, 0x47, 0x66, 0x19, 0x6d, 0x95, 0xe3,
-	0x8e, 0xff, 0x00, 0x4d, 0x98, 0x32, 0x70, 0xbc, 0x85, 0x46, 0xf3, 0xdf, 0xc1, 0x6f, 0xdc, 0xf4,
-	0x63, 0x5c, 0x80, 0x9b, 0x14, 0xf9, 0xcf, 0xc0, 0xab, 0x99, 0x24, 0xba, 0xd7, 0x43, 0x84, 0xce,
-	0xba, 0x0c, 0x99, 0x96, 0x02, 0x35, 0x92, 0x20, 0x1f, 0x49, 0xa0, 0x86, 0x0d, 0x23, 0x09, 0x36,
-	0xc3, 0x88, 0xc2, 0xdd, 0x96, 0x76, 0xd3, 0xff, 0xec, 0x00, 0xe4, 0x69, 0xfe, 0x02, 0xe4, 0xc8,
-	0x40, 0x48, 0xbc, 0x61, 0xd0, 0x94, 0x25, 0xcd, 0xf2, 0x40, 0x1a, 0xe5, 0x64, 0xe0, 0xdc, 0x40,
-	0xd3, 0xdd, 0x96, 0x6d, 0xd1, 0xf4, 0xdc, 0xee, 0x6e, 0xa1, 0x7a, 0x51, 0x0a, 0xf0, 0xf7, 0xd0,
-	0x95, 0xee, 0x19, 0xf4, 0x66, 0xd2, 0x28, 0xa0, 0x1b, 0x84, 0x22, 0x4e, 0xc5, 0x7e, 0x08, 0xfe,
-	0xcd, 0x24, 0xe9, 0xf5, 0x1f, 0x56, 0xc7, 0xbf, 0x39, 0x00, 0x6e, 0x78, 0x58, 0xc1, 0x47, 0x2e,
-	0x0c, 0x3e, 0xbc, 0x09, 0xdc, 0x46, 0x6e, 0xb7, 0xad, 0x4f, 0xd4, 0xcb, 0x3a, 0x6f, 0x08, 0xdb,
-	0xa8, 0x61, 0x55, 0x43, 0x39, 0xf7, 0x51, 0x55, 0x3b, 0x86, 0xa6, 0xd5, 0x8d, 0x8a, 0xb4, 0x38,
-	0x14, 0xa5, 0x5f, 0xf1, 0x3b, 0x80, 0xd3, 0x4c, 0x12, 0x0b, 0xce, 0xb0, 0x66, 0xf2, 0xdd, 0x81,
-	0x3a, 0x7a, 0x6d, 0xfa, 0xd5, 0x31, 0xf2, 0x9f, 0x75, 0x0c, 0x6d, 0x3e, 0x6b, 0x3f, 0x2b, 0xe8,
-	0x92, 0x44, 0xc5, 0x2f, 0x50, 0x45, 0x6d, 0x27, 0x3c, 0x6b, 0x90, 0x14, 0x57, 0x9f, 0x3b, 0xd7,
-	0x5f, 0xa0, 0x2c, 0xfc, 0xc6, 0x87, 0x1f, 0x7f, 0xbf, 0x96, 0x27, 0x71, 0x8d, 0x14, 0x57, 0x35,
-	0x7e, 0xa9, 0x76, 0x01, 0xb6, 0xa4, 0x31, 0x57, 0xa0, 0x3b, 0x7f, 0x8e, 0x02, 0x9c, 0x3c, 0xe9,
-	0x54, 0xc7, 0x53, 0xa4, 0x77, 0xf5, 0x93, 0xb7, 0x71, 0xe7, 0x3d, 0x8e, 0xd1, 0xe5, 0x5c, 0xdf,
-	0x4c, 0x12, 0x9b, 0x9f, 0xb9, 0x06, 0x6d, 0x7e, 0x3d, 0x8b, 0xcc, 0x9f, 0x91, 0x7e, 0x35, 0x3c,
-	0x5e, 0xf0, 0xc3, 0xef, 0xce, 0x5e, 0x1b, 0x5e, 0xb0, 0x92, 0xf7, 0x2c, 0x01, 0x77, 0x71, 0x80,
-	0x0a, 0x3c, 0xaf, 0x4b, 0xcf, 0x6b, 0xb8, 0x41, 0xac, 0x9f, 0x31, 0x55, 0xe8, 0x1b, 0x54, 0xed,
-	0x5e, 0xcc, 0x8b, 0x5d, 0xb0, 0x96, 0x72, 0x01, 0x00, 0xcb, 0x1e, 0xe9, 0xd3, 0xe4, 0x53, 0x00,
-	0xfc, 0xc9, 0x31, 0xfe, 0xa2, 0xf1, 0xb2, 0xb5, 0xae, 0xe2, 0x8b, 0x73, 0x57, 0x06, 0x0b, 0x01,
-	0x61, 0x49, 0x22, 0xcc, 0x61, 0x8f, 0xf4, 0xfb, 0x5a, 0xab, 0x36, 0x7c, 0x74, 0xd0, 0x98, 0x76,
-	0x3f, 0x6f, 0xc5, 0xb2, 0xb5, 0xc8, 0x8b, 0xd1, 0xd8, 0x5f, 0xb0, 0x3f, 0x2f, 0x69, 0x1a, 0x78,
-	0xa6, 0x2f, 0xcd, 0xfa, 0x9d, 0xc3, 0x63, 0xcf, 0x39, 0x3a, 0xf6, 0x9c, 0x3f, 0xc7, 0x9e, 0xf3,
-	0xe5, 0xc4, 0x2b, 0x1d, 0x9d, 0x78, 0xa5, 0x5f, 0x27, 0x5e, 0xe9, 0x69, 0x0d, 0xee, 0xbc, 0x86,
-	0x5b, 0x07, 0x19, 0xe5, 0xed, 0x8a, 0xfc, 0x5f, 0xe3, 0xee, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe5, 0x9b, 0x31, 0x2b, 0x62, 0x09, 0x00, 0x00,
+func (m *QueryGetOutboundPostRequest) Reset()         { *m = QueryGetOutboundPostRequest{} }
+func (m *QueryGetOutboundPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutboundPostRequest) ProtoMessage()    {}
+func (*QueryGetOutboundPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{24}
+}
+func (m *QueryGetOutboundPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutboundPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutboundPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutboundPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutboundPostRequest.Merge(m, src)
+}
+func (m *QueryGetOutboundPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutboundPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutboundPostRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetOutboundPostRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Post items.
-	Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error)
-	PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error)
-	// Queries a list of SentPost items.
-	SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error)
-	SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error)
-	// Queries a list of TimeoutPost items.
-	TimeoutPost(ctx context.Context, in *QueryGetTimeoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimeoutPostResponse, error)
-	TimeoutPostAll(ctx context.Context, in *QueryAllTimeoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimeoutPostResponse, error)
+func (m *QueryGetOutboundPostRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetOutboundPostRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryGetOutboundPostResponse struct {
+	OutboundPost OutboundPost `protobuf:"bytes,1,opt,name=OutboundPost,proto3" json:"OutboundPost"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetOutboundPostResponse) Reset()         { *m = QueryGetOutboundPostResponse{} }
+func (m *QueryGetOutboundPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutboundPostResponse) ProtoMessage()    {}
+func (*QueryGetOutboundPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{25}
 }
-
-func (c *queryClient) Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error) {
-	out := new(QueryGetPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/Post", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetOutboundPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutboundPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutboundPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetOutboundPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutboundPostResponse.Merge(m, src)
+}
+func (m *QueryGetOutboundPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutboundPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutboundPostResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error) {
-	out := new(QueryAllPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetOutboundPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetOutboundPostResponse) GetOutboundPost() OutboundPost {
+	if m != nil {
+		return m.OutboundPost
 	}
-	return out, nil
+	return OutboundPost{}
 }
 
-func (c *queryClient) SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error) {
-	out := new(QueryGetSentPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPost", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllOutboundPostRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the records, all records are listed when unspecified
+	Status OutboundPostStatus `protobuf:"varint,2,opt,name=status,proto3,enum=planet.blog.OutboundPostStatus" json:"status,omitempty"`
+	// uploadID filters the records of the chunks of an upload
+	UploadID string `protobuf:"bytes,3,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
 }
 
-func (c *queryClient) SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error) {
-	out := new(QueryAllSentPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllOutboundPostRequest) Reset()         { *m = QueryAllOutboundPostRequest{} }
+func (m *QueryAllOutboundPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutboundPostRequest) ProtoMessage()    {}
+func (*QueryAllOutboundPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{26}
+}
+func (m *QueryAllOutboundPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllOutboundPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOutboundPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllOutboundPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutboundPostRequest.Merge(m, src)
+}
+func (m *QueryAllOutboundPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllOutboundPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutboundPostRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TimeoutPost(ctx context.Context, in *QueryGetTimeoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimeoutPostResponse, error) {
-	out := new(QueryGetTimeoutPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimeoutPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllOutboundPostRequest proto.InternalMessageInfo
+
+func (m *QueryAllOutboundPostRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TimeoutPostAll(ctx context.Context, in *QueryAllTimeoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimeoutPostResponse, error) {
-	out := new(QueryAllTimeoutPostResponse)
-	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimeoutPostAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllOutboundPostRequest) GetStatus() OutboundPostStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return OutboundPostStatusUnspecified
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Post items.
-	Post(context.Context, *QueryGetPostRequest) (*QueryGetPostResponse, error)
-	PostAll(context.Context, *QueryAllPostRequest) (*QueryAllPostResponse, error)
-	// Queries a list of SentPost items.
-	SentPost(context.Context, *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error)
-	SentPostAll(context.Context, *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error)
-	// Queries a list of TimeoutPost items.
-	TimeoutPost(context.Context, *QueryGetTimeoutPostRequest) (*QueryGetTimeoutPostResponse, error)
-	TimeoutPostAll(context.Context, *QueryAllTimeoutPostRequest) (*QueryAllTimeoutPostResponse, error)
+func (m *QueryAllOutboundPostRequest) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryAllOutboundPostResponse struct {
+	OutboundPost []OutboundPost      `protobuf:"bytes,1,rep,name=OutboundPost,proto3" json:"OutboundPost"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Post(ctx context.Context, req *QueryGetPostRequest) (*QueryGetPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+func (m *QueryAllOutboundPostResponse) Reset()         { *m = QueryAllOutboundPostResponse{} }
+func (m *QueryAllOutboundPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutboundPostResponse) ProtoMessage()    {}
+func (*QueryAllOutboundPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{27}
 }
-func (*UnimplementedQueryServer) PostAll(ctx context.Context, req *QueryAllPostRequest) (*QueryAllPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostAll not implemented")
+func (m *QueryAllOutboundPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SentPost(ctx context.Context, req *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SentPost not implemented")
+func (m *QueryAllOutboundPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOutboundPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SentPostAll(ctx context.Context, req *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SentPostAll not implemented")
+func (m *QueryAllOutboundPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutboundPostResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TimeoutPost(ctx context.Context, req *QueryGetTimeoutPostRequest) (*QueryGetTimeoutPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPost not implemented")
+func (m *QueryAllOutboundPostResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TimeoutPostAll(ctx context.Context, req *QueryAllTimeoutPostRequest) (*QueryAllTimeoutPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPostAll not implemented")
+func (m *QueryAllOutboundPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutboundPostResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAllOutboundPostResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllOutboundPostResponse) GetOutboundPost() []OutboundPost {
+	if m != nil {
+		return m.OutboundPost
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Post(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/Post",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Post(ctx, req.(*QueryGetPostRequest))
+func (m *QueryAllOutboundPostResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PostAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/PostAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PostAll(ctx, req.(*QueryAllPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetPostRevisionRequest struct {
+	PostID   uint64 `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func _Query_SentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSentPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SentPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/SentPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SentPost(ctx, req.(*QueryGetSentPostRequest))
+func (m *QueryGetPostRevisionRequest) Reset()         { *m = QueryGetPostRevisionRequest{} }
+func (m *QueryGetPostRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPostRevisionRequest) ProtoMessage()    {}
+func (*QueryGetPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{28}
+}
+func (m *QueryGetPostRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPostRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPostRevisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetPostRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPostRevisionRequest.Merge(m, src)
+}
+func (m *QueryGetPostRevisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPostRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPostRevisionRequest.DiscardUnknown(m)
 }
 
-func _Query_SentPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSentPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SentPostAll(ctx, in)
+var xxx_messageInfo_QueryGetPostRevisionRequest proto.InternalMessageInfo
+
+func (m *QueryGetPostRevisionRequest) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/SentPostAll",
+	return 0
+}
+
+func (m *QueryGetPostRevisionRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SentPostAll(ctx, req.(*QueryAllSentPostRequest))
+	return 0
+}
+
+type QueryGetPostRevisionResponse struct {
+	PostRevision PostRevision `protobuf:"bytes,1,opt,name=PostRevision,proto3" json:"PostRevision"`
+}
+
+func (m *QueryGetPostRevisionResponse) Reset()         { *m = QueryGetPostRevisionResponse{} }
+func (m *QueryGetPostRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPostRevisionResponse) ProtoMessage()    {}
+func (*QueryGetPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{29}
+}
+func (m *QueryGetPostRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPostRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPostRevisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetPostRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPostRevisionResponse.Merge(m, src)
+}
+func (m *QueryGetPostRevisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPostRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPostRevisionResponse.DiscardUnknown(m)
 }
 
-func _Query_TimeoutPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTimeoutPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPostRevisionResponse proto.InternalMessageInfo
+
+func (m *QueryGetPostRevisionResponse) GetPostRevision() PostRevision {
+	if m != nil {
+		return m.PostRevision
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TimeoutPost(ctx, in)
+	return PostRevision{}
+}
+
+type QueryPostRevisionsRequest struct {
+	PostID     uint64             `protobuf:"varint,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostRevisionsRequest) Reset()         { *m = QueryPostRevisionsRequest{} }
+func (m *QueryPostRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostRevisionsRequest) ProtoMessage()    {}
+func (*QueryPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{30}
+}
+func (m *QueryPostRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/TimeoutPost",
+}
+func (m *QueryPostRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostRevisionsRequest.Merge(m, src)
+}
+func (m *QueryPostRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostRevisionsRequest proto.InternalMessageInfo
+
+func (m *QueryPostRevisionsRequest) GetPostID() uint64 {
+	if m != nil {
+		return m.PostID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TimeoutPost(ctx, req.(*QueryGetTimeoutPostRequest))
+	return 0
+}
+
+func (m *QueryPostRevisionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TimeoutPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTimeoutPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TimeoutPostAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/planet.blog.Query/TimeoutPostAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TimeoutPostAll(ctx, req.(*QueryAllTimeoutPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPostRevisionsResponse struct {
+	PostRevision []PostRevision      `protobuf:"bytes,1,rep,name=PostRevision,proto3" json:"PostRevision"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "planet.blog.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Post",
-			Handler:    _Query_Post_Handler,
-		},
-		{
-			MethodName: "PostAll",
-			Handler:    _Query_PostAll_Handler,
-		},
-		{
-			MethodName: "SentPost",
-			Handler:    _Query_SentPost_Handler,
-		},
-		{
-			MethodName: "SentPostAll",
-			Handler:    _Query_SentPostAll_Handler,
-		},
-		{
-			MethodName: "TimeoutPost",
-			Handler:    _Query_TimeoutPost_Handler,
-		},
-		{
-			MethodName: "TimeoutPostAll",
-			Handler:    _Query_TimeoutPostAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "planet/blog/query.proto",
+func (m *QueryPostRevisionsResponse) Reset()         { *m = QueryPostRevisionsResponse{} }
+func (m *QueryPostRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostRevisionsResponse) ProtoMessage()    {}
+func (*QueryPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{31}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPostRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostRevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPostRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostRevisionsResponse.Merge(m, src)
+}
+func (m *QueryPostRevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostRevisionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPostRevisionsResponse proto.InternalMessageInfo
+
+func (m *QueryPostRevisionsResponse) GetPostRevision() []PostRevision {
+	if m != nil {
+		return m.PostRevision
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPostRevisionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetBroadcastRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetBroadcastRequest) Reset()         { *m = QueryGetBroadcastRequest{} }
+func (m *QueryGetBroadcastRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBroadcastRequest) ProtoMessage()    {}
+func (*QueryGetBroadcastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{32}
+}
+func (m *QueryGetBroadcastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBroadcastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBroadcastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetBroadcastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBroadcastRequest.Merge(m, src)
+}
+func (m *QueryGetBroadcastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBroadcastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBroadcastRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetBroadcastRequest proto.InternalMessageInfo
+
+func (m *QueryGetBroadcastRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetBroadcastResponse struct {
+	Broadcast Broadcast `protobuf:"bytes,1,opt,name=Broadcast,proto3" json:"Broadcast"`
+	// OutboundPost is the outboundPost of each destination of the broadcast
+	OutboundPost []OutboundPost `protobuf:"bytes,2,rep,name=OutboundPost,proto3" json:"OutboundPost"`
 }
 
-func (m *QueryGetPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetBroadcastResponse) Reset()         { *m = QueryGetBroadcastResponse{} }
+func (m *QueryGetBroadcastResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBroadcastResponse) ProtoMessage()    {}
+func (*QueryGetBroadcastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{33}
+}
+func (m *QueryGetBroadcastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBroadcastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBroadcastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetBroadcastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBroadcastResponse.Merge(m, src)
+}
+func (m *QueryGetBroadcastResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBroadcastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBroadcastResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetBroadcastResponse proto.InternalMessageInfo
+
+func (m *QueryGetBroadcastResponse) GetBroadcast() Broadcast {
+	if m != nil {
+		return m.Broadcast
 	}
-	return dAtA[:n], nil
+	return Broadcast{}
 }
 
-func (m *QueryGetPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetBroadcastResponse) GetOutboundPost() []OutboundPost {
+	if m != nil {
+		return m.OutboundPost
+	}
+	return nil
 }
 
-func (m *QueryGetPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+type QueryGetRemotePostRequest struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PostID    string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
+}
+
+func (m *QueryGetRemotePostRequest) Reset()         { *m = QueryGetRemotePostRequest{} }
+func (m *QueryGetRemotePostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemotePostRequest) ProtoMessage()    {}
+func (*QueryGetRemotePostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{34}
+}
+func (m *QueryGetRemotePostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemotePostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemotePostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetRemotePostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemotePostRequest.Merge(m, src)
 }
-
-func (m *QueryAllPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetRemotePostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemotePostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemotePostRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGetRemotePostRequest proto.InternalMessageInfo
+
+func (m *QueryGetRemotePostRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetRemotePostRequest) GetPostID() string {
+	if m != nil {
+		return m.PostID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetRemotePostResponse struct {
+	RemotePost RemotePost `protobuf:"bytes,1,opt,name=RemotePost,proto3" json:"RemotePost"`
 }
 
-func (m *QueryAllPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Post) > 0 {
-		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetRemotePostResponse) Reset()         { *m = QueryGetRemotePostResponse{} }
+func (m *QueryGetRemotePostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemotePostResponse) ProtoMessage()    {}
+func (*QueryGetRemotePostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{35}
+}
+func (m *QueryGetRemotePostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemotePostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemotePostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetRemotePostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemotePostResponse.Merge(m, src)
+}
+func (m *QueryGetRemotePostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemotePostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemotePostResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetSentPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRemotePostResponse proto.InternalMessageInfo
+
+func (m *QueryGetRemotePostResponse) GetRemotePost() RemotePost {
+	if m != nil {
+		return m.RemotePost
 	}
-	return dAtA[:n], nil
+	return RemotePost{}
 }
 
-func (m *QueryGetSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetReplicationSubscriptionRequest struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
 }
 
-func (m *QueryGetSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetReplicationSubscriptionRequest) Reset() {
+	*m = QueryGetReplicationSubscriptionRequest{}
+}
+func (m *QueryGetReplicationSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReplicationSubscriptionRequest) ProtoMessage()    {}
+func (*QueryGetReplicationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{36}
+}
+func (m *QueryGetReplicationSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetReplicationSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetReplicationSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetReplicationSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReplicationSubscriptionRequest.Merge(m, src)
+}
+func (m *QueryGetReplicationSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetReplicationSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReplicationSubscriptionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetSentPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetReplicationSubscriptionRequest proto.InternalMessageInfo
+
+func (m *QueryGetReplicationSubscriptionRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetReplicationSubscriptionResponse struct {
+	ReplicationSubscription ReplicationSubscription `protobuf:"bytes,1,opt,name=ReplicationSubscription,proto3" json:"ReplicationSubscription"`
 }
 
-func (m *QueryGetSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SentPost.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetReplicationSubscriptionResponse) Reset() {
+	*m = QueryGetReplicationSubscriptionResponse{}
+}
+func (m *QueryGetReplicationSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReplicationSubscriptionResponse) ProtoMessage()    {}
+func (*QueryGetReplicationSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{37}
+}
+func (m *QueryGetReplicationSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetReplicationSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetReplicationSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetReplicationSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReplicationSubscriptionResponse.Merge(m, src)
+}
+func (m *QueryGetReplicationSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetReplicationSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReplicationSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSentPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetReplicationSubscriptionResponse proto.InternalMessageInfo
+
+func (m *QueryGetReplicationSubscriptionResponse) GetReplicationSubscription() ReplicationSubscription {
+	if m != nil {
+		return m.ReplicationSubscription
 	}
-	return dAtA[:n], nil
+	return ReplicationSubscription{}
 }
 
-func (m *QueryAllSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetReplicaRequest struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
 }
 
-func (m *QueryAllSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetReplicaRequest) Reset()         { *m = QueryGetReplicaRequest{} }
+func (m *QueryGetReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReplicaRequest) ProtoMessage()    {}
+func (*QueryGetReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{38}
+}
+func (m *QueryGetReplicaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetReplicaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReplicaRequest.Merge(m, src)
+}
+func (m *QueryGetReplicaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReplicaRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllSentPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetReplicaRequest proto.InternalMessageInfo
+
+func (m *QueryGetReplicaRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetReplicaResponse struct {
+	Replica Replica `protobuf:"bytes,1,opt,name=Replica,proto3" json:"Replica"`
 }
 
-func (m *QueryAllSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SentPost) > 0 {
-		for iNdEx := len(m.SentPost) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SentPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetReplicaResponse) Reset()         { *m = QueryGetReplicaResponse{} }
+func (m *QueryGetReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReplicaResponse) ProtoMessage()    {}
+func (*QueryGetReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{39}
+}
+func (m *QueryGetReplicaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetReplicaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReplicaResponse.Merge(m, src)
+}
+func (m *QueryGetReplicaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReplicaResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTimeoutPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetReplicaResponse proto.InternalMessageInfo
+
+func (m *QueryGetReplicaResponse) GetReplica() Replica {
+	if m != nil {
+		return m.Replica
 	}
-	return dAtA[:n], nil
+	return Replica{}
 }
 
-func (m *QueryGetTimeoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetReplicatedPostRequest struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PostID    string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
 }
 
-func (m *QueryGetTimeoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetReplicatedPostRequest) Reset()         { *m = QueryGetReplicatedPostRequest{} }
+func (m *QueryGetReplicatedPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReplicatedPostRequest) ProtoMessage()    {}
+func (*QueryGetReplicatedPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{40}
 }
-
-func (m *QueryGetTimeoutPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetReplicatedPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetReplicatedPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetReplicatedPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetTimeoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetReplicatedPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReplicatedPostRequest.Merge(m, src)
+}
+func (m *QueryGetReplicatedPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetReplicatedPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReplicatedPostRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTimeoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TimeoutPost.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryGetReplicatedPostRequest proto.InternalMessageInfo
+
+func (m *QueryGetReplicatedPostRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllTimeoutPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetReplicatedPostRequest) GetPostID() string {
+	if m != nil {
+		return m.PostID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllTimeoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetReplicatedPostResponse struct {
+	ReplicatedPost RemotePost `protobuf:"bytes,1,opt,name=ReplicatedPost,proto3" json:"ReplicatedPost"`
 }
 
-func (m *QueryAllTimeoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetReplicatedPostResponse) Reset()         { *m = QueryGetReplicatedPostResponse{} }
+func (m *QueryGetReplicatedPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReplicatedPostResponse) ProtoMessage()    {}
+func (*QueryGetReplicatedPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1671705bf75f3f4, []int{41}
+}
+func (m *QueryGetReplicatedPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetReplicatedPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetReplicatedPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetReplicatedPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReplicatedPostResponse.Merge(m, src)
+}
+func (m *QueryGetReplicatedPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetReplicatedPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReplicatedPostResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllTimeoutPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetReplicatedPostResponse proto.InternalMessageInfo
+
+func (m *QueryGetReplicatedPostResponse) GetReplicatedPost() RemotePost {
+	if m != nil {
+		return m.ReplicatedPost
 	}
-	return dAtA[:n], nil
+	return RemotePost{}
 }
 
-func (m *QueryAllTimeoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "planet.blog.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "planet.blog.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPostRequest)(nil), "planet.blog.QueryGetPostRequest")
+	proto.RegisterType((*QueryGetPostResponse)(nil), "planet.blog.QueryGetPostResponse")
+	proto.RegisterType((*QueryAllPostRequest)(nil), "planet.blog.QueryAllPostRequest")
+	proto.RegisterType((*QueryAllPostResponse)(nil), "planet.blog.QueryAllPostResponse")
+	proto.RegisterType((*QueryPostByCreatorRequest)(nil), "planet.blog.QueryPostByCreatorRequest")
+	proto.RegisterType((*QueryPostByCreatorResponse)(nil), "planet.blog.QueryPostByCreatorResponse")
+	proto.RegisterType((*QueryPostByChannelRequest)(nil), "planet.blog.QueryPostByChannelRequest")
+	proto.RegisterType((*QueryPostByChannelResponse)(nil), "planet.blog.QueryPostByChannelResponse")
+	proto.RegisterType((*QueryPostByChainRequest)(nil), "planet.blog.QueryPostByChainRequest")
+	proto.RegisterType((*QueryPostByChainResponse)(nil), "planet.blog.QueryPostByChainResponse")
+	proto.RegisterType((*QueryGetSentPostRequest)(nil), "planet.blog.QueryGetSentPostRequest")
+	proto.RegisterType((*QueryGetSentPostResponse)(nil), "planet.blog.QueryGetSentPostResponse")
+	proto.RegisterType((*QueryAllSentPostRequest)(nil), "planet.blog.QueryAllSentPostRequest")
+	proto.RegisterType((*QueryAllSentPostResponse)(nil), "planet.blog.QueryAllSentPostResponse")
+	proto.RegisterType((*QuerySentPostByRemoteRequest)(nil), "planet.blog.QuerySentPostByRemoteRequest")
+	proto.RegisterType((*QuerySentPostByRemoteResponse)(nil), "planet.blog.QuerySentPostByRemoteResponse")
+	proto.RegisterType((*QuerySentPostByChainRequest)(nil), "planet.blog.QuerySentPostByChainRequest")
+	proto.RegisterType((*QuerySentPostByChainResponse)(nil), "planet.blog.QuerySentPostByChainResponse")
+	proto.RegisterType((*QueryGetTimeoutPostRequest)(nil), "planet.blog.QueryGetTimeoutPostRequest")
+	proto.RegisterType((*QueryGetTimeoutPostResponse)(nil), "planet.blog.QueryGetTimeoutPostResponse")
+	proto.RegisterType((*QueryAllTimeoutPostRequest)(nil), "planet.blog.QueryAllTimeoutPostRequest")
+	proto.RegisterType((*QueryAllTimeoutPostResponse)(nil), "planet.blog.QueryAllTimeoutPostResponse")
+	proto.RegisterType((*QueryGetOutboundPostRequest)(nil), "planet.blog.QueryGetOutboundPostRequest")
+	proto.RegisterType((*QueryGetOutboundPostResponse)(nil), "planet.blog.QueryGetOutboundPostResponse")
+	proto.RegisterType((*QueryAllOutboundPostRequest)(nil), "planet.blog.QueryAllOutboundPostRequest")
+	proto.RegisterType((*QueryAllOutboundPostResponse)(nil), "planet.blog.QueryAllOutboundPostResponse")
+	proto.RegisterType((*QueryGetPostRevisionRequest)(nil), "planet.blog.QueryGetPostRevisionRequest")
+	proto.RegisterType((*QueryGetPostRevisionResponse)(nil), "planet.blog.QueryGetPostRevisionResponse")
+	proto.RegisterType((*QueryPostRevisionsRequest)(nil), "planet.blog.QueryPostRevisionsRequest")
+	proto.RegisterType((*QueryPostRevisionsResponse)(nil), "planet.blog.QueryPostRevisionsResponse")
+	proto.RegisterType((*QueryGetBroadcastRequest)(nil), "planet.blog.QueryGetBroadcastRequest")
+	proto.RegisterType((*QueryGetBroadcastResponse)(nil), "planet.blog.QueryGetBroadcastResponse")
+	proto.RegisterType((*QueryGetRemotePostRequest)(nil), "planet.blog.QueryGetRemotePostRequest")
+	proto.RegisterType((*QueryGetRemotePostResponse)(nil), "planet.blog.QueryGetRemotePostResponse")
+	proto.RegisterType((*QueryGetReplicationSubscriptionRequest)(nil), "planet.blog.QueryGetReplicationSubscriptionRequest")
+	proto.RegisterType((*QueryGetReplicationSubscriptionResponse)(nil), "planet.blog.QueryGetReplicationSubscriptionResponse")
+	proto.RegisterType((*QueryGetReplicaRequest)(nil), "planet.blog.QueryGetReplicaRequest")
+	proto.RegisterType((*QueryGetReplicaResponse)(nil), "planet.blog.QueryGetReplicaResponse")
+	proto.RegisterType((*QueryGetReplicatedPostRequest)(nil), "planet.blog.QueryGetReplicatedPostRequest")
+	proto.RegisterType((*QueryGetReplicatedPostResponse)(nil), "planet.blog.QueryGetReplicatedPostResponse")
 }
 
-func (m *QueryAllTimeoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func init() { proto.RegisterFile("planet/blog/query.proto", fileDescriptor_b1671705bf75f3f4) }
+
+var fileDescriptor_b1671705bf75f3f4 = []byte{
+	// 1665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc0, 0xb3, 0x49, 0xbe, 0x69, 0x33, 0xf9, 0x92, 0xd2, 0x49, 0x9a, 0x38, 0x9b, 0xc4, 0x4d,
+	0xb7, 0x89, 0x9d, 0x1f, 0xad, 0xb7, 0xe9, 0x4f, 0x81, 0x54, 0x89, 0xb4, 0xa5, 0xa1, 0xa7, 0xb6,
+	0x4e, 0x11, 0x08, 0x84, 0xa2, 0xb5, 0xbd, 0x72, 0x2d, 0x36, 0xbb, 0xae, 0x77, 0x5d, 0x11, 0x8c,
+	0xa9, 0xe0, 0x80, 0x10, 0x02, 0x51, 0x15, 0x2e, 0x88, 0x0a, 0x71, 0xe0, 0x50, 0x21, 0xc4, 0x1f,
+	0xc0, 0x85, 0x6b, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0xa8, 0xe5, 0x0f, 0x41, 0x3b, 0xf3, 0x76, 0x77,
+	0x66, 0x77, 0x66, 0xbd, 0x69, 0x0d, 0xe5, 0xe6, 0x99, 0x79, 0xf3, 0xde, 0xe7, 0xbd, 0x79, 0x33,
+	0x9e, 0x37, 0x8b, 0xa6, 0x9b, 0x96, 0x61, 0x9b, 0x9e, 0x5e, 0xb1, 0x9c, 0xba, 0x7e, 0xab, 0x6d,
+	0xb6, 0x76, 0x4b, 0xcd, 0x96, 0xe3, 0x39, 0x78, 0x8c, 0x0e, 0x94, 0xfc, 0x01, 0x75, 0xb2, 0xee,
+	0xd4, 0x1d, 0xd2, 0xaf, 0xfb, 0xbf, 0xa8, 0x88, 0x3a, 0x57, 0x77, 0x9c, 0xba, 0x65, 0xea, 0x46,
+	0xb3, 0xa1, 0x1b, 0xb6, 0xed, 0x78, 0x86, 0xd7, 0x70, 0x6c, 0x17, 0x46, 0x57, 0xab, 0x8e, 0xbb,
+	0xe3, 0xb8, 0x7a, 0xc5, 0x70, 0x4d, 0xaa, 0x59, 0xbf, 0xbd, 0x5e, 0x31, 0x3d, 0x63, 0x5d, 0x6f,
+	0x1a, 0xf5, 0x86, 0x4d, 0x84, 0x41, 0x36, 0xc7, 0x52, 0x34, 0x8d, 0x96, 0xb1, 0x13, 0x68, 0x99,
+	0xe2, 0x46, 0x1c, 0xd7, 0x83, 0xfe, 0x59, 0xb6, 0xdf, 0x35, 0x6d, 0x6f, 0x9b, 0x19, 0xcc, 0xb3,
+	0x83, 0x5e, 0x63, 0xc7, 0x74, 0xda, 0xdc, 0xf8, 0x61, 0x76, 0xdc, 0x69, 0x7b, 0x15, 0xa7, 0x6d,
+	0xd7, 0xa4, 0x02, 0x7e, 0xff, 0x76, 0xcb, 0xbc, 0xdd, 0x70, 0x23, 0x60, 0xce, 0x7c, 0xa5, 0xe5,
+	0x18, 0xb5, 0xaa, 0x11, 0xce, 0x9e, 0x67, 0x07, 0x5b, 0xe6, 0x8e, 0xe3, 0x99, 0xac, 0xf2, 0xd8,
+	0x70, 0xd3, 0x6a, 0x54, 0x99, 0x58, 0x68, 0x93, 0x08, 0x5f, 0xf7, 0xa3, 0x75, 0x8d, 0x84, 0xa1,
+	0x6c, 0xde, 0x6a, 0x9b, 0xae, 0xa7, 0xbd, 0x86, 0x26, 0xb8, 0x5e, 0xb7, 0xe9, 0xd8, 0xae, 0x89,
+	0xd7, 0xd1, 0x08, 0x0d, 0x57, 0x4e, 0x59, 0x50, 0x96, 0xc7, 0x4e, 0x4e, 0x94, 0x98, 0x65, 0x2b,
+	0x51, 0xe1, 0x0b, 0xc3, 0x0f, 0xff, 0x38, 0x3c, 0x50, 0x06, 0x41, 0x6d, 0x09, 0x34, 0x6d, 0x9a,
+	0xde, 0x35, 0xc7, 0xf5, 0xc0, 0x00, 0x1e, 0x47, 0x83, 0x8d, 0x1a, 0xd1, 0x32, 0x5c, 0x1e, 0x6c,
+	0xd4, 0xb4, 0x8b, 0x68, 0x92, 0x17, 0x03, 0x8b, 0x6b, 0x68, 0xd8, 0x6f, 0x83, 0xbd, 0x83, 0xbc,
+	0x3d, 0xc7, 0xf5, 0xc0, 0x1a, 0x11, 0xd2, 0xde, 0x01, 0x5b, 0x1b, 0x96, 0xc5, 0xda, 0xba, 0x8c,
+	0x50, 0x94, 0x02, 0xa0, 0xa9, 0x50, 0xa2, 0xf9, 0x52, 0xf2, 0xf3, 0xa5, 0x44, 0x33, 0x11, 0xf2,
+	0xa5, 0x74, 0xcd, 0xa8, 0x9b, 0x30, 0xb7, 0xcc, 0xcc, 0xd4, 0x3e, 0x57, 0xd0, 0x24, 0xaf, 0x3f,
+	0x01, 0x39, 0xd4, 0x13, 0x12, 0x6f, 0x72, 0x34, 0x83, 0x84, 0xa6, 0xd8, 0x93, 0x86, 0x5a, 0xe2,
+	0x70, 0xba, 0x68, 0x86, 0xae, 0x91, 0x6f, 0x61, 0xf7, 0x62, 0xcb, 0x34, 0x3c, 0xa7, 0x15, 0xf8,
+	0x9c, 0x43, 0xfb, 0xaa, 0xb4, 0x87, 0x38, 0x3c, 0x5a, 0x0e, 0x9a, 0xf8, 0xb2, 0xc0, 0xfe, 0xd3,
+	0x44, 0xe3, 0x9e, 0x82, 0x54, 0x91, 0xfd, 0xe7, 0x1a, 0x93, 0x8f, 0x14, 0x3e, 0x28, 0x37, 0x0d,
+	0xdb, 0x36, 0xad, 0x20, 0x28, 0x73, 0x68, 0xb4, 0x4a, 0x7b, 0xae, 0x5c, 0x82, 0xb0, 0x44, 0x1d,
+	0xff, 0x58, 0x60, 0x02, 0x86, 0xe7, 0x1a, 0x98, 0x0e, 0x9a, 0xe6, 0x99, 0x1a, 0x36, 0x9b, 0x2a,
+	0x7e, 0x3b, 0x8c, 0x49, 0xd0, 0xec, 0x5b, 0x44, 0xee, 0x2a, 0x28, 0x97, 0xb4, 0xfe, 0x5c, 0xe3,
+	0xb1, 0x02, 0xf1, 0xd8, 0x34, 0xbd, 0x2d, 0xd3, 0x4e, 0x3d, 0x9a, 0xb6, 0x50, 0x2e, 0x29, 0x0a,
+	0xf0, 0xe7, 0xd0, 0xfe, 0xa0, 0x0f, 0x0e, 0x96, 0x43, 0x9c, 0x03, 0xc1, 0x20, 0x38, 0x11, 0x0a,
+	0x6b, 0x06, 0xd8, 0xdf, 0xb0, 0xac, 0xb8, 0xfd, 0x7e, 0x1d, 0x57, 0xf7, 0x83, 0xa8, 0x73, 0x36,
+	0x84, 0xe0, 0x43, 0x99, 0xc1, 0xfb, 0xb7, 0x02, 0x37, 0xd0, 0x1c, 0xa1, 0x0b, 0x2d, 0xed, 0x96,
+	0xc9, 0x7f, 0x57, 0xb6, 0xcd, 0x3a, 0x85, 0x46, 0xfc, 0xff, 0xb8, 0x2b, 0x97, 0x08, 0xc2, 0x68,
+	0x19, 0x5a, 0xda, 0x9b, 0x68, 0x5e, 0xa2, 0xf5, 0x59, 0x57, 0xec, 0x0e, 0x9a, 0x8d, 0x69, 0xfe,
+	0x97, 0x77, 0xd1, 0xf7, 0x0a, 0x9a, 0x13, 0x13, 0xfc, 0x67, 0xd6, 0xf4, 0x18, 0x9c, 0x7c, 0x9b,
+	0xa6, 0x77, 0x83, 0xde, 0x83, 0xd2, 0x36, 0xd6, 0x36, 0x9a, 0x15, 0x4a, 0x83, 0x3b, 0xaf, 0xa0,
+	0x31, 0xa6, 0x1b, 0x16, 0x2b, 0xc7, 0x79, 0xc4, 0x8c, 0x83, 0x53, 0xec, 0x14, 0xad, 0x06, 0x38,
+	0x1b, 0x96, 0x25, 0xc0, 0xe9, 0xd7, 0x3e, 0x7b, 0xa0, 0xa0, 0x59, 0xa1, 0x19, 0x99, 0x1f, 0x43,
+	0x7b, 0xf4, 0xa3, 0x7f, 0xeb, 0xf3, 0x46, 0x14, 0xf1, 0xab, 0x70, 0x0f, 0x65, 0x23, 0x92, 0xbe,
+	0xe5, 0x54, 0xb4, 0xdf, 0xf5, 0x05, 0xed, 0xaa, 0x49, 0x18, 0x86, 0xcb, 0x61, 0x5b, 0xab, 0xa2,
+	0x39, 0xb1, 0x62, 0x88, 0xc1, 0x45, 0xf4, 0x7f, 0xb6, 0x1f, 0xa2, 0x3d, 0xc3, 0x05, 0x81, 0x15,
+	0x80, 0x28, 0x70, 0x93, 0xb4, 0x5f, 0x98, 0x40, 0x8b, 0xf0, 0xfb, 0xb4, 0xa0, 0xf8, 0x1c, 0x1a,
+	0x71, 0x3d, 0xc3, 0x6b, 0xbb, 0xc4, 0xcd, 0xf1, 0x93, 0x87, 0xa5, 0x98, 0x5b, 0x44, 0xac, 0x0c,
+	0xe2, 0x7e, 0x84, 0xda, 0x4d, 0xcb, 0x31, 0x6a, 0x57, 0x2e, 0xe5, 0x86, 0x48, 0xf8, 0xc2, 0xb6,
+	0xf6, 0x53, 0xb0, 0x7b, 0x13, 0xf0, 0xd2, 0x10, 0x0d, 0xed, 0x39, 0x44, 0xfd, 0xcb, 0x94, 0xeb,
+	0x51, 0xa6, 0x50, 0x4a, 0x5a, 0x8f, 0x04, 0xa1, 0x8e, 0x8e, 0x5f, 0xba, 0x9d, 0xa1, 0xe5, 0x47,
+	0x20, 0x28, 0x5d, 0x82, 0x1c, 0x09, 0xda, 0x6c, 0x8e, 0xf0, 0x2a, 0xa3, 0x00, 0xb0, 0xfd, 0xc2,
+	0x1c, 0x61, 0x05, 0x82, 0x00, 0xb0, 0x7d, 0x5a, 0x87, 0xb9, 0xff, 0x05, 0x9d, 0x6e, 0x2f, 0xea,
+	0x7e, 0x9d, 0xd0, 0x3f, 0xb2, 0x37, 0x3f, 0xc6, 0xba, 0xd4, 0xc1, 0xa1, 0x3d, 0x3b, 0xd8, 0xbf,
+	0x15, 0x5e, 0x8d, 0xae, 0x35, 0x17, 0x82, 0x8a, 0x52, 0x76, 0x52, 0xdf, 0x0f, 0xae, 0xd5, 0xbc,
+	0x30, 0xf8, 0xf5, 0x32, 0x1a, 0x0d, 0x3b, 0x61, 0xd5, 0xa6, 0x38, 0xa7, 0xc2, 0x51, 0xf0, 0x28,
+	0x12, 0x4f, 0x64, 0xfd, 0xe0, 0x53, 0x64, 0xbd, 0x76, 0x3d, 0xa2, 0xa3, 0xff, 0xf6, 0xd9, 0x0f,
+	0x35, 0xd9, 0x3d, 0xe2, 0x6d, 0xa4, 0x8a, 0x54, 0x82, 0xc7, 0xe7, 0x11, 0x8a, 0x7a, 0xc1, 0xe5,
+	0x69, 0x8e, 0x39, 0x1a, 0x06, 0x62, 0x66, 0x82, 0x76, 0x19, 0x15, 0x22, 0xe5, 0x61, 0x41, 0xbe,
+	0xd5, 0xae, 0xb8, 0xd5, 0x56, 0xa3, 0xe9, 0x31, 0xfb, 0x2c, 0x15, 0x5e, 0xfb, 0x52, 0x41, 0xc5,
+	0x9e, 0x8a, 0x00, 0xb9, 0x86, 0xa6, 0x25, 0x22, 0xc0, 0xbf, 0x18, 0xe3, 0x17, 0xca, 0x82, 0x33,
+	0x32, 0x55, 0xda, 0x59, 0x34, 0x15, 0x03, 0xca, 0xe6, 0xc9, 0x55, 0x34, 0x9d, 0x98, 0x07, 0xe0,
+	0xa7, 0xd1, 0x3e, 0xe8, 0x02, 0xd0, 0x49, 0x11, 0x28, 0x80, 0x05, 0xa2, 0xda, 0xeb, 0x68, 0x3e,
+	0xa6, 0xd0, 0x33, 0x6b, 0xcf, 0x9e, 0x16, 0x75, 0x94, 0x97, 0xa9, 0x05, 0xdc, 0x57, 0xd1, 0x38,
+	0x3f, 0x92, 0x2d, 0x3d, 0x62, 0x93, 0x4e, 0xfe, 0x3c, 0x8d, 0xfe, 0x47, 0x2c, 0xe1, 0x9b, 0x68,
+	0x84, 0x3e, 0xac, 0x60, 0xfe, 0x7f, 0x28, 0xf9, 0x6a, 0xa3, 0x2e, 0xc8, 0x05, 0x28, 0x9d, 0x36,
+	0xfb, 0xf1, 0x6f, 0x7f, 0x7d, 0x35, 0x78, 0x08, 0x4f, 0xe8, 0xc9, 0x27, 0x30, 0xfc, 0x2e, 0xad,
+	0xc4, 0xb0, 0x40, 0x0d, 0xff, 0x7a, 0xa3, 0x1e, 0x49, 0x91, 0x00, 0x4b, 0x79, 0x62, 0x29, 0x87,
+	0xa7, 0xf4, 0xf8, 0xe3, 0x96, 0xde, 0x69, 0xd4, 0xba, 0xb8, 0x81, 0xf6, 0xf9, 0xf2, 0x1b, 0x96,
+	0x25, 0xb2, 0xc7, 0xbf, 0xe0, 0xa8, 0x47, 0x52, 0x24, 0xc0, 0xde, 0x0c, 0xb1, 0x37, 0x81, 0x0f,
+	0x26, 0xec, 0xe1, 0xbb, 0x0a, 0x7a, 0x81, 0x7b, 0xa4, 0xc0, 0x05, 0x41, 0xa0, 0x04, 0xaf, 0x28,
+	0x6a, 0xb1, 0xa7, 0x1c, 0x58, 0x2f, 0x11, 0xeb, 0xcb, 0xb8, 0x90, 0xb0, 0xbe, 0x5d, 0xd9, 0xdd,
+	0x86, 0xa7, 0x17, 0xbd, 0x03, 0x3f, 0xba, 0xf8, 0x5e, 0x84, 0x44, 0x73, 0x2e, 0x05, 0x89, 0x7b,
+	0xc3, 0x50, 0x8b, 0x3d, 0xe5, 0x00, 0xe9, 0x04, 0x41, 0x5a, 0xc5, 0xcb, 0x62, 0x24, 0x2a, 0xad,
+	0x77, 0xc2, 0x9c, 0xef, 0xe2, 0x4f, 0x15, 0x34, 0xc6, 0xd4, 0x15, 0x78, 0x31, 0xc5, 0x54, 0x58,
+	0xf8, 0xa8, 0x4b, 0x3d, 0xa4, 0x00, 0xe7, 0x18, 0xc1, 0x29, 0xe0, 0x45, 0x19, 0x4e, 0xc3, 0xd6,
+	0x3b, 0x50, 0x32, 0x75, 0xf1, 0x07, 0x51, 0x29, 0x23, 0xc2, 0x48, 0x56, 0xed, 0xea, 0x52, 0x0f,
+	0x29, 0xc0, 0x38, 0x4a, 0x30, 0xe6, 0xf1, 0xac, 0x2e, 0x7c, 0xd1, 0xa5, 0xb9, 0xf9, 0x3e, 0x1a,
+	0x0b, 0x26, 0xfa, 0xf9, 0xb9, 0x28, 0xcc, 0xbe, 0x0c, 0x00, 0x82, 0xc2, 0x5b, 0xb2, 0x2f, 0x42,
+	0x00, 0xfc, 0x40, 0x41, 0x2f, 0xc6, 0x8b, 0x57, 0xbc, 0x92, 0xd4, 0x2d, 0x29, 0x9b, 0xd5, 0xd5,
+	0x2c, 0xa2, 0xc0, 0x72, 0x9e, 0xb0, 0x9c, 0xc3, 0x67, 0xc4, 0x2c, 0xfe, 0xc2, 0xd0, 0xf7, 0x64,
+	0x36, 0x4d, 0xf4, 0x0e, 0x3d, 0x0b, 0xbb, 0xf8, 0x1b, 0x05, 0x1d, 0x88, 0xd5, 0xa2, 0x78, 0x39,
+	0xcd, 0x3c, 0x97, 0x37, 0x2b, 0x19, 0x24, 0x81, 0x73, 0x9d, 0x70, 0xae, 0xe1, 0x15, 0x39, 0x67,
+	0x3c, 0x81, 0xfc, 0x5c, 0x66, 0x4b, 0xa8, 0xa2, 0x30, 0x3d, 0x92, 0x55, 0xa1, 0xba, 0xdc, 0x5b,
+	0x10, 0xa8, 0x0a, 0x84, 0x6a, 0x01, 0xe7, 0x75, 0xd9, 0xfb, 0x3f, 0xcd, 0xa6, 0x4f, 0x14, 0x34,
+	0xce, 0xcc, 0xf7, 0x33, 0xaa, 0x28, 0xcc, 0x95, 0x6c, 0x34, 0xe2, 0x2a, 0x53, 0x3b, 0x42, 0x68,
+	0x66, 0xf1, 0x8c, 0x94, 0x06, 0x7f, 0xa7, 0xf0, 0x97, 0x2d, 0x2c, 0xf6, 0x55, 0x50, 0x5a, 0xa9,
+	0x2b, 0x19, 0x24, 0x01, 0xe4, 0x25, 0x02, 0x72, 0x0a, 0xaf, 0xeb, 0xd2, 0xcf, 0x1e, 0x7c, 0x3a,
+	0x05, 0x45, 0x64, 0x17, 0x7f, 0xa6, 0xa0, 0x03, 0xac, 0x4e, 0x3f, 0x54, 0xe2, 0x08, 0x64, 0x64,
+	0x94, 0xd4, 0x5a, 0x9a, 0x46, 0x18, 0xe7, 0xb0, 0x2a, 0x67, 0xc4, 0xdf, 0x2a, 0xfc, 0x75, 0x5d,
+	0x12, 0x2d, 0x41, 0x75, 0xa4, 0xae, 0x64, 0x90, 0x04, 0x92, 0xb3, 0x84, 0xe4, 0x04, 0x2e, 0xe9,
+	0xd2, 0x6f, 0x40, 0xe1, 0x86, 0xd3, 0x3b, 0x41, 0x57, 0x17, 0x7f, 0x01, 0x7f, 0x20, 0x81, 0x42,
+	0x57, 0xf6, 0x07, 0x12, 0x2f, 0x82, 0xd4, 0x62, 0x4f, 0x39, 0x40, 0x5b, 0x23, 0x68, 0x4b, 0xf8,
+	0x68, 0x06, 0x34, 0x7c, 0x87, 0xa9, 0x01, 0xb0, 0xf8, 0x2c, 0x8e, 0x57, 0x19, 0x6a, 0xa1, 0x97,
+	0x58, 0xea, 0x99, 0x1d, 0x7e, 0x06, 0xa3, 0xbb, 0xec, 0x6b, 0x85, 0xbd, 0x93, 0x63, 0xb1, 0xee,
+	0x44, 0x75, 0xa0, 0x16, 0x7b, 0xca, 0x01, 0xc4, 0x19, 0x02, 0xa1, 0xe3, 0xe3, 0xba, 0xe4, 0x73,
+	0x9b, 0xf8, 0x8c, 0xfc, 0x55, 0x91, 0xde, 0xbb, 0xf1, 0x29, 0x89, 0xed, 0xb4, 0x8a, 0x40, 0x3d,
+	0xbd, 0xb7, 0x49, 0xa9, 0x9b, 0x92, 0xf9, 0x1a, 0xb8, 0xed, 0x32, 0xd3, 0xb8, 0x5b, 0xc1, 0x87,
+	0xe1, 0xfd, 0x1b, 0x1f, 0x4d, 0xb3, 0x1d, 0x00, 0x2e, 0xa6, 0x0b, 0x01, 0xd0, 0x32, 0x01, 0xd2,
+	0xf0, 0x82, 0x08, 0x88, 0xb3, 0xff, 0x83, 0x12, 0xbf, 0x51, 0xe3, 0xd5, 0xd4, 0x18, 0x70, 0xf7,
+	0x7c, 0x75, 0x2d, 0x93, 0x6c, 0xa6, 0x30, 0x99, 0x35, 0xf9, 0x42, 0x5f, 0x38, 0xfe, 0xf0, 0x71,
+	0x5e, 0x79, 0xf4, 0x38, 0xaf, 0xfc, 0xf9, 0x38, 0xaf, 0xdc, 0x7d, 0x92, 0x1f, 0x78, 0xf4, 0x24,
+	0x3f, 0xf0, 0xfb, 0x93, 0xfc, 0xc0, 0x5b, 0x13, 0xa0, 0xeb, 0x3d, 0xaa, 0xcd, 0xdb, 0x6d, 0x9a,
+	0x6e, 0x65, 0x84, 0x7c, 0x7d, 0x3d, 0xf5, 0xf7, 0x00, 0xdf, 0x07, 0xfc, 0x25, 0x11, 0x1f, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Post items.
+	Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error)
+	PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error)
+	// Queries a list of Post items written by a creator.
+	PostByCreator(ctx context.Context, in *QueryPostByCreatorRequest, opts ...grpc.CallOption) (*QueryPostByCreatorResponse, error)
+	// Queries a list of Post items received on a local channel.
+	PostByChannel(ctx context.Context, in *QueryPostByChannelRequest, opts ...grpc.CallOption) (*QueryPostByChannelResponse, error)
+	// Queries a list of Post items received from an origin chain.
+	PostByChain(ctx context.Context, in *QueryPostByChainRequest, opts ...grpc.CallOption) (*QueryPostByChainResponse, error)
+	// Queries a list of SentPost items.
+	SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error)
+	SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error)
+	// Queries a SentPost by the local channel it was sent on and its remote post ID.
+	SentPostByRemote(ctx context.Context, in *QuerySentPostByRemoteRequest, opts ...grpc.CallOption) (*QuerySentPostByRemoteResponse, error)
+	// Queries a list of SentPost items sent to a destination chain.
+	SentPostByChain(ctx context.Context, in *QuerySentPostByChainRequest, opts ...grpc.CallOption) (*QuerySentPostByChainResponse, error)
+	// Queries a list of TimeoutPost items.
+	TimeoutPost(ctx context.Context, in *QueryGetTimeoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimeoutPostResponse, error)
+	TimeoutPostAll(ctx context.Context, in *QueryAllTimeoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimeoutPostResponse, error)
+	// Queries a list of OutboundPost items, optionally filtered by status.
+	OutboundPost(ctx context.Context, in *QueryGetOutboundPostRequest, opts ...grpc.CallOption) (*QueryGetOutboundPostResponse, error)
+	OutboundPostAll(ctx context.Context, in *QueryAllOutboundPostRequest, opts ...grpc.CallOption) (*QueryAllOutboundPostResponse, error)
+	// Queries the previous revisions of a post.
+	PostRevision(ctx context.Context, in *QueryGetPostRevisionRequest, opts ...grpc.CallOption) (*QueryGetPostRevisionResponse, error)
+	PostRevisions(ctx context.Context, in *QueryPostRevisionsRequest, opts ...grpc.CallOption) (*QueryPostRevisionsResponse, error)
+	// Queries a broadcast with the delivery status of each destination.
+	Broadcast(ctx context.Context, in *QueryGetBroadcastRequest, opts ...grpc.CallOption) (*QueryGetBroadcastResponse, error)
+	// Queries the cached copy of a post fetched from a counterparty chain.
+	RemotePost(ctx context.Context, in *QueryGetRemotePostRequest, opts ...grpc.CallOption) (*QueryGetRemotePostResponse, error)
+	// Queries the replication of the local posts to the counterparty chain of a channel.
+	ReplicationSubscription(ctx context.Context, in *QueryGetReplicationSubscriptionRequest, opts ...grpc.CallOption) (*QueryGetReplicationSubscriptionResponse, error)
+	// Queries the replica of the posts of the counterparty chain of a channel.
+	Replica(ctx context.Context, in *QueryGetReplicaRequest, opts ...grpc.CallOption) (*QueryGetReplicaResponse, error)
+	// Queries a post of the replica of a channel.
+	ReplicatedPost(ctx context.Context, in *QueryGetReplicatedPostRequest, opts ...grpc.CallOption) (*QueryGetReplicatedPostResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TimeoutPost) > 0 {
-		for iNdEx := len(m.TimeoutPost) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TimeoutPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) Post(ctx context.Context, in *QueryGetPostRequest, opts ...grpc.CallOption) (*QueryGetPostResponse, error) {
+	out := new(QueryGetPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Post", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) PostAll(ctx context.Context, in *QueryAllPostRequest, opts ...grpc.CallOption) (*QueryAllPostResponse, error) {
+	out := new(QueryAllPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) PostByCreator(ctx context.Context, in *QueryPostByCreatorRequest, opts ...grpc.CallOption) (*QueryPostByCreatorResponse, error) {
+	out := new(QueryPostByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PostByChannel(ctx context.Context, in *QueryPostByChannelRequest, opts ...grpc.CallOption) (*QueryPostByChannelResponse, error) {
+	out := new(QueryPostByChannelResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostByChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PostByChain(ctx context.Context, in *QueryPostByChainRequest, opts ...grpc.CallOption) (*QueryPostByChainResponse, error) {
+	out := new(QueryPostByChainResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return out, nil
+}
+
+func (c *queryClient) SentPost(ctx context.Context, in *QueryGetSentPostRequest, opts ...grpc.CallOption) (*QueryGetSentPostResponse, error) {
+	out := new(QueryGetSentPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SentPostAll(ctx context.Context, in *QueryAllSentPostRequest, opts ...grpc.CallOption) (*QueryAllSentPostResponse, error) {
+	out := new(QueryAllSentPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Post.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SentPostByRemote(ctx context.Context, in *QuerySentPostByRemoteRequest, opts ...grpc.CallOption) (*QuerySentPostByRemoteResponse, error) {
+	out := new(QuerySentPostByRemoteResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostByRemote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SentPostByChain(ctx context.Context, in *QuerySentPostByChainRequest, opts ...grpc.CallOption) (*QuerySentPostByChainResponse, error) {
+	out := new(QuerySentPostByChainResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/SentPostByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TimeoutPost(ctx context.Context, in *QueryGetTimeoutPostRequest, opts ...grpc.CallOption) (*QueryGetTimeoutPostResponse, error) {
+	out := new(QueryGetTimeoutPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimeoutPost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Post) > 0 {
-		for _, e := range m.Post {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) TimeoutPostAll(ctx context.Context, in *QueryAllTimeoutPostRequest, opts ...grpc.CallOption) (*QueryAllTimeoutPostResponse, error) {
+	out := new(QueryAllTimeoutPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/TimeoutPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) OutboundPost(ctx context.Context, in *QueryGetOutboundPostRequest, opts ...grpc.CallOption) (*QueryGetOutboundPostResponse, error) {
+	out := new(QueryGetOutboundPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/OutboundPost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetSentPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) OutboundPostAll(ctx context.Context, in *QueryAllOutboundPostRequest, opts ...grpc.CallOption) (*QueryAllOutboundPostResponse, error) {
+	out := new(QueryAllOutboundPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/OutboundPostAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return out, nil
+}
+
+func (c *queryClient) PostRevision(ctx context.Context, in *QueryGetPostRevisionRequest, opts ...grpc.CallOption) (*QueryGetPostRevisionResponse, error) {
+	out := new(QueryGetPostRevisionResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetSentPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PostRevisions(ctx context.Context, in *QueryPostRevisionsRequest, opts ...grpc.CallOption) (*QueryPostRevisionsResponse, error) {
+	out := new(QueryPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/PostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SentPost.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllSentPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Broadcast(ctx context.Context, in *QueryGetBroadcastRequest, opts ...grpc.CallOption) (*QueryGetBroadcastResponse, error) {
+	out := new(QueryGetBroadcastResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Broadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RemotePost(ctx context.Context, in *QueryGetRemotePostRequest, opts ...grpc.CallOption) (*QueryGetRemotePostResponse, error) {
+	out := new(QueryGetRemotePostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/RemotePost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllSentPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ReplicationSubscription(ctx context.Context, in *QueryGetReplicationSubscriptionRequest, opts ...grpc.CallOption) (*QueryGetReplicationSubscriptionResponse, error) {
+	out := new(QueryGetReplicationSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/ReplicationSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SentPost) > 0 {
-		for _, e := range m.SentPost {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) Replica(ctx context.Context, in *QueryGetReplicaRequest, opts ...grpc.CallOption) (*QueryGetReplicaResponse, error) {
+	out := new(QueryGetReplicaResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/Replica", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ReplicatedPost(ctx context.Context, in *QueryGetReplicatedPostRequest, opts ...grpc.CallOption) (*QueryGetReplicatedPostResponse, error) {
+	out := new(QueryGetReplicatedPostResponse)
+	err := c.cc.Invoke(ctx, "/planet.blog.Query/ReplicatedPost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetTimeoutPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Post items.
+	Post(context.Context, *QueryGetPostRequest) (*QueryGetPostResponse, error)
+	PostAll(context.Context, *QueryAllPostRequest) (*QueryAllPostResponse, error)
+	// Queries a list of Post items written by a creator.
+	PostByCreator(context.Context, *QueryPostByCreatorRequest) (*QueryPostByCreatorResponse, error)
+	// Queries a list of Post items received on a local channel.
+	PostByChannel(context.Context, *QueryPostByChannelRequest) (*QueryPostByChannelResponse, error)
+	// Queries a list of Post items received from an origin chain.
+	PostByChain(context.Context, *QueryPostByChainRequest) (*QueryPostByChainResponse, error)
+	// Queries a list of SentPost items.
+	SentPost(context.Context, *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error)
+	SentPostAll(context.Context, *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error)
+	// Queries a SentPost by the local channel it was sent on and its remote post ID.
+	SentPostByRemote(context.Context, *QuerySentPostByRemoteRequest) (*QuerySentPostByRemoteResponse, error)
+	// Queries a list of SentPost items sent to a destination chain.
+	SentPostByChain(context.Context, *QuerySentPostByChainRequest) (*QuerySentPostByChainResponse, error)
+	// Queries a list of TimeoutPost items.
+	TimeoutPost(context.Context, *QueryGetTimeoutPostRequest) (*QueryGetTimeoutPostResponse, error)
+	TimeoutPostAll(context.Context, *QueryAllTimeoutPostRequest) (*QueryAllTimeoutPostResponse, error)
+	// Queries a list of OutboundPost items, optionally filtered by status.
+	OutboundPost(context.Context, *QueryGetOutboundPostRequest) (*QueryGetOutboundPostResponse, error)
+	OutboundPostAll(context.Context, *QueryAllOutboundPostRequest) (*QueryAllOutboundPostResponse, error)
+	// Queries the previous revisions of a post.
+	PostRevision(context.Context, *QueryGetPostRevisionRequest) (*QueryGetPostRevisionResponse, error)
+	PostRevisions(context.Context, *QueryPostRevisionsRequest) (*QueryPostRevisionsResponse, error)
+	// Queries a broadcast with the delivery status of each destination.
+	Broadcast(context.Context, *QueryGetBroadcastRequest) (*QueryGetBroadcastResponse, error)
+	// Queries the cached copy of a post fetched from a counterparty chain.
+	RemotePost(context.Context, *QueryGetRemotePostRequest) (*QueryGetRemotePostResponse, error)
+	// Queries the replication of the local posts to the counterparty chain of a channel.
+	ReplicationSubscription(context.Context, *QueryGetReplicationSubscriptionRequest) (*QueryGetReplicationSubscriptionResponse, error)
+	// Queries the replica of the posts of the counterparty chain of a channel.
+	Replica(context.Context, *QueryGetReplicaRequest) (*QueryGetReplicaResponse, error)
+	// Queries a post of the replica of a channel.
+	ReplicatedPost(context.Context, *QueryGetReplicatedPostRequest) (*QueryGetReplicatedPostResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Post(ctx context.Context, req *QueryGetPostRequest) (*QueryGetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+}
+func (*UnimplementedQueryServer) PostAll(ctx context.Context, req *QueryAllPostRequest) (*QueryAllPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAll not implemented")
+}
+func (*UnimplementedQueryServer) PostByCreator(ctx context.Context, req *QueryPostByCreatorRequest) (*QueryPostByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostByCreator not implemented")
+}
+func (*UnimplementedQueryServer) PostByChannel(ctx context.Context, req *QueryPostByChannelRequest) (*QueryPostByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostByChannel not implemented")
+}
+func (*UnimplementedQueryServer) PostByChain(ctx context.Context, req *QueryPostByChainRequest) (*QueryPostByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostByChain not implemented")
+}
+func (*UnimplementedQueryServer) SentPost(ctx context.Context, req *QueryGetSentPostRequest) (*QueryGetSentPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPost not implemented")
+}
+func (*UnimplementedQueryServer) SentPostAll(ctx context.Context, req *QueryAllSentPostRequest) (*QueryAllSentPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPostAll not implemented")
+}
+func (*UnimplementedQueryServer) SentPostByRemote(ctx context.Context, req *QuerySentPostByRemoteRequest) (*QuerySentPostByRemoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPostByRemote not implemented")
+}
+func (*UnimplementedQueryServer) SentPostByChain(ctx context.Context, req *QuerySentPostByChainRequest) (*QuerySentPostByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentPostByChain not implemented")
+}
+func (*UnimplementedQueryServer) TimeoutPost(ctx context.Context, req *QueryGetTimeoutPostRequest) (*QueryGetTimeoutPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPost not implemented")
+}
+func (*UnimplementedQueryServer) TimeoutPostAll(ctx context.Context, req *QueryAllTimeoutPostRequest) (*QueryAllTimeoutPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPostAll not implemented")
+}
+func (*UnimplementedQueryServer) OutboundPost(ctx context.Context, req *QueryGetOutboundPostRequest) (*QueryGetOutboundPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboundPost not implemented")
+}
+func (*UnimplementedQueryServer) OutboundPostAll(ctx context.Context, req *QueryAllOutboundPostRequest) (*QueryAllOutboundPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboundPostAll not implemented")
+}
+func (*UnimplementedQueryServer) PostRevision(ctx context.Context, req *QueryGetPostRevisionRequest) (*QueryGetPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevision not implemented")
+}
+func (*UnimplementedQueryServer) PostRevisions(ctx context.Context, req *QueryPostRevisionsRequest) (*QueryPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevisions not implemented")
+}
+func (*UnimplementedQueryServer) Broadcast(ctx context.Context, req *QueryGetBroadcastRequest) (*QueryGetBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (*UnimplementedQueryServer) RemotePost(ctx context.Context, req *QueryGetRemotePostRequest) (*QueryGetRemotePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemotePost not implemented")
+}
+func (*UnimplementedQueryServer) ReplicationSubscription(ctx context.Context, req *QueryGetReplicationSubscriptionRequest) (*QueryGetReplicationSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicationSubscription not implemented")
+}
+func (*UnimplementedQueryServer) Replica(ctx context.Context, req *QueryGetReplicaRequest) (*QueryGetReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replica not implemented")
+}
+func (*UnimplementedQueryServer) ReplicatedPost(ctx context.Context, req *QueryGetReplicatedPostRequest) (*QueryGetReplicatedPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicatedPost not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTimeoutPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TimeoutPost.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Post(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Post",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Post(ctx, req.(*QueryGetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllTimeoutPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostAll(ctx, req.(*QueryAllPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostByCreator(ctx, req.(*QueryPostByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostByChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostByChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostByChannel(ctx, req.(*QueryPostByChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostByChain(ctx, req.(*QueryPostByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSentPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPost(ctx, req.(*QueryGetSentPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSentPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPostAll(ctx, req.(*QueryAllSentPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPostByRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySentPostByRemoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPostByRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPostByRemote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPostByRemote(ctx, req.(*QuerySentPostByRemoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SentPostByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySentPostByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SentPostByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/SentPostByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SentPostByChain(ctx, req.(*QuerySentPostByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeoutPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTimeoutPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeoutPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/TimeoutPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeoutPost(ctx, req.(*QueryGetTimeoutPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeoutPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTimeoutPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeoutPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/TimeoutPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeoutPostAll(ctx, req.(*QueryAllTimeoutPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutboundPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutboundPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutboundPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/OutboundPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutboundPost(ctx, req.(*QueryGetOutboundPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutboundPostAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutboundPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutboundPostAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/OutboundPostAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutboundPostAll(ctx, req.(*QueryAllOutboundPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostRevision(ctx, req.(*QueryGetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/PostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostRevisions(ctx, req.(*QueryPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Broadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Broadcast(ctx, req.(*QueryGetBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemotePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRemotePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemotePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/RemotePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemotePost(ctx, req.(*QueryGetRemotePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplicationSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReplicationSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplicationSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/ReplicationSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplicationSubscription(ctx, req.(*QueryGetReplicationSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Replica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Replica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/Replica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Replica(ctx, req.(*QueryGetReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplicatedPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReplicatedPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplicatedPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planet.blog.Query/ReplicatedPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplicatedPost(ctx, req.(*QueryGetReplicatedPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "planet.blog.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Post",
+			Handler:    _Query_Post_Handler,
+		},
+		{
+			MethodName: "PostAll",
+			Handler:    _Query_PostAll_Handler,
+		},
+		{
+			MethodName: "PostByCreator",
+			Handler:    _Query_PostByCreator_Handler,
+		},
+		{
+			MethodName: "PostByChannel",
+			Handler:    _Query_PostByChannel_Handler,
+		},
+		{
+			MethodName: "PostByChain",
+			Handler:    _Query_PostByChain_Handler,
+		},
+		{
+			MethodName: "SentPost",
+			Handler:    _Query_SentPost_Handler,
+		},
+		{
+			MethodName: "SentPostAll",
+			Handler:    _Query_SentPostAll_Handler,
+		},
+		{
+			MethodName: "SentPostByRemote",
+			Handler:    _Query_SentPostByRemote_Handler,
+		},
+		{
+			MethodName: "SentPostByChain",
+			Handler:    _Query_SentPostByChain_Handler,
+		},
+		{
+			MethodName: "TimeoutPost",
+			Handler:    _Query_TimeoutPost_Handler,
+		},
+		{
+			MethodName: "TimeoutPostAll",
+			Handler:    _Query_TimeoutPostAll_Handler,
+		},
+		{
+			MethodName: "OutboundPost",
+			Handler:    _Query_OutboundPost_Handler,
+		},
+		{
+			MethodName: "OutboundPostAll",
+			Handler:    _Query_OutboundPostAll_Handler,
+		},
+		{
+			MethodName: "PostRevision",
+			Handler:    _Query_PostRevision_Handler,
+		},
+		{
+			MethodName: "PostRevisions",
+			Handler:    _Query_PostRevisions_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _Query_Broadcast_Handler,
+		},
+		{
+			MethodName: "RemotePost",
+			Handler:    _Query_RemotePost_Handler,
+		},
+		{
+			MethodName: "ReplicationSubscription",
+			Handler:    _Query_ReplicationSubscription_Handler,
+		},
+		{
+			MethodName: "Replica",
+			Handler:    _Query_Replica_Handler,
+		},
+		{
+			MethodName: "ReplicatedPost",
+			Handler:    _Query_ReplicatedPost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "planet/blog/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostByChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostByChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostByChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostByChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostByChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostByChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSentPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSentPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SentPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSentPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSentPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSentPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSentPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSentPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSentPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SentPost) > 0 {
+		for iNdEx := len(m.SentPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySentPostByRemoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySentPostByRemoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySentPostByRemoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySentPostByRemoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySentPostByRemoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySentPostByRemoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SentPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySentPostByChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySentPostByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySentPostByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySentPostByChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySentPostByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySentPostByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SentPost) > 0 {
+		for iNdEx := len(m.SentPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTimeoutPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTimeoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTimeoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTimeoutPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTimeoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTimeoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TimeoutPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTimeoutPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTimeoutPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTimeoutPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTimeoutPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTimeoutPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTimeoutPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TimeoutPost) > 0 {
+		for iNdEx := len(m.TimeoutPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOutboundPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutboundPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutboundPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOutboundPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutboundPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutboundPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OutboundPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutboundPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutboundPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutboundPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutboundPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutboundPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutboundPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutboundPost) > 0 {
+		for iNdEx := len(m.OutboundPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostRevisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostRevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostRevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostRevisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PostRevision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostRevision) > 0 {
+		for iNdEx := len(m.PostRevision) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevision[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBroadcastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBroadcastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBroadcastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBroadcastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBroadcastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBroadcastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutboundPost) > 0 {
+		for iNdEx := len(m.OutboundPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Broadcast.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemotePostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemotePostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemotePostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemotePostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemotePostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemotePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemotePost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplicationSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplicationSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplicationSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplicationSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplicationSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplicationSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReplicationSubscription.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Replica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplicatedPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplicatedPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplicatedPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplicatedPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplicatedPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplicatedPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReplicatedPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostByChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostByChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSentPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSentPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SentPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSentPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSentPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SentPost) > 0 {
+		for _, e := range m.SentPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySentPostByRemoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySentPostByRemoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SentPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySentPostByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySentPostByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SentPost) > 0 {
+		for _, e := range m.SentPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTimeoutPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTimeoutPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimeoutPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTimeoutPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTimeoutPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TimeoutPost) > 0 {
+		for _, e := range m.TimeoutPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOutboundPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetOutboundPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutboundPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutboundPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutboundPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutboundPost) > 0 {
+		for _, e := range m.OutboundPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPostRevisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovQuery(uint64(m.PostID))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovQuery(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *QueryGetPostRevisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PostRevision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPostRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostID != 0 {
+		n += 1 + sovQuery(uint64(m.PostID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PostRevision) > 0 {
+		for _, e := range m.PostRevision {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBroadcastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetBroadcastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Broadcast.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.OutboundPost) > 0 {
+		for _, e := range m.OutboundPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetRemotePostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRemotePostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemotePost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetReplicationSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReplicationSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReplicationSubscription.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetReplicaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReplicaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Replica.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetReplicatedPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReplicatedPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReplicatedPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostByChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostByChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostByChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostByChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostByChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSentPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSentPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSentPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSentPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentPost = append(m.SentPost, SentPost{})
+			if err := m.SentPost[len(m.SentPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySentPostByRemoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySentPostByRemoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySentPostByRemoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySentPostByRemoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySentPostByRemoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySentPostByRemoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySentPostByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySentPostByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySentPostByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySentPostByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySentPostByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySentPostByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentPost = append(m.SentPost, SentPost{})
+			if err := m.SentPost[len(m.SentPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTimeoutPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTimeoutPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTimeoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTimeoutPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTimeoutPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTimeoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTimeoutPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTimeoutPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTimeoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTimeoutPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTimeoutPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTimeoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutPost = append(m.TimeoutPost, TimeoutPost{})
+			if err := m.TimeoutPost[len(m.TimeoutPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutboundPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutboundPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutboundPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutboundPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutboundPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutboundPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutboundPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutboundPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutboundPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutboundPostStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllTimeoutPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.TimeoutPost) > 0 {
-		for _, e := range m.TimeoutPost {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryAllOutboundPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutboundPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutboundPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPost = append(m.OutboundPost, OutboundPost{})
+			if err := m.OutboundPost[len(m.OutboundPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPostRevisionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,12 +8083,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPostRevisionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPostRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			m.PostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1779,7 +8148,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPostRevisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1802,15 +8171,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPostRevisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPostRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevision", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1837,7 +8206,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PostRevision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1862,7 +8231,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPostRevisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1885,17 +8254,17 @@ func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPostRevisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPostRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
 			}
-			m.Id = 0
+			m.PostID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1905,11 +8274,47 @@ func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PostID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1931,7 +8336,7 @@ func (m *QueryGetPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPostRevisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,15 +8359,49 @@ func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPostRevisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPostRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostRevision = append(m.PostRevision, PostRevision{})
+			if err := m.PostRevision[len(m.PostRevision)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1989,7 +8428,10 @@ func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2014,7 +8456,7 @@ func (m *QueryGetPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBroadcastRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2037,17 +8479,17 @@ func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBroadcastRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2057,28 +8499,11 @@ func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2100,7 +8525,7 @@ func (m *QueryAllPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBroadcastResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,15 +8548,15 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBroadcastResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBroadcastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,14 +8583,13 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Post = append(m.Post, Post{})
-			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Broadcast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2192,10 +8616,8 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutboundPost = append(m.OutboundPost, OutboundPost{})
+			if err := m.OutboundPost[len(m.OutboundPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2220,7 +8642,7 @@ func (m *QueryAllPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRemotePostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2243,17 +8665,17 @@ func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSentPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRemotePostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRemotePostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2263,11 +8685,56 @@ func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2289,7 +8756,7 @@ func (m *QueryGetSentPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRemotePostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2312,15 +8779,15 @@ func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSentPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRemotePostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRemotePostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2347,7 +8814,7 @@ func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SentPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemotePost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2372,7 +8839,7 @@ func (m *QueryGetSentPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetReplicationSubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2395,17 +8862,17 @@ func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSentPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetReplicationSubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSentPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetReplicationSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2415,27 +8882,23 @@ func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2458,7 +8921,7 @@ func (m *QueryAllSentPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetReplicationSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2481,49 +8944,15 @@ func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSentPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetReplicationSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSentPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetReplicationSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentPost", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SentPost = append(m.SentPost, SentPost{})
-			if err := m.SentPost[len(m.SentPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationSubscription", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2550,10 +8979,7 @@ func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReplicationSubscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2578,7 +9004,7 @@ func (m *QueryAllSentPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTimeoutPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2601,17 +9027,17 @@ func (m *QueryGetTimeoutPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTimeoutPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTimeoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2621,11 +9047,24 @@ func (m *QueryGetTimeoutPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2647,7 +9086,7 @@ func (m *QueryGetTimeoutPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTimeoutPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2670,15 +9109,15 @@ func (m *QueryGetTimeoutPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTimeoutPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTimeoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2705,7 +9144,7 @@ func (m *QueryGetTimeoutPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2730,7 +9169,7 @@ func (m *QueryGetTimeoutPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTimeoutPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetReplicatedPostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2753,17 +9192,17 @@ func (m *QueryAllTimeoutPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTimeoutPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetReplicatedPostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTimeoutPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetReplicatedPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2773,27 +9212,55 @@ func (m *QueryAllTimeoutPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2816,7 +9283,7 @@ func (m *QueryAllTimeoutPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTimeoutPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetReplicatedPostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2839,49 +9306,15 @@ func (m *QueryAllTimeoutPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTimeoutPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetReplicatedPostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTimeoutPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetReplicatedPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPost", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TimeoutPost = append(m.TimeoutPost, TimeoutPost{})
-			if err := m.TimeoutPost[len(m.TimeoutPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatedPost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2908,10 +9341,7 @@ func (m *QueryAllTimeoutPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReplicatedPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
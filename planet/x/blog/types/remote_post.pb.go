@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/remote_post.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RemotePost is the cached copy of a post of a counterparty chain, fetched or
+// replicated over a channel
+type RemotePost struct {
+	// channelID is the channel the post was fetched or replicated over
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// postID is the ID of the post on the counterparty chain
+	PostID string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
+	Post   Post   `protobuf:"bytes,3,opt,name=post,proto3" json:"post"`
+	// fetchHeight is the height at which the fetch was acknowledged, or the
+	// replicated change applied
+	FetchHeight int64     `protobuf:"varint,4,opt,name=fetchHeight,proto3" json:"fetchHeight,omitempty"`
+	FetchedAt   time.Time `protobuf:"bytes,5,opt,name=fetchedAt,proto3,stdtime" json:"fetchedAt"`
+	// readHeight is the height at which the counterparty read the post
+	ReadHeight int64 `protobuf:"varint,6,opt,name=readHeight,proto3" json:"readHeight,omitempty"`
+}
+
+func (m *RemotePost) Reset()         { *m = RemotePost{} }
+func (m *RemotePost) String() string { return proto.CompactTextString(m) }
+func (*RemotePost) ProtoMessage()    {}
+func (*RemotePost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f2fd42e70d74297, []int{0}
+}
+func (m *RemotePost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemotePost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemotePost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemotePost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemotePost.Merge(m, src)
+}
+func (m *RemotePost) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemotePost) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemotePost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemotePost proto.InternalMessageInfo
+
+func (m *RemotePost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *RemotePost) GetPostID() string {
+	if m != nil {
+		return m.PostID
+	}
+	return ""
+}
+
+func (m *RemotePost) GetPost() Post {
+	if m != nil {
+		return m.Post
+	}
+	return Post{}
+}
+
+func (m *RemotePost) GetFetchHeight() int64 {
+	if m != nil {
+		return m.FetchHeight
+	}
+	return 0
+}
+
+func (m *RemotePost) GetFetchedAt() time.Time {
+	if m != nil {
+		return m.FetchedAt
+	}
+	return time.Time{}
+}
+
+func (m *RemotePost) GetReadHeight() int64 {
+	if m != nil {
+		return m.ReadHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RemotePost)(nil), "planet.blog.RemotePost")
+}
+
+func init() { proto.RegisterFile("planet/blog/remote_post.proto", fileDescriptor_0f2fd42e70d74297) }
+
+var fileDescriptor_0f2fd42e70d74297 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0xc1, 0x4e, 0x83, 0x40,
+	0x14, 0x64, 0x6d, 0x6d, 0x64, 0x39, 0xb9, 0x9a, 0x86, 0x10, 0x5d, 0x88, 0x27, 0x12, 0xe3, 0x92,
+	0xe8, 0x17, 0x48, 0x7a, 0xb0, 0x37, 0x43, 0x3c, 0x79, 0x31, 0xd0, 0x6e, 0x97, 0x26, 0xc0, 0x12,
+	0x78, 0x26, 0xfa, 0x17, 0xfd, 0xac, 0x1e, 0x7b, 0xf4, 0xa4, 0x06, 0xbe, 0xc1, 0xbb, 0xd9, 0x85,
+	0x06, 0x6e, 0xfb, 0x66, 0xe6, 0xcd, 0xec, 0x3c, 0x7c, 0x5d, 0x66, 0x71, 0xc1, 0x21, 0x48, 0x32,
+	0x29, 0x82, 0x8a, 0xe7, 0x12, 0xf8, 0x5b, 0x29, 0x6b, 0x60, 0x65, 0x25, 0x41, 0x12, 0xab, 0xa3,
+	0x99, 0xa2, 0x9d, 0x4b, 0x21, 0x85, 0xd4, 0x78, 0xa0, 0x5e, 0x9d, 0xc4, 0x71, 0x85, 0x94, 0x22,
+	0xe3, 0x81, 0x9e, 0x92, 0xf7, 0x4d, 0x00, 0xdb, 0x9c, 0xd7, 0x10, 0xe7, 0x65, 0x2f, 0x98, 0x8f,
+	0x23, 0x06, 0xef, 0x9b, 0x3f, 0x84, 0x71, 0xa4, 0x13, 0x9f, 0x65, 0x0d, 0xe4, 0x0a, 0x9b, 0xab,
+	0x34, 0x2e, 0x0a, 0x9e, 0x2d, 0x17, 0x36, 0xf2, 0x90, 0x6f, 0x46, 0x03, 0x40, 0xe6, 0x78, 0xa6,
+	0x56, 0x97, 0x0b, 0xfb, 0x44, 0x53, 0xfd, 0x44, 0x6e, 0xf1, 0x54, 0xbd, 0xec, 0x89, 0x87, 0x7c,
+	0xeb, 0xfe, 0x9c, 0x8d, 0xfe, 0xcb, 0x94, 0x6d, 0x38, 0xdd, 0x7f, 0xbb, 0x46, 0xa4, 0x45, 0xc4,
+	0xc3, 0xd6, 0x86, 0xc3, 0x2a, 0x7d, 0xe2, 0x5b, 0x91, 0x82, 0x3d, 0xf5, 0x90, 0x3f, 0x89, 0xc6,
+	0x10, 0x09, 0xb1, 0xa9, 0x47, 0xbe, 0x7e, 0x04, 0xfb, 0x54, 0x7b, 0x3a, 0xac, 0x2b, 0xc8, 0x8e,
+	0x05, 0xd9, 0xcb, 0xb1, 0x60, 0x78, 0xa6, 0xcc, 0x77, 0x3f, 0x2e, 0x8a, 0x86, 0x35, 0x42, 0x31,
+	0xae, 0x78, 0xbc, 0xee, 0x43, 0x66, 0x3a, 0x64, 0x84, 0x84, 0x77, 0xfb, 0x86, 0xa2, 0x43, 0x43,
+	0xd1, 0x6f, 0x43, 0xd1, 0xae, 0xa5, 0xc6, 0xa1, 0xa5, 0xc6, 0x57, 0x4b, 0x8d, 0xd7, 0x8b, 0xfe,
+	0x52, 0x1f, 0xdd, 0xad, 0xe0, 0xb3, 0xe4, 0x75, 0x32, 0xd3, 0xb9, 0x0f, 0xff, 0x03, 0x00, 0x5c,
+	0xf6, 0x4f, 0x12, 0xaa, 0x01, 0x00, 0x00,
+}
+
+func (m *RemotePost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemotePost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemotePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadHeight != 0 {
+		i = encodeVarintRemotePost(dAtA, i, uint64(m.ReadHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FetchedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FetchedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRemotePost(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.FetchHeight != 0 {
+		i = encodeVarintRemotePost(dAtA, i, uint64(m.FetchHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRemotePost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintRemotePost(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintRemotePost(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemotePost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemotePost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemotePost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovRemotePost(uint64(l))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovRemotePost(uint64(l))
+	}
+	l = m.Post.Size()
+	n += 1 + l + sovRemotePost(uint64(l))
+	if m.FetchHeight != 0 {
+		n += 1 + sovRemotePost(uint64(m.FetchHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FetchedAt)
+	n += 1 + l + sovRemotePost(uint64(l))
+	if m.ReadHeight != 0 {
+		n += 1 + sovRemotePost(uint64(m.ReadHeight))
+	}
+	return n
+}
+
+func sovRemotePost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemotePost(x uint64) (n int) {
+	return sovRemotePost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemotePost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemotePost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemotePost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemotePost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchHeight", wireType)
+			}
+			m.FetchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FetchHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FetchedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadHeight", wireType)
+			}
+			m.ReadHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemotePost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemotePost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemotePost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemotePost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemotePost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemotePost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemotePost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemotePost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemotePost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemotePost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemotePost = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1101 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/replication.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReplicationOp is the operation of a replicated change
+type ReplicationOp int32
+
+const (
+	ReplicationOpUnspecified ReplicationOp = 0
+	// REPLICATION_OP_RESET starts a snapshot: the replica drops its posts
+	ReplicationOpReset ReplicationOp = 1
+	// REPLICATION_OP_SET creates or updates a post
+	ReplicationOpSet ReplicationOp = 2
+	// REPLICATION_OP_REMOVE removes a post
+	ReplicationOpRemove ReplicationOp = 3
+)
+
+var ReplicationOp_name = map[int32]string{
+	0: "REPLICATION_OP_UNSPECIFIED",
+	1: "REPLICATION_OP_RESET",
+	2: "REPLICATION_OP_SET",
+	3: "REPLICATION_OP_REMOVE",
+}
+
+var ReplicationOp_value = map[string]int32{
+	"REPLICATION_OP_UNSPECIFIED": 0,
+	"REPLICATION_OP_RESET":       1,
+	"REPLICATION_OP_SET":         2,
+	"REPLICATION_OP_REMOVE":      3,
+}
+
+func (x ReplicationOp) String() string {
+	return proto.EnumName(ReplicationOp_name, int32(x))
+}
+
+func (ReplicationOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b62e22ba01f4fa3c, []int{0}
+}
+
+// ReplicationSubscription is a channel over which the counterparty chain keeps
+// a replica of the local posts
+type ReplicationSubscription struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// nextSequence is the replication sequence of the next change sent on the channel
+	NextSequence uint64 `protobuf:"varint,2,opt,name=nextSequence,proto3" json:"nextSequence,omitempty"`
+	// resetSequence is the replication sequence of the reset starting the last snapshot
+	ResetSequence uint64 `protobuf:"varint,3,opt,name=resetSequence,proto3" json:"resetSequence,omitempty"`
+	// synced is unset when a replication packet fails or times out. Changes
+	// aren't sent until the replica re-syncs.
+	Synced bool `protobuf:"varint,4,opt,name=synced,proto3" json:"synced,omitempty"`
+	// snapshotPending sends a reset followed by all the posts, a page of
+	// snapshotPageSize posts at the end of each block
+	SnapshotPending  bool  `protobuf:"varint,5,opt,name=snapshotPending,proto3" json:"snapshotPending,omitempty"`
+	SubscribedHeight int64 `protobuf:"varint,6,opt,name=subscribedHeight,proto3" json:"subscribedHeight,omitempty"`
+	// snapshotStarted is set once the reset of the pending snapshot is sent
+	SnapshotStarted bool `protobuf:"varint,7,opt,name=snapshotStarted,proto3" json:"snapshotStarted,omitempty"`
+	// snapshotCursor is the ID of the first post of the next page of the snapshot
+	SnapshotCursor uint64 `protobuf:"varint,8,opt,name=snapshotCursor,proto3" json:"snapshotCursor,omitempty"`
+}
+
+func (m *ReplicationSubscription) Reset()         { *m = ReplicationSubscription{} }
+func (m *ReplicationSubscription) String() string { return proto.CompactTextString(m) }
+func (*ReplicationSubscription) ProtoMessage()    {}
+func (*ReplicationSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62e22ba01f4fa3c, []int{0}
+}
+func (m *ReplicationSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationSubscription.Merge(m, src)
+}
+func (m *ReplicationSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationSubscription proto.InternalMessageInfo
+
+func (m *ReplicationSubscription) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *ReplicationSubscription) GetNextSequence() uint64 {
+	if m != nil {
+		return m.NextSequence
+	}
+	return 0
+}
+
+func (m *ReplicationSubscription) GetResetSequence() uint64 {
+	if m != nil {
+		return m.ResetSequence
+	}
+	return 0
+}
+
+func (m *ReplicationSubscription) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+func (m *ReplicationSubscription) GetSnapshotPending() bool {
+	if m != nil {
+		return m.SnapshotPending
+	}
+	return false
+}
+
+func (m *ReplicationSubscription) GetSubscribedHeight() int64 {
+	if m != nil {
+		return m.SubscribedHeight
+	}
+	return 0
+}
+
+func (m *ReplicationSubscription) GetSnapshotStarted() bool {
+	if m != nil {
+		return m.SnapshotStarted
+	}
+	return false
+}
+
+func (m *ReplicationSubscription) GetSnapshotCursor() uint64 {
+	if m != nil {
+		return m.SnapshotCursor
+	}
+	return 0
+}
+
+// Replica is the replica of the posts of a counterparty chain, kept over a
+// channel. The replicated posts are stored apart from the posts fetched over
+// the channel.
+type Replica struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// nextSequence is the replication sequence of the next change expected, 0
+	// until the first snapshot arrives
+	NextSequence uint64 `protobuf:"varint,2,opt,name=nextSequence,proto3" json:"nextSequence,omitempty"`
+	// appliedHeight is the height at which the last change was applied
+	AppliedHeight int64 `protobuf:"varint,3,opt,name=appliedHeight,proto3" json:"appliedHeight,omitempty"`
+}
+
+func (m *Replica) Reset()         { *m = Replica{} }
+func (m *Replica) String() string { return proto.CompactTextString(m) }
+func (*Replica) ProtoMessage()    {}
+func (*Replica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62e22ba01f4fa3c, []int{1}
+}
+func (m *Replica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replica.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Replica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replica.Merge(m, src)
+}
+func (m *Replica) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replica) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replica proto.InternalMessageInfo
+
+func (m *Replica) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *Replica) GetNextSequence() uint64 {
+	if m != nil {
+		return m.NextSequence
+	}
+	return 0
+}
+
+func (m *Replica) GetAppliedHeight() int64 {
+	if m != nil {
+		return m.AppliedHeight
+	}
+	return 0
+}
+
+// PostChange is a change of a local post, queued for replication until the end
+// of the block
+type PostChange struct {
+	Op   ReplicationOp `protobuf:"varint,1,opt,name=op,proto3,enum=planet.blog.ReplicationOp" json:"op,omitempty"`
+	Post Post          `protobuf:"bytes,2,opt,name=post,proto3" json:"post"`
+}
+
+func (m *PostChange) Reset()         { *m = PostChange{} }
+func (m *PostChange) String() string { return proto.CompactTextString(m) }
+func (*PostChange) ProtoMessage()    {}
+func (*PostChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62e22ba01f4fa3c, []int{2}
+}
+func (m *PostChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostChange.Merge(m, src)
+}
+func (m *PostChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostChange proto.InternalMessageInfo
+
+func (m *PostChange) GetOp() ReplicationOp {
+	if m != nil {
+		return m.Op
+	}
+	return ReplicationOpUnspecified
+}
+
+func (m *PostChange) GetPost() Post {
+	if m != nil {
+		return m.Post
+	}
+	return Post{}
+}
+
+func init() {
+	proto.RegisterEnum("planet.blog.ReplicationOp", ReplicationOp_name, ReplicationOp_value)
+	proto.RegisterType((*ReplicationSubscription)(nil), "planet.blog.ReplicationSubscription")
+	proto.RegisterType((*Replica)(nil), "planet.blog.Replica")
+	proto.RegisterType((*PostChange)(nil), "planet.blog.PostChange")
+}
+
+func init() { proto.RegisterFile("planet/blog/replication.proto", fileDescriptor_b62e22ba01f4fa3c) }
+
+var fileDescriptor_b62e22ba01f4fa3c = []byte{
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0xb6, 0x74, 0x9b, 0xc7, 0x46, 0xf1, 0x4a, 0x17, 0x45, 0x23, 0x44, 0x15, 0x42,
+	0x55, 0x81, 0x16, 0x95, 0x2b, 0x17, 0xd6, 0x05, 0x51, 0x09, 0xd6, 0x28, 0xd9, 0x38, 0x70, 0x99,
+	0xd2, 0xe4, 0x91, 0x46, 0x0a, 0xb6, 0x17, 0xbb, 0x68, 0xfb, 0x06, 0xa8, 0x27, 0xbe, 0x40, 0x4f,
+	0x7c, 0x12, 0x6e, 0x3b, 0xee, 0xc8, 0x09, 0xa1, 0xf6, 0xc0, 0xd7, 0x40, 0x49, 0x0a, 0x6d, 0xb2,
+	0xeb, 0x6e, 0xf6, 0xcf, 0xff, 0xf7, 0x7f, 0xcf, 0xf6, 0x7b, 0xf8, 0x21, 0x8f, 0x5c, 0x0a, 0xb2,
+	0x3b, 0x8a, 0x58, 0xd0, 0x8d, 0x81, 0x47, 0xa1, 0xe7, 0xca, 0x90, 0xd1, 0x0e, 0x8f, 0x99, 0x64,
+	0x64, 0x3b, 0x3b, 0xee, 0x24, 0xc7, 0x5a, 0x3d, 0x60, 0x01, 0x4b, 0x79, 0x37, 0x59, 0x65, 0x12,
+	0xad, 0xb1, 0xee, 0xc0, 0x99, 0x90, 0x19, 0x6f, 0xfe, 0x28, 0xe1, 0x7d, 0x7b, 0x65, 0xe8, 0x4c,
+	0x46, 0xc2, 0x8b, 0x43, 0x9e, 0xac, 0xc9, 0x01, 0xde, 0xf2, 0xc6, 0x2e, 0xa5, 0x10, 0x0d, 0x8e,
+	0x54, 0x64, 0xa0, 0xd6, 0x96, 0xbd, 0x02, 0xa4, 0x89, 0xef, 0x52, 0xb8, 0x90, 0x0e, 0x9c, 0x4f,
+	0x80, 0x7a, 0xa0, 0x96, 0x0c, 0xd4, 0xaa, 0xd8, 0x39, 0x46, 0x1e, 0xe3, 0x9d, 0x18, 0x04, 0xac,
+	0x44, 0xe5, 0x54, 0x94, 0x87, 0xa4, 0x81, 0xab, 0xe2, 0x92, 0x7a, 0xe0, 0xab, 0x15, 0x03, 0xb5,
+	0x36, 0xed, 0xe5, 0x8e, 0xb4, 0xf0, 0x3d, 0x41, 0x5d, 0x2e, 0xc6, 0x4c, 0x5a, 0x40, 0xfd, 0x90,
+	0x06, 0xea, 0x9d, 0x54, 0x50, 0xc4, 0xa4, 0x8d, 0x6b, 0x22, 0xab, 0x7c, 0x04, 0xfe, 0x5b, 0x08,
+	0x83, 0xb1, 0x54, 0xab, 0x06, 0x6a, 0x95, 0xed, 0x1b, 0x7c, 0xdd, 0xd5, 0x91, 0x6e, 0x2c, 0xc1,
+	0x57, 0x37, 0xf2, 0xae, 0x4b, 0x4c, 0x9e, 0xe0, 0xdd, 0x7f, 0xa8, 0x3f, 0x89, 0x05, 0x8b, 0xd5,
+	0xcd, 0xb4, 0xfc, 0x02, 0x6d, 0x9e, 0xe3, 0x8d, 0xe5, 0x13, 0xde, 0xce, 0x93, 0xb9, 0x9c, 0x47,
+	0xe1, 0xff, 0x7b, 0x94, 0xd3, 0x7b, 0xe4, 0x61, 0x13, 0x30, 0xb6, 0x98, 0x90, 0xfd, 0xb1, 0x4b,
+	0x03, 0x20, 0x6d, 0x5c, 0x62, 0x3c, 0x4d, 0xb7, 0xdb, 0xd3, 0x3a, 0x6b, 0xcd, 0xd0, 0x59, 0xfb,
+	0xda, 0x21, 0xb7, 0x4b, 0x8c, 0x93, 0xa7, 0xb8, 0x92, 0x7c, 0x7f, 0x9a, 0x7b, 0xbb, 0x77, 0x3f,
+	0xa7, 0x4e, 0x2c, 0x0f, 0x2b, 0x57, 0xbf, 0x1e, 0x29, 0x76, 0x2a, 0x6a, 0xff, 0x41, 0x78, 0x27,
+	0x67, 0x41, 0x5e, 0x61, 0xcd, 0x36, 0xad, 0x77, 0x83, 0xfe, 0xeb, 0x93, 0xc1, 0xf0, 0xf8, 0x6c,
+	0x68, 0x9d, 0x9d, 0x1e, 0x3b, 0x96, 0xd9, 0x1f, 0xbc, 0x19, 0x98, 0x47, 0x35, 0x45, 0x3b, 0x98,
+	0xce, 0x0c, 0x35, 0x17, 0x72, 0x4a, 0x05, 0x07, 0x2f, 0xfc, 0x14, 0x82, 0x4f, 0x5e, 0xe0, 0x7a,
+	0x21, 0xda, 0x36, 0x1d, 0xf3, 0xa4, 0x86, 0xb4, 0xc6, 0x74, 0x66, 0x90, 0x7c, 0xb5, 0x49, 0x8f,
+	0x90, 0x67, 0x98, 0x14, 0x22, 0x12, 0x7d, 0x49, 0xab, 0x4f, 0x67, 0x46, 0x2d, 0xa7, 0x77, 0x40,
+	0x92, 0x1e, 0x7e, 0x70, 0xc3, 0xff, 0xfd, 0xf0, 0x83, 0x59, 0x2b, 0x6b, 0xfb, 0xd3, 0x99, 0xb1,
+	0x57, 0x48, 0xf0, 0x99, 0x7d, 0x01, 0xad, 0xf2, 0xf5, 0xbb, 0xae, 0x1c, 0x3e, 0xbf, 0x9a, 0xeb,
+	0xe8, 0x7a, 0xae, 0xa3, 0xdf, 0x73, 0x1d, 0x7d, 0x5b, 0xe8, 0xca, 0xf5, 0x42, 0x57, 0x7e, 0x2e,
+	0x74, 0xe5, 0xe3, 0xde, 0x72, 0x72, 0x2e, 0xb2, 0xd9, 0x91, 0x97, 0x1c, 0xc4, 0xa8, 0x9a, 0x4e,
+	0xcf, 0xcb, 0xbf, 0x03, 0x00, 0x22, 0x8b, 0xd1, 0xdf, 0x99, 0x03, 0x00, 0x00,
+}
+
+func (m *ReplicationSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotCursor != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.SnapshotCursor))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SnapshotStarted {
+		i--
+		if m.SnapshotStarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SubscribedHeight != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.SubscribedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SnapshotPending {
+		i--
+		if m.SnapshotPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Synced {
+		i--
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResetSequence != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ResetSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replica) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replica) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppliedHeight != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.AppliedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Op != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReplication(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReplication(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReplicationSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovReplication(uint64(m.NextSequence))
+	}
+	if m.ResetSequence != 0 {
+		n += 1 + sovReplication(uint64(m.ResetSequence))
+	}
+	if m.Synced {
+		n += 2
+	}
+	if m.SnapshotPending {
+		n += 2
+	}
+	if m.SubscribedHeight != 0 {
+		n += 1 + sovReplication(uint64(m.SubscribedHeight))
+	}
+	if m.SnapshotStarted {
+		n += 2
+	}
+	if m.SnapshotCursor != 0 {
+		n += 1 + sovReplication(uint64(m.SnapshotCursor))
+	}
+	return n
+}
+
+func (m *Replica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovReplication(uint64(m.NextSequence))
+	}
+	if m.AppliedHeight != 0 {
+		n += 1 + sovReplication(uint64(m.AppliedHeight))
+	}
+	return n
+}
+
+func (m *PostChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovReplication(uint64(m.Op))
+	}
+	l = m.Post.Size()
+	n += 1 + l + sovReplication(uint64(l))
+	return n
+}
+
+func sovReplication(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReplication(x uint64) (n int) {
+	return sovReplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReplicationSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetSequence", wireType)
+			}
+			m.ResetSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotPending = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscribedHeight", wireType)
+			}
+			m.SubscribedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscribedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotStarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotStarted = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCursor", wireType)
+			}
+			m.SnapshotCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Replica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Replica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedHeight", wireType)
+			}
+			m.AppliedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= ReplicationOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReplication(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReplication
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReplication
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReplication
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReplication        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReplication          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReplication = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,6 +28,14 @@ type SentPost struct {
 	Title   string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Chain   string `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
 	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// channelID is the local channel the post was sent on
+	ChannelID string `protobuf:"bytes,6,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// deleted is set once the counterparty confirmed the deletion of the post
+	Deleted bool `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// revision is the last known revision of the post on the counterparty chain
+	Revision uint64 `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	// chainID is the chain ID of the counterparty chain the post was sent to
+	ChainID string `protobuf:"bytes,9,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
 func (m *SentPost) Reset()         { *m = SentPost{} }
@@ -98,6 +106,34 @@ func (m *SentPost) GetCreator() string {
 	return ""
 }
 
+func (m *SentPost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *SentPost) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *SentPost) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *SentPost) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SentPost)(nil), "planet.blog.SentPost")
 }
@@ -105,20 +141,23 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/sent_post.proto", fileDescriptor_c61cffbf1305fe72) }
 
 var fileDescriptor_c61cffbf1305fe72 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0x4e, 0xcd, 0x2b, 0x89, 0x2f, 0xc8, 0x2f,
-	0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x48, 0xea, 0x81, 0x24, 0x95, 0x2a,
-	0xb8, 0x38, 0x82, 0x53, 0xf3, 0x4a, 0x02, 0xf2, 0x8b, 0x4b, 0x84, 0xf8, 0xb8, 0x98, 0x32, 0x53,
-	0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0x98, 0x32, 0x53, 0x84, 0xc4, 0xb8, 0xd8, 0x40, 0xda,
-	0x3c, 0x5d, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x21, 0x11, 0x2e, 0xd6, 0x92,
-	0xcc, 0x92, 0x9c, 0x54, 0x09, 0x66, 0xb0, 0x30, 0x84, 0x03, 0x12, 0x4d, 0xce, 0x48, 0xcc, 0xcc,
-	0x93, 0x60, 0x81, 0x88, 0x82, 0x39, 0x42, 0x12, 0x5c, 0xec, 0xc9, 0x45, 0xa9, 0x89, 0x25, 0xf9,
-	0x45, 0x12, 0xac, 0x60, 0x71, 0x18, 0xd7, 0x49, 0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0x84, 0xa1, 0xae, 0xaf, 0x80, 0xb8, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x78, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x98, 0x6d, 0x45, 0xdb, 0x00,
-	0x00, 0x00,
+	// 252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4a, 0xc4, 0x40,
+	0x10, 0x86, 0xb3, 0xf1, 0x2e, 0x97, 0xac, 0x60, 0xb1, 0x8a, 0x0c, 0x2a, 0x4b, 0xb0, 0x4a, 0xe3,
+	0x5d, 0xe1, 0x1b, 0x48, 0x9a, 0x74, 0x12, 0x3b, 0x1b, 0xc9, 0x25, 0x83, 0xb7, 0x10, 0x76, 0x43,
+	0x32, 0x88, 0xbe, 0x85, 0x8f, 0x65, 0x79, 0xa5, 0xa5, 0x24, 0xe0, 0x73, 0xc8, 0xee, 0xe6, 0xbc,
+	0xf2, 0xfb, 0x7e, 0x86, 0x7f, 0xf8, 0xf9, 0x75, 0xd7, 0x56, 0x1a, 0x69, 0xb3, 0x6d, 0xcd, 0xeb,
+	0x66, 0x40, 0x4d, 0x2f, 0x9d, 0x19, 0x68, 0xdd, 0xf5, 0x86, 0x8c, 0x38, 0xf5, 0xe1, 0xda, 0x86,
+	0xb7, 0xbf, 0x8c, 0xc7, 0x4f, 0xa8, 0xe9, 0xd1, 0x0c, 0x24, 0xce, 0x78, 0xa8, 0x1a, 0x60, 0x29,
+	0xcb, 0x16, 0x65, 0xa8, 0x1a, 0x71, 0xc9, 0x23, 0x7b, 0x57, 0xe4, 0x10, 0xa6, 0x2c, 0x4b, 0xca,
+	0x99, 0xc4, 0x05, 0x5f, 0x92, 0xa2, 0x16, 0xe1, 0xc4, 0x69, 0x0f, 0xd6, 0xd6, 0xbb, 0x4a, 0x69,
+	0x58, 0x78, 0xeb, 0x40, 0x00, 0x5f, 0xd5, 0x3d, 0x56, 0x64, 0x7a, 0x58, 0x3a, 0x7f, 0x40, 0x71,
+	0xc3, 0x93, 0x7a, 0x57, 0x69, 0x8d, 0x6d, 0x91, 0x43, 0xe4, 0xb2, 0xa3, 0xb0, 0x77, 0x0d, 0xb6,
+	0x48, 0xd8, 0xc0, 0x2a, 0x65, 0x59, 0x5c, 0x1e, 0x50, 0x5c, 0xf1, 0xb8, 0xc7, 0x37, 0x35, 0x28,
+	0xa3, 0x21, 0x76, 0xbf, 0xfe, 0xb3, 0x6b, 0xb3, 0xb5, 0x45, 0x0e, 0xc9, 0xdc, 0xe6, 0xf1, 0xe1,
+	0xee, 0x6b, 0x94, 0x6c, 0x3f, 0x4a, 0xf6, 0x33, 0x4a, 0xf6, 0x39, 0xc9, 0x60, 0x3f, 0xc9, 0xe0,
+	0x7b, 0x92, 0xc1, 0xf3, 0xf9, 0x3c, 0xd6, 0xbb, 0x9f, 0x8b, 0x3e, 0x3a, 0x1c, 0xb6, 0x91, 0xdb,
+	0xea, 0xfe, 0x6f, 0x00, 0x07, 0xc8, 0x4c, 0x24, 0x4a, 0x01, 0x00, 0x00,
 }
 
 func (m *SentPost) Marshal() (dAtA []byte, err error) {
@@ -141,6 +180,35 @@ func (m *SentPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintSentPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintSentPost(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -213,6 +281,20 @@ func (m *SentPost) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSentPost(uint64(l))
 	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovSentPost(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovSentPost(uint64(m.Revision))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSentPost(uint64(l))
+	}
 	return n
 }
 
@@ -398,6 +480,109 @@ func (m *SentPost) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSentPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSentPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSentPost(dAtA[iNdEx:])
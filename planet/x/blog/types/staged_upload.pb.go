@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: planet/blog/staged_upload.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StagedUpload is a post received in chunks whose chunks haven't all arrived.
+// The post is stored once they have, and the upload is dropped when it expires.
+type StagedUpload struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// channelID is the channel the chunks are received on
+	ChannelID string `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// uploadID identifies the upload on the counterparty channel
+	UploadID string `protobuf:"bytes,3,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
+	Creator  string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title    string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// contentHash is the SHA-256 hash of the whole content
+	ContentHash    []byte `protobuf:"bytes,6,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	ChunkCount     uint32 `protobuf:"varint,7,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	ReceivedChunks uint32 `protobuf:"varint,8,opt,name=receivedChunks,proto3" json:"receivedChunks,omitempty"`
+	// receivedBytes is the size in bytes of the chunks received
+	ReceivedBytes uint64    `protobuf:"varint,9,opt,name=receivedBytes,proto3" json:"receivedBytes,omitempty"`
+	ExpiresAt     time.Time `protobuf:"bytes,10,opt,name=expiresAt,proto3,stdtime" json:"expiresAt"`
+}
+
+func (m *StagedUpload) Reset()         { *m = StagedUpload{} }
+func (m *StagedUpload) String() string { return proto.CompactTextString(m) }
+func (*StagedUpload) ProtoMessage()    {}
+func (*StagedUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_739e46a8df5978ee, []int{0}
+}
+func (m *StagedUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StagedUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StagedUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StagedUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StagedUpload.Merge(m, src)
+}
+func (m *StagedUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *StagedUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_StagedUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StagedUpload proto.InternalMessageInfo
+
+func (m *StagedUpload) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StagedUpload) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *StagedUpload) GetUploadID() string {
+	if m != nil {
+		return m.UploadID
+	}
+	return ""
+}
+
+func (m *StagedUpload) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *StagedUpload) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *StagedUpload) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *StagedUpload) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *StagedUpload) GetReceivedChunks() uint32 {
+	if m != nil {
+		return m.ReceivedChunks
+	}
+	return 0
+}
+
+func (m *StagedUpload) GetReceivedBytes() uint64 {
+	if m != nil {
+		return m.ReceivedBytes
+	}
+	return 0
+}
+
+func (m *StagedUpload) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+// UploadChunk is a chunk of a staged upload
+type UploadChunk struct {
+	StagedUploadID uint64 `protobuf:"varint,1,opt,name=stagedUploadID,proto3" json:"stagedUploadID,omitempty"`
+	Index          uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data           []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *UploadChunk) Reset()         { *m = UploadChunk{} }
+func (m *UploadChunk) String() string { return proto.CompactTextString(m) }
+func (*UploadChunk) ProtoMessage()    {}
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_739e46a8df5978ee, []int{1}
+}
+func (m *UploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunk.Merge(m, src)
+}
+func (m *UploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunk proto.InternalMessageInfo
+
+func (m *UploadChunk) GetStagedUploadID() uint64 {
+	if m != nil {
+		return m.StagedUploadID
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StagedUpload)(nil), "planet.blog.StagedUpload")
+	proto.RegisterType((*UploadChunk)(nil), "planet.blog.UploadChunk")
+}
+
+func init() { proto.RegisterFile("planet/blog/staged_upload.proto", fileDescriptor_739e46a8df5978ee) }
+
+var fileDescriptor_739e46a8df5978ee = []byte{
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0xd0, 0x6d, 0xcd, 0x97, 0x76, 0x07, 0xb3, 0x83, 0x15, 0xa1, 0x24, 0x9a, 0x10,
+	0xca, 0x85, 0x44, 0x82, 0x27, 0x20, 0xeb, 0x81, 0x5e, 0x03, 0x5c, 0xb8, 0x4c, 0x6e, 0xf2, 0x91,
+	0x46, 0x64, 0x76, 0x14, 0x3b, 0xa8, 0x7b, 0x8b, 0x3d, 0xd6, 0x8e, 0x3b, 0x72, 0x40, 0x80, 0xda,
+	0x17, 0x41, 0xb1, 0x17, 0xd6, 0xf5, 0xe6, 0xff, 0xcf, 0xff, 0x38, 0x9f, 0x7f, 0x32, 0x84, 0x6d,
+	0xc3, 0x05, 0xea, 0x74, 0xdd, 0xc8, 0x2a, 0x55, 0x9a, 0x57, 0x58, 0x5e, 0xf7, 0x6d, 0x23, 0x79,
+	0x99, 0xb4, 0x9d, 0xd4, 0x92, 0x7a, 0xb6, 0x90, 0x0c, 0x05, 0xff, 0xa2, 0x92, 0x95, 0x34, 0x3c,
+	0x1d, 0x56, 0xb6, 0xe2, 0x87, 0x95, 0x94, 0x55, 0x83, 0xa9, 0x49, 0xeb, 0xfe, 0x5b, 0xaa, 0xeb,
+	0x1b, 0x54, 0x9a, 0xdf, 0xb4, 0xb6, 0x70, 0xf9, 0xcb, 0x81, 0xf9, 0x27, 0x73, 0xf6, 0x17, 0x73,
+	0x34, 0x3d, 0x07, 0xa7, 0x2e, 0x19, 0x89, 0x48, 0x3c, 0xcd, 0x9d, 0xba, 0xa4, 0xaf, 0xc0, 0x2d,
+	0x36, 0x5c, 0x08, 0x6c, 0x56, 0x4b, 0xe6, 0x44, 0x24, 0x76, 0xf3, 0x27, 0x40, 0x7d, 0x98, 0xd9,
+	0x91, 0x56, 0x4b, 0xf6, 0xc2, 0x6c, 0xfe, 0xcf, 0x94, 0xc1, 0x59, 0xd1, 0x21, 0xd7, 0xb2, 0x63,
+	0x53, 0xb3, 0x35, 0x46, 0x7a, 0x01, 0x27, 0xba, 0xd6, 0x0d, 0xb2, 0x13, 0xc3, 0x6d, 0xa0, 0x11,
+	0x78, 0x85, 0x14, 0x1a, 0x85, 0xfe, 0xc8, 0xd5, 0x86, 0x9d, 0x46, 0x24, 0x9e, 0xe7, 0x87, 0x88,
+	0x06, 0x00, 0xc5, 0xa6, 0x17, 0xdf, 0xaf, 0x64, 0x2f, 0x34, 0x3b, 0x8b, 0x48, 0xbc, 0xc8, 0x0f,
+	0x08, 0x7d, 0x03, 0xe7, 0x1d, 0x16, 0x58, 0xff, 0xc0, 0xf2, 0x6a, 0xa0, 0x8a, 0xcd, 0x4c, 0xe7,
+	0x88, 0xd2, 0xd7, 0xb0, 0x18, 0x49, 0x76, 0xab, 0x51, 0x31, 0xd7, 0x5c, 0xf7, 0x39, 0xa4, 0x19,
+	0xb8, 0xb8, 0x6d, 0xeb, 0x0e, 0xd5, 0x07, 0xcd, 0x20, 0x22, 0xb1, 0xf7, 0xce, 0x4f, 0xac, 0xcf,
+	0x64, 0xf4, 0x99, 0x7c, 0x1e, 0x7d, 0x66, 0xb3, 0xfb, 0xdf, 0xe1, 0xe4, 0xee, 0x4f, 0x48, 0xf2,
+	0xa7, 0xcf, 0x2e, 0xaf, 0xc1, 0xb3, 0x5e, 0xcd, 0x9f, 0x87, 0x01, 0xd5, 0x81, 0xec, 0xd5, 0xf2,
+	0x51, 0xf4, 0x11, 0x1d, 0x04, 0xd5, 0xa2, 0xc4, 0xad, 0x11, 0xbe, 0xc8, 0x6d, 0xa0, 0x14, 0xa6,
+	0x25, 0xd7, 0xdc, 0x88, 0x9e, 0xe7, 0x66, 0x9d, 0xbd, 0xbd, 0xdf, 0x05, 0xe4, 0x61, 0x17, 0x90,
+	0xbf, 0xbb, 0x80, 0xdc, 0xed, 0x83, 0xc9, 0xc3, 0x3e, 0x98, 0xfc, 0xdc, 0x07, 0x93, 0xaf, 0x2f,
+	0x1f, 0x9f, 0xcf, 0xd6, 0x3e, 0x20, 0x7d, 0xdb, 0xa2, 0x5a, 0x9f, 0x9a, 0xc1, 0xdf, 0xff, 0x1b,
+	0x00, 0x3d, 0x2b, 0x20, 0x3a, 0x5c, 0x02, 0x00, 0x00,
+}
+
+func (m *StagedUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StagedUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StagedUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStagedUpload(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.ReceivedBytes != 0 {
+		i = encodeVarintStagedUpload(dAtA, i, uint64(m.ReceivedBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReceivedChunks != 0 {
+		i = encodeVarintStagedUpload(dAtA, i, uint64(m.ReceivedChunks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintStagedUpload(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintStagedUpload(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStagedUpload(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStagedUpload(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintStagedUpload(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintStagedUpload(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStagedUpload(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintStagedUpload(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintStagedUpload(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StagedUploadID != 0 {
+		i = encodeVarintStagedUpload(dAtA, i, uint64(m.StagedUploadID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStagedUpload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStagedUpload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StagedUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStagedUpload(uint64(m.Id))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovStagedUpload(uint64(l))
+	}
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovStagedUpload(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovStagedUpload(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStagedUpload(uint64(l))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovStagedUpload(uint64(l))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovStagedUpload(uint64(m.ChunkCount))
+	}
+	if m.ReceivedChunks != 0 {
+		n += 1 + sovStagedUpload(uint64(m.ReceivedChunks))
+	}
+	if m.ReceivedBytes != 0 {
+		n += 1 + sovStagedUpload(uint64(m.ReceivedBytes))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovStagedUpload(uint64(l))
+	return n
+}
+
+func (m *UploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StagedUploadID != 0 {
+		n += 1 + sovStagedUpload(uint64(m.StagedUploadID))
+	}
+	if m.Index != 0 {
+		n += 1 + sovStagedUpload(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovStagedUpload(uint64(l))
+	}
+	return n
+}
+
+func sovStagedUpload(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStagedUpload(x uint64) (n int) {
+	return sovStagedUpload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StagedUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStagedUpload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StagedUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StagedUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedChunks", wireType)
+			}
+			m.ReceivedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedBytes", wireType)
+			}
+			m.ReceivedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStagedUpload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStagedUpload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StagedUploadID", wireType)
+			}
+			m.StagedUploadID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StagedUploadID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStagedUpload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStagedUpload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStagedUpload(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStagedUpload
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStagedUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStagedUpload
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStagedUpload
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStagedUpload
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStagedUpload        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStagedUpload          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStagedUpload = fmt.Errorf("proto: unexpected end of group")
+)
@@ -27,6 +27,16 @@ type TimeoutPost struct {
 	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Chain   string `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// port and channelID are the source port and channel the packet was sent on
+	Port      string `protobuf:"bytes,6,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID string `protobuf:"bytes,7,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	// postID is the remote post ID of a timed out update, empty for a new post
+	PostID string `protobuf:"bytes,8,opt,name=postID,proto3" json:"postID,omitempty"`
+	// baseRevision is the revision a timed out update was based on
+	BaseRevision uint64 `protobuf:"varint,9,opt,name=baseRevision,proto3" json:"baseRevision,omitempty"`
+	// memo is the memo of the header of the timed out packet, sent again on retry
+	Memo string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *TimeoutPost) Reset()         { *m = TimeoutPost{} }
@@ -90,6 +100,48 @@ func (m *TimeoutPost) GetCreator() string {
 	return ""
 }
 
+func (m *TimeoutPost) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *TimeoutPost) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *TimeoutPost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *TimeoutPost) GetPostID() string {
+	if m != nil {
+		return m.PostID
+	}
+	return ""
+}
+
+func (m *TimeoutPost) GetBaseRevision() uint64 {
+	if m != nil {
+		return m.BaseRevision
+	}
+	return 0
+}
+
+func (m *TimeoutPost) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TimeoutPost)(nil), "planet.blog.TimeoutPost")
 }
@@ -97,19 +149,24 @@ func init() {
 func init() { proto.RegisterFile("planet/blog/timeout_post.proto", fileDescriptor_155372e6950f34d2) }
 
 var fileDescriptor_155372e6950f34d2 = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0xc8, 0x49, 0xcc,
-	0x4b, 0x2d, 0xd1, 0x4f, 0xca, 0xc9, 0x4f, 0xd7, 0x2f, 0xc9, 0xcc, 0x4d, 0xcd, 0x2f, 0x2d, 0x89,
-	0x2f, 0xc8, 0x2f, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xc8, 0xeb, 0x81,
-	0xe4, 0x95, 0x92, 0xb9, 0xb8, 0x43, 0x20, 0x4a, 0x02, 0xf2, 0x8b, 0x4b, 0x84, 0xf8, 0xb8, 0x98,
-	0x32, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0x98, 0x32, 0x53, 0x84, 0x44, 0xb8, 0x58,
-	0x4b, 0x32, 0x4b, 0x72, 0x52, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0x20, 0x1c, 0x90, 0x68,
-	0x72, 0x46, 0x62, 0x66, 0x9e, 0x04, 0x33, 0x44, 0x14, 0xcc, 0x11, 0x92, 0xe0, 0x62, 0x4f, 0x2e,
-	0x4a, 0x4d, 0x2c, 0xc9, 0x2f, 0x92, 0x60, 0x01, 0x8b, 0xc3, 0xb8, 0x4e, 0xba, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x0c, 0x75, 0x6b, 0x05, 0xd4, 0xb5, 0x95, 0x05,
-	0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x77, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x05, 0x6e, 0x01,
-	0xe1, 0xc9, 0x00, 0x00, 0x00,
+	// 264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xb3, 0x31, 0x4d, 0xcd, 0x56, 0x3c, 0xac, 0x22, 0x73, 0x90, 0xa5, 0xf4, 0xd4, 0x8b,
+	0xed, 0xc1, 0x37, 0x90, 0x5e, 0x7a, 0x93, 0xe0, 0xc9, 0x8b, 0x24, 0xe9, 0x60, 0x17, 0x92, 0x9d,
+	0x90, 0x8c, 0xa2, 0x6f, 0xe1, 0x63, 0x79, 0xec, 0xd1, 0xa3, 0x24, 0x0f, 0xa2, 0x64, 0x37, 0x22,
+	0xbd, 0xcd, 0xff, 0x7d, 0x03, 0xc3, 0xfc, 0x52, 0xd7, 0x65, 0x66, 0x91, 0xd7, 0x79, 0x49, 0xcf,
+	0x6b, 0x36, 0x15, 0xd2, 0x0b, 0x3f, 0xd5, 0xd4, 0xf2, 0xaa, 0x6e, 0x88, 0x49, 0xcd, 0xbc, 0x5f,
+	0x0d, 0x7e, 0xf1, 0x23, 0xe4, 0xec, 0xc1, 0xef, 0xdc, 0x53, 0xcb, 0xea, 0x5c, 0x86, 0x66, 0x07,
+	0x62, 0x2e, 0x96, 0x51, 0x1a, 0x9a, 0x9d, 0xba, 0x94, 0x13, 0x36, 0x5c, 0x22, 0x84, 0x73, 0xb1,
+	0x4c, 0x52, 0x1f, 0x06, 0x5a, 0xec, 0x33, 0x63, 0xe1, 0xc4, 0x53, 0x17, 0x14, 0xc8, 0x69, 0xd1,
+	0x60, 0xc6, 0xd4, 0x40, 0xe4, 0xf8, 0x5f, 0x74, 0x86, 0x2c, 0xa3, 0x65, 0x98, 0x8c, 0xc6, 0x47,
+	0xa5, 0x64, 0x54, 0x53, 0xc3, 0x10, 0x3b, 0xec, 0x66, 0x75, 0x2d, 0x93, 0x62, 0x9f, 0x59, 0x8b,
+	0xe5, 0x76, 0x03, 0x53, 0x27, 0xfe, 0x81, 0xba, 0x92, 0xf1, 0xf0, 0xcc, 0x76, 0x03, 0xa7, 0x4e,
+	0x8d, 0x49, 0x2d, 0xe4, 0x59, 0x9e, 0xb5, 0x98, 0xe2, 0xab, 0x69, 0x0d, 0x59, 0x48, 0xdc, 0x0f,
+	0x47, 0x6c, 0xb8, 0x56, 0x61, 0x45, 0x20, 0xfd, 0xb5, 0x61, 0xbe, 0xbb, 0xf9, 0xec, 0xb4, 0x38,
+	0x74, 0x5a, 0x7c, 0x77, 0x5a, 0x7c, 0xf4, 0x3a, 0x38, 0xf4, 0x3a, 0xf8, 0xea, 0x75, 0xf0, 0x78,
+	0x31, 0x16, 0xf9, 0x36, 0x56, 0xf9, 0x5e, 0x63, 0x9b, 0xc7, 0xae, 0xc4, 0xdb, 0xdf, 0x01, 0x00,
+	0x20, 0x47, 0xf9, 0xa6, 0x66, 0x01, 0x00, 0x00,
 }
 
 func (m *TimeoutPost) Marshal() (dAtA []byte, err error) {
@@ -132,6 +189,46 @@ func (m *TimeoutPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTimeoutPost(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BaseRevision != 0 {
+		i = encodeVarintTimeoutPost(dAtA, i, uint64(m.BaseRevision))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PostID) > 0 {
+		i -= len(m.PostID)
+		copy(dAtA[i:], m.PostID)
+		i = encodeVarintTimeoutPost(dAtA, i, uint64(len(m.PostID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTimeoutPost(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTimeoutPost(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTimeoutPost(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -193,6 +290,29 @@ func (m *TimeoutPost) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTimeoutPost(uint64(l))
 	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTimeoutPost(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTimeoutPost(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTimeoutPost(uint64(l))
+	}
+	l = len(m.PostID)
+	if l > 0 {
+		n += 1 + l + sovTimeoutPost(uint64(l))
+	}
+	if m.BaseRevision != 0 {
+		n += 1 + sovTimeoutPost(uint64(m.BaseRevision))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTimeoutPost(uint64(l))
+	}
 	return n
 }
 
@@ -346,6 +466,185 @@ func (m *TimeoutPost) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRevision", wireType)
+			}
+			m.BaseRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeoutPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeoutPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTimeoutPost(dAtA[iNdEx:])
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidatePostTitle checks that a title is non-empty, valid UTF-8 and free of
+// control characters. Its length is checked against the params by the keeper.
+func ValidatePostTitle(title string) error {
+	if title == "" {
+		return ErrEmptyTitle
+	}
+	return validateText("title", title, false)
+}
+
+// ValidatePostContent checks that a content is valid UTF-8 and free of control
+// characters other than line breaks and tabs. Its length is checked against the
+// params by the keeper.
+func ValidatePostContent(content string) error {
+	return validateText("content", content, true)
+}
+
+// ValidateRemotePost checks the title and content of a post of a counterparty
+// chain, received in an acknowledgement
+func ValidateRemotePost(post Post) error {
+	if err := ValidatePostTitle(post.Title); err != nil {
+		return err
+	}
+	return ValidatePostContent(post.Content)
+}
+
+// ValidatePostCreator checks that a creator is a bech32 account address. The
+// human-readable prefix is not checked since it belongs to the sending chain.
+func ValidatePostCreator(creator string) error {
+	_, bz, err := bech32.DecodeAndConvert(creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCreator, "%s: %s", creator, err)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCreator, "%s: %s", creator, err)
+	}
+	return nil
+}
+
+// ValidatePostID checks that a post ID is the decimal form of a uint64
+func ValidatePostID(postID string) error {
+	if _, err := strconv.ParseUint(postID, 10, 64); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPostID, "%q", postID)
+	}
+	return nil
+}
+
+// ValidateChainID checks that a counterparty chain ID can be stored in the
+// indexes by chain, whose keys end the chain ID with a slash
+func ValidateChainID(chainID string) error {
+	if strings.Contains(chainID, "/") {
+		return sdkerrors.Wrapf(ErrInvalidChainID, "%q contains a slash", chainID)
+	}
+	return nil
+}
+
+// MaxPacketMemoLength is the maximum length in bytes of the memo of a packet
+const MaxPacketMemoLength = 256
+
+// ValidatePacketMemo checks that a packet memo is short, valid UTF-8 and free
+// of control characters
+func ValidatePacketMemo(memo string) error {
+	if len(memo) > MaxPacketMemoLength {
+		return sdkerrors.Wrapf(ErrMemoTooLong, "%d > %d", len(memo), MaxPacketMemoLength)
+	}
+	return validateText("memo", memo, false)
+}
+
+func validateText(field, s string, multiline bool) error {
+	if !utf8.ValidString(s) {
+		return sdkerrors.Wrap(ErrInvalidUTF8, field)
+	}
+	for i, r := range s {
+		if multiline && (r == '\n' || r == '\r' || r == '\t') {
+			continue
+		}
+		if unicode.IsControl(r) {
+			return sdkerrors.Wrapf(ErrControlCharacter, "%s: %U at byte %d", field, r, i)
+		}
+	}
+	return nil
+}